@@ -11,11 +11,22 @@ import (
 
 // Config -.
 type Config struct {
-	App   `yaml:"app"`
-	Cache `yaml:"cache"`
-	HTTP  `yaml:"http"`
-	Trace `yaml:"trace"`
-	Log   `yaml:"logger"`
+	App       `yaml:"app"`
+	Cache     `yaml:"cache"`
+	HTTP      `yaml:"http"`
+	Trace     `yaml:"trace"`
+	Log       `yaml:"logger"`
+	Backup    `yaml:"backup"`
+	Scheduler `yaml:"scheduler"`
+	Counters  `yaml:"counters"`
+	Exporter  `yaml:"exporter"`
+	Watch     `yaml:"watch"`
+	Reaper    `yaml:"reaper"`
+	Fleet     `yaml:"fleet"`
+	Auth      `yaml:"auth"`
+	Vault     `yaml:"vault"`
+
+	Credentials map[string]Credentials `yaml:"credentials"`
 }
 
 // App -.
@@ -24,6 +35,178 @@ type App struct {
 	PathTo1C  string `env-required:"true" yaml:"path_to_1c" env:"PATH_TO_1C"`
 
 	LockCode string `env-required:"true" yaml:"lock_code"`
+
+	// Entrypoints - named ras servers, in allowlist mode only they may be requested.
+	Entrypoints []Entrypoint `yaml:"entrypoints"`
+	Allowlist   bool         `yaml:"allowlist"   env:"ALLOWLIST"`
+}
+
+// Entrypoint - ras server (address) by name, credentials refer to named ones in credentials section.
+type Entrypoint struct {
+	Name        string   `yaml:"name"`
+	Address     string   `yaml:"address"`
+	Credentials string   `yaml:"credentials"`
+	Tags        []string `yaml:"tags"`
+}
+
+// Backup -.
+type Backup struct {
+	Dir     string `env-default:"./backups" yaml:"dir"     env:"BACKUP_DIR"`
+	Workers int    `env-default:"1"         yaml:"workers"`
+	Queue   int    `env-default:"100"       yaml:"queue"`
+
+	GracePeriod  time.Duration `env-default:"5m"  yaml:"grace_period"`
+	KickRetries  int           `env-default:"3"   yaml:"kick_retries"`
+	KickInterval time.Duration `env-default:"10s" yaml:"kick_interval"`
+	LockWindow   time.Duration `env-default:"12h" yaml:"lock_window"`
+
+	Retention `yaml:"retention"`
+}
+
+// Retention - zero values keep all dumps.
+type Retention struct {
+	KeepLast   int `yaml:"keep_last"`
+	KeepDaily  int `yaml:"keep_daily"`
+	KeepWeekly int `yaml:"keep_weekly"`
+}
+
+// Scheduler -.
+type Scheduler struct {
+	State     string     `env-default:"./schedules.json" yaml:"state"`
+	Schedules []Schedule `yaml:"schedules"`
+}
+
+// Schedule - backup of infobase by cron expression, credentials refer to named ones in credentials section.
+type Schedule struct {
+	Name        string     `yaml:"name"`
+	Cron        string     `yaml:"cron"`
+	Entrypoint  string     `yaml:"entrypoint"`
+	Cluster     string     `yaml:"cluster"`
+	Infobase    string     `yaml:"infobase"`
+	Credentials string     `yaml:"credentials"`
+	Safe        bool       `yaml:"safe"`
+	Retention   *Retention `yaml:"retention"`
+}
+
+// Counters - export of resource consumption counters to prometheus.
+type Counters struct {
+	Export  bool            `yaml:"export"`
+	Timeout time.Duration   `env-default:"10s" yaml:"timeout"`
+	Targets []CounterTarget `yaml:"targets"`
+}
+
+// CounterTarget - counters of cluster, credentials refer to named ones in credentials section.
+type CounterTarget struct {
+	Entrypoint  string   `yaml:"entrypoint"`
+	Cluster     string   `yaml:"cluster"`
+	Credentials string   `yaml:"credentials"`
+	Counters    []string `yaml:"counters"`
+}
+
+// Exporter - polling sessions and connections of clusters for prometheus.
+type Exporter struct {
+	Enable      bool                 `yaml:"enable"`
+	Interval    time.Duration        `env-default:"30s" yaml:"interval"`
+	Timeout     time.Duration        `env-default:"20s" yaml:"timeout"`
+	Entrypoints []ExporterEntrypoint `yaml:"entrypoints"`
+}
+
+// ExporterEntrypoint - all clusters of entrypoint are polled, credentials refer to named ones in credentials section.
+type ExporterEntrypoint struct {
+	Entrypoint  string `yaml:"entrypoint"`
+	Credentials string `yaml:"credentials"`
+}
+
+// Watch - polling of sessions of watched clusters.
+type Watch struct {
+	Interval time.Duration `env-default:"5s"  yaml:"interval"`
+	Timeout  time.Duration `env-default:"20s" yaml:"timeout"`
+}
+
+// Fleet - fleet-wide views, timeout is applied to each entrypoint of registry.
+type Fleet struct {
+	Timeout time.Duration `env-default:"20s" yaml:"timeout"`
+}
+
+// Auth - api keys (hex of sha256 of key) and bearer JWT signed by keys of JWKS file,
+// role of token is taken from role_claim; with auth disabled anyone is allowed everything.
+type Auth struct {
+	Enable    bool     `yaml:"enable"     env:"AUTH_ENABLE"`
+	Keys      []APIKey `yaml:"keys"`
+	JWKS      string   `yaml:"jwks"       env:"AUTH_JWKS"`
+	Issuer    string   `yaml:"issuer"`
+	Audience  string   `yaml:"audience"`
+	RoleClaim string   `env-default:"role" yaml:"role_claim"`
+
+	// Grants - scopes by name of api key or subject of token, principals without grants are not scoped.
+	Grants map[string][]Grant `yaml:"grants"`
+}
+
+// Grant - entrypoints (address or name), clusters (id) and infobases (name) as shell patterns, empty list allows any.
+type Grant struct {
+	Entrypoints []string `yaml:"entrypoints"`
+	Clusters    []string `yaml:"clusters"`
+	Infobases   []string `yaml:"infobases"`
+}
+
+// APIKey - role is viewer, operator or admin.
+type APIKey struct {
+	Name string `yaml:"name"`
+	Hash string `yaml:"hash"`
+	Role string `yaml:"role"`
+}
+
+// Vault - credentials stored in file encrypted by master key, key is taken from env only, requires auth.
+type Vault struct {
+	Enable bool   `yaml:"enable"                       env:"VAULT_ENABLE"`
+	Path   string `env-default:"./vault.bin" yaml:"path" env:"VAULT_PATH"`
+	Key    string `yaml:"-"                            env:"VAULT_KEY"`
+}
+
+// Reaper - terminating idle sessions by policies, in dry-run mode sessions are only written to audit.
+type Reaper struct {
+	Enable   bool           `yaml:"enable"`
+	DryRun   bool           `yaml:"dry_run"`
+	Interval time.Duration  `env-default:"5m"           yaml:"interval"`
+	Audit    string         `env-default:"./reaper.log" yaml:"audit"`
+	Exclude  ReaperExclude  `yaml:"exclude"`
+	Policies []ReaperPolicy `yaml:"policies"`
+}
+
+// ReaperPolicy - rules of cluster, credentials refer to named ones in credentials section.
+type ReaperPolicy struct {
+	Name        string        `yaml:"name"`
+	Entrypoint  string        `yaml:"entrypoint"`
+	Cluster     string        `yaml:"cluster"`
+	Credentials string        `yaml:"credentials"`
+	Rules       []ReaperRule  `yaml:"rules"`
+	Exclude     ReaperExclude `yaml:"exclude"`
+}
+
+// ReaperRule - sessions of infobase (name) idle longer than idle, empty infobase and app_ids match any.
+type ReaperRule struct {
+	Infobase   string        `yaml:"infobase"`
+	Idle       time.Duration `yaml:"idle"`
+	AppIDs     []string      `yaml:"app_ids"`
+	Hibernated bool          `yaml:"hibernated"`
+}
+
+// ReaperExclude - sessions which are never terminated.
+type ReaperExclude struct {
+	AppIDs []string `yaml:"app_ids"`
+	Users  []string `yaml:"users"`
+}
+
+// Credentials - administrators of cluster and infobase.
+type Credentials struct {
+	Cluster  Credential `yaml:"cluster"`
+	Infobase Credential `yaml:"infobase"`
+}
+
+// Credential -.
+type Credential struct {
+	Login    string `yaml:"login"`
+	Password string `yaml:"password"`
 }
 
 // Cache -.
@@ -99,6 +282,55 @@ func Prepare() error {
 			Level: "debug",
 			Path:  "log.log",
 		},
+		Backup{
+			Dir:     "./backups",
+			Workers: 1,
+			Queue:   100,
+
+			GracePeriod:  5 * time.Minute,
+			KickRetries:  3,
+			KickInterval: 10 * time.Second,
+			LockWindow:   12 * time.Hour,
+
+			Retention: Retention{
+				KeepLast:   7,
+				KeepDaily:  14,
+				KeepWeekly: 8,
+			},
+		},
+		Scheduler{
+			State: "./schedules.json",
+		},
+		Counters{
+			Timeout: 10 * time.Second,
+		},
+		Exporter{
+			Interval: 30 * time.Second,
+			Timeout:  20 * time.Second,
+		},
+		Watch{
+			Interval: 5 * time.Second,
+			Timeout:  20 * time.Second,
+		},
+		Reaper{
+			DryRun:   true,
+			Interval: 5 * time.Minute,
+			Audit:    "./reaper.log",
+			Exclude: ReaperExclude{
+				AppIDs: []string{"BackgroundJob"},
+			},
+		},
+		Fleet{
+			Timeout: 20 * time.Second,
+		},
+		Auth{
+			RoleClaim: "role",
+			Grants:    map[string][]Grant{},
+		},
+		Vault{
+			Path: "./vault.bin",
+		},
+		map[string]Credentials{},
 	}
 
 	yamlData, err := yaml.Marshal(&cfg)
@@ -16,34 +16,112 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/cluster/:cluster/connection/list": {
-            "get": {
-                "description": "Show all connections with identifiers for current cluster",
-                "produces": [
+        "/agent/admin": {
+            "post": {
+                "description": "Add administrator to central server agent, first administrator may be added without credentials",
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "connection list"
+                    "agent admin"
                 ],
-                "summary": "Show all connections in cluster",
-                "operationId": "connections",
+                "summary": "Register agent administrator",
+                "operationId": "registerAgentAdmin",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "UUID of cluster",
-                        "name": "cluster",
+                        "description": "Entrypoint for agent",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Name, password, authentication kinds and OS user of administrator",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.adminRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/agent/admin/:name": {
+            "delete": {
+                "description": "Delete administrator of central server agent by name",
+                "tags": [
+                    "agent admin"
+                ],
+                "summary": "Remove agent administrator",
+                "operationId": "removeAgentAdmin",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of administrator",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "boolean",
-                        "description": "Firstly try to find from Cache",
-                        "name": "cache",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Entrypoint for agent",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/agent/admin/list": {
+            "get": {
+                "description": "Show all administrators of central server agent, agent credentials are passed in headers agent-login and agent-password",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "agent admin"
+                ],
+                "summary": "Show agent administrators",
+                "operationId": "agentAdmins",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "Entrypoint for cluster",
+                        "description": "Entrypoint for agent",
                         "name": "entrypoint",
                         "in": "query",
                         "required": true
@@ -53,7 +131,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.connectionResponse"
+                            "$ref": "#/definitions/v1.adminResponse"
                         }
                     },
                     "400": {
@@ -62,12 +140,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/error.response"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/error.response"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -77,51 +149,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/cluster/:cluster/infobase/:infobase/connection/list": {
+        "/backups": {
             "get": {
-                "description": "Show all connections with identifiers for current infobase in cluster",
+                "description": "Show dumps registered in backup catalog from oldest to newest with size, duration and checksum",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "connection list infobase"
+                    "infobase backup"
                 ],
-                "summary": "Show all connections in infobase",
-                "operationId": "connectionsByInfobase",
+                "summary": "Show backups",
+                "operationId": "backups",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "UUID of cluster",
                         "name": "cluster",
-                        "in": "path",
-                        "required": true
+                        "in": "query"
                     },
                     {
                         "type": "string",
                         "description": "UUID of infobase",
                         "name": "infobase",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Firstly try to find from Cache",
-                        "name": "cache",
                         "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Entrypoint for cluster",
-                        "name": "entrypoint",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.connectionResponse"
+                            "$ref": "#/definitions/v1.backupsResponse"
                         }
                     },
                     "400": {
@@ -130,12 +187,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/error.response"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/error.response"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -145,38 +196,76 @@ const docTemplate = `{
                 }
             }
         },
-        "/cluster/:cluster/infobase/:infobase/session/list": {
-            "get": {
-                "description": "Show all sessions with identifiers for current infobase in cluster",
+        "/cluster": {
+            "post": {
+                "description": "Register new cluster on central server agent, agent credentials are passed in headers agent-login and agent-password",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "session list infobase"
+                    "cluster"
                 ],
-                "summary": "Show all sessions in infobase",
-                "operationId": "sessionsByInfobase",
+                "summary": "Register cluster",
+                "operationId": "insertCluster",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "UUID of cluster",
-                        "name": "cluster",
-                        "in": "path",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
                         "required": true
                     },
+                    {
+                        "description": "Host and port of cluster manager and cluster settings",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.clusterInsertRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Cluster"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster": {
+            "delete": {
+                "description": "Remove cluster from central server agent, agent credentials are passed in headers agent-login and agent-password",
+                "tags": [
+                    "cluster"
+                ],
+                "summary": "Unregister cluster",
+                "operationId": "removeCluster",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "UUID of infobase",
-                        "name": "infobase",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "boolean",
-                        "description": "Firstly try to find from Cache",
-                        "name": "cache",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
                         "description": "Entrypoint for cluster",
@@ -186,11 +275,8 @@ const docTemplate = `{
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/v1.sessionResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -198,12 +284,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/error.response"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/error.response"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -211,19 +291,20 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/cluster/:cluster/infobase/list": {
-            "get": {
-                "description": "Show all infobases with identifiers for current cluster",
+            },
+            "patch": {
+                "description": "Change settings of cluster, only fields present in body are changed",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "infobase list"
+                    "cluster"
                 ],
-                "summary": "Show all infobases in cluster",
-                "operationId": "infobases",
+                "summary": "Update cluster settings",
+                "operationId": "updateCluster",
                 "parameters": [
                     {
                         "type": "string",
@@ -232,25 +313,28 @@ const docTemplate = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "boolean",
-                        "description": "Firstly try to find from Cache",
-                        "name": "cache",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
                         "description": "Entrypoint for cluster",
                         "name": "entrypoint",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "description": "Settings to change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.clusterUpdateRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.infobaseResponse"
+                            "$ref": "#/definitions/entity.Cluster"
                         }
                     },
                     "400": {
@@ -274,17 +358,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/cluster/:cluster/session/list": {
-            "get": {
-                "description": "Show all sessions with identifiers for current cluster",
-                "produces": [
+        "/cluster/:cluster/admin": {
+            "post": {
+                "description": "Add administrator to cluster, first administrator may be added without credentials",
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "session list"
+                    "cluster admin"
                 ],
-                "summary": "Show all sessions in cluster",
-                "operationId": "sessions",
+                "summary": "Register cluster administrator",
+                "operationId": "registerClusterAdmin",
                 "parameters": [
                     {
                         "type": "string",
@@ -293,26 +377,26 @@ const docTemplate = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "boolean",
-                        "description": "Firstly try to find from Cache",
-                        "name": "cache",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
                         "description": "Entrypoint for cluster",
                         "name": "entrypoint",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "description": "Name, password, authentication kinds and OS user of administrator",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.adminRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/v1.sessionResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -320,12 +404,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/error.response"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/error.response"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -335,23 +413,28 @@ const docTemplate = `{
                 }
             }
         },
-        "/cluster/list": {
-            "get": {
-                "description": "Show all clusters with data",
-                "produces": [
-                    "application/json"
-                ],
+        "/cluster/:cluster/admin/:name": {
+            "delete": {
+                "description": "Delete administrator of cluster by name",
                 "tags": [
-                    "cluster list"
+                    "cluster admin"
                 ],
-                "summary": "Show clusters",
-                "operationId": "clusters",
+                "summary": "Remove cluster administrator",
+                "operationId": "removeClusterAdmin",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Firstly try to find from Cache",
-                        "name": "cache",
-                        "in": "query"
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of administrator",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
@@ -362,11 +445,8 @@ const docTemplate = `{
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/v1.clusterResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -374,12 +454,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/error.response"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/error.response"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -388,359 +462,5421 @@ const docTemplate = `{
                     }
                 }
             }
-        }
+        },
+        "/cluster/:cluster/admin/list": {
+            "get": {
+                "description": "Show all administrators of cluster",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cluster admin"
+                ],
+                "summary": "Show cluster administrators",
+                "operationId": "clusterAdmins",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.adminResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/connection": {
+            "delete": {
+                "description": "Disconnect list of connections in cluster",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "connection delete"
+                ],
+                "summary": "Disconnect connections",
+                "operationId": "deleteConnections",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "UUIDs of connections",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.connectionsDeleteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/connection/:connection": {
+            "delete": {
+                "description": "Disconnect connection in cluster",
+                "tags": [
+                    "connection delete"
+                ],
+                "summary": "Disconnect connection",
+                "operationId": "deleteConnection",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of connection",
+                        "name": "connection",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/connection/list": {
+            "get": {
+                "description": "Show all connections with identifiers for current cluster",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "connection list"
+                ],
+                "summary": "Show all connections in cluster",
+                "operationId": "connections",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Firstly try to find from Cache",
+                        "name": "cache",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.connectionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/counter/:counter/clear": {
+            "post": {
+                "description": "Reset accumulated values of resource consumption counter",
+                "tags": [
+                    "counter"
+                ],
+                "summary": "Clear counter values",
+                "operationId": "clearCounter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of counter",
+                        "name": "counter",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only values of object (user, infobase, etc.)",
+                        "name": "object",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/counter/:counter/values": {
+            "get": {
+                "description": "Show accumulated values of resource consumption counter for each object",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "counter"
+                ],
+                "summary": "Show counter values",
+                "operationId": "counterValues",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of counter",
+                        "name": "counter",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only values of object (user, infobase, etc.)",
+                        "name": "object",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.counterValueResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/counter/list": {
+            "get": {
+                "description": "Show all resource consumption counters of cluster",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "counter"
+                ],
+                "summary": "Show resource consumption counters",
+                "operationId": "counters",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.counterResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/infobase": {
+            "post": {
+                "description": "Register new infobase in cluster, database is created by DBMS if create_database is set",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "infobase"
+                ],
+                "summary": "Create infobase",
+                "operationId": "createInfobase",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Parameters of new infobase",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.infobaseCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Infobase"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/infobase/:infobase": {
+            "get": {
+                "description": "Show DBMS, locale, locks and security settings of infobase, infobase credentials are required by 1C",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "infobase"
+                ],
+                "summary": "Show infobase settings",
+                "operationId": "infobaseDetails",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of infobase",
+                        "name": "infobase",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.InfobaseDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove infobase from cluster, confirm must be equal to name of infobase.\nDatabase is kept unless drop_database or clear_database is set",
+                "tags": [
+                    "infobase"
+                ],
+                "summary": "Drop infobase",
+                "operationId": "dropInfobase",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of infobase",
+                        "name": "infobase",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of infobase as confirmation",
+                        "name": "confirm",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Drop database of infobase",
+                        "name": "drop_database",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Clear database of infobase",
+                        "name": "clear_database",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Change settings of infobase, only fields present in body are changed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "infobase"
+                ],
+                "summary": "Update infobase settings",
+                "operationId": "updateInfobase",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of infobase",
+                        "name": "infobase",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Settings to change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.infobaseUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.InfobaseDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/infobase/:infobase/backup": {
+            "post": {
+                "description": "Enqueue dump of infobase to backup directory, state of dump is got by job id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "infobase backup"
+                ],
+                "summary": "Backup infobase",
+                "operationId": "backup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of infobase",
+                        "name": "infobase",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Permission code of locked infobase, default one from config if empty",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.backupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/v1.jobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/infobase/:infobase/connection/list": {
+            "get": {
+                "description": "Show all connections with identifiers for current infobase in cluster",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "connection list infobase"
+                ],
+                "summary": "Show all connections in infobase",
+                "operationId": "connectionsByInfobase",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of infobase",
+                        "name": "infobase",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Firstly try to find from Cache",
+                        "name": "cache",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.connectionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/infobase/:infobase/lock": {
+            "post": {
+                "description": "Deny new sessions and scheduled jobs in infobase for a window of time",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "infobase lock"
+                ],
+                "summary": "Lock infobase",
+                "operationId": "lockInfobase",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of infobase",
+                        "name": "infobase",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Lock parameters, empty window means from now for an hour",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.lockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/infobase/:infobase/lock/list": {
+            "get": {
+                "description": "Show managed locks of infobase with sessions holding them, list may be narrowed to connection or session",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lock list infobase"
+                ],
+                "summary": "Show managed locks in infobase",
+                "operationId": "locks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of infobase",
+                        "name": "infobase",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of connection",
+                        "name": "connection",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of session",
+                        "name": "session",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Firstly try to find sessions from Cache",
+                        "name": "cache",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.lockResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/infobase/:infobase/restore": {
+            "post": {
+                "description": "Enqueue job which denies sessions to infobase, waits grace period while users see lock message,\nterminates remaining sessions, loads infobase from dump and allows sessions again even if load failed.\nDump must be in backup directory, confirm must be equal to name of infobase",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "infobase backup"
+                ],
+                "summary": "Restore infobase",
+                "operationId": "restore",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of infobase",
+                        "name": "infobase",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Dump file in backup directory, name of infobase as confirmation, permission code, lock message and grace period in seconds",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.restoreRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/v1.jobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/infobase/:infobase/safebackup": {
+            "post": {
+                "description": "Enqueue job which denies sessions to infobase, waits grace period while users see lock message,\nterminates remaining sessions, dumps infobase and allows sessions again even if dump failed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "infobase backup"
+                ],
+                "summary": "Safe backup infobase",
+                "operationId": "safebackup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of infobase",
+                        "name": "infobase",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Permission code, lock message and grace period in seconds, defaults from config if empty",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.safeBackupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/v1.jobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/infobase/:infobase/session/list": {
+            "get": {
+                "description": "Show all sessions with identifiers for current infobase in cluster",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "session list infobase"
+                ],
+                "summary": "Show all sessions in infobase",
+                "operationId": "sessionsByInfobase",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of infobase",
+                        "name": "infobase",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Firstly try to find from Cache",
+                        "name": "cache",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.sessionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/infobase/:infobase/unlock": {
+            "post": {
+                "description": "Allow sessions and scheduled jobs in infobase",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "infobase lock"
+                ],
+                "summary": "Unlock infobase",
+                "operationId": "unlockInfobase",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of infobase",
+                        "name": "infobase",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Permission code to set",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/v1.unlockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/infobase/list": {
+            "get": {
+                "description": "Show all infobases with identifiers for current cluster",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "infobase list"
+                ],
+                "summary": "Show all infobases in cluster",
+                "operationId": "infobases",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Firstly try to find from Cache",
+                        "name": "cache",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.infobaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/limit": {
+            "post": {
+                "description": "Add resource consumption limit to cluster",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "limit"
+                ],
+                "summary": "Create resource consumption limit",
+                "operationId": "createLimit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Name and settings of limit",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.limitCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Limit"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/limit/:name": {
+            "put": {
+                "description": "Change settings of resource consumption limit, all thresholds are written",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "limit"
+                ],
+                "summary": "Update resource consumption limit",
+                "operationId": "updateLimit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of limit",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Settings of limit",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.limitRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Limit"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete resource consumption limit of cluster by name",
+                "tags": [
+                    "limit"
+                ],
+                "summary": "Remove resource consumption limit",
+                "operationId": "removeLimit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of limit",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/limit/list": {
+            "get": {
+                "description": "Show all resource consumption limits of cluster",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "limit"
+                ],
+                "summary": "Show resource consumption limits",
+                "operationId": "limits",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.limitResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/manager/list": {
+            "get": {
+                "description": "Show all cluster managers with their processes for current cluster",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "manager list"
+                ],
+                "summary": "Show all managers in cluster",
+                "operationId": "managers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Firstly try to find from Cache",
+                        "name": "cache",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.managerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/process/list": {
+            "get": {
+                "description": "Show all working processes with load statistics for current cluster",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "process list"
+                ],
+                "summary": "Show all working processes in cluster",
+                "operationId": "processes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Firstly try to find from Cache",
+                        "name": "cache",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.processResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/profile": {
+            "post": {
+                "description": "Add security profile to cluster",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "Create security profile",
+                "operationId": "createProfile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Name and settings of profile",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.profileCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Profile"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/profile/:name": {
+            "put": {
+                "description": "Change settings of security profile, all settings are written",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "Update security profile",
+                "operationId": "updateProfile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of profile",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Settings of profile",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.profileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Profile"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete security profile of cluster by name",
+                "tags": [
+                    "profile"
+                ],
+                "summary": "Remove security profile",
+                "operationId": "removeProfile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of profile",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/profile/list": {
+            "get": {
+                "description": "Show all security profiles of cluster",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "Show security profiles",
+                "operationId": "profiles",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.profileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/rule/apply": {
+            "post": {
+                "description": "Apply assignment rules of all working servers of cluster, rac applies rules of the whole cluster at once;\nfull apply moves already running infobases, partial one affects only new connections",
+                "tags": [
+                    "rule"
+                ],
+                "summary": "Apply assignment rules",
+                "operationId": "applyRules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Full apply",
+                        "name": "full",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/server/:server/rule": {
+            "post": {
+                "description": "Add assignment rule to working server at position, rules below it are shifted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rule"
+                ],
+                "summary": "Insert assignment rule",
+                "operationId": "insertRule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of working server",
+                        "name": "server",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Settings of rule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ruleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Rule"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/server/:server/rule/:rule": {
+            "put": {
+                "description": "Change assignment rule of working server, all settings are written",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rule"
+                ],
+                "summary": "Update assignment rule",
+                "operationId": "updateRule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of working server",
+                        "name": "server",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of rule",
+                        "name": "rule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Settings of rule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ruleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Rule"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete assignment rule of working server",
+                "tags": [
+                    "rule"
+                ],
+                "summary": "Remove assignment rule",
+                "operationId": "removeRule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of working server",
+                        "name": "server",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of rule",
+                        "name": "rule",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/server/:server/rule/list": {
+            "get": {
+                "description": "Show all assignment (requirement) rules of working server in order of position",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rule"
+                ],
+                "summary": "Show assignment rules of working server",
+                "operationId": "rules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of working server",
+                        "name": "server",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ruleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/server/list": {
+            "get": {
+                "description": "Show all working servers with their limits for current cluster",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "server list"
+                ],
+                "summary": "Show all working servers in cluster",
+                "operationId": "servers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Firstly try to find from Cache",
+                        "name": "cache",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.serverResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/session": {
+            "delete": {
+                "description": "Terminate list of sessions in cluster",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "session delete"
+                ],
+                "summary": "Terminate sessions",
+                "operationId": "deleteSessions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "UUIDs of sessions",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.sessionsDeleteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/session/:session": {
+            "delete": {
+                "description": "Terminate session in cluster",
+                "tags": [
+                    "session delete"
+                ],
+                "summary": "Terminate session",
+                "operationId": "deleteSession",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of session",
+                        "name": "session",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/session/list": {
+            "get": {
+                "description": "Show all sessions with identifiers for current cluster",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "session list"
+                ],
+                "summary": "Show all sessions in cluster",
+                "operationId": "sessions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Firstly try to find from Cache",
+                        "name": "cache",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.sessionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/:cluster/session/watch": {
+            "get": {
+                "description": "Stream of server-sent events with sessions added, removed or changed significantly,\nall known sessions are sent as added ones first; sessions are polled once for all watchers of cluster",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "session"
+                ],
+                "summary": "Watch sessions of cluster",
+                "operationId": "watchSessions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.SessionEvent"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/cluster/list": {
+            "get": {
+                "description": "Show all clusters with data",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cluster list"
+                ],
+                "summary": "Show clusters",
+                "operationId": "clusters",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Firstly try to find from Cache",
+                        "name": "cache",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entrypoint for cluster",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.clusterResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/fleet/infobases": {
+            "get": {
+                "description": "Show infobases of all clusters of all ras entrypoints in registry, entrypoints are read concurrently;\nentrypoints and clusters which failed are listed in errors along with infobases of other ones",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "infobase"
+                ],
+                "summary": "Show infobases of fleet",
+                "operationId": "fleetInfobases",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Firstly try to find from Cache",
+                        "name": "usecache",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only entrypoints with tag",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only infobases with name",
+                        "name": "infobase",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.fleetInfobasesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/fleet/sessions": {
+            "get": {
+                "description": "Show sessions of all clusters of all ras entrypoints in registry, entrypoints are read concurrently;\nentrypoints and clusters which failed are listed in errors along with sessions of other ones",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "session"
+                ],
+                "summary": "Show sessions of fleet",
+                "operationId": "fleetSessions",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Firstly try to find from Cache",
+                        "name": "usecache",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only entrypoints with tag",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only sessions of infobase (name)",
+                        "name": "infobase",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only sessions of user (name)",
+                        "name": "user",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.fleetSessionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs/:id": {
+            "get": {
+                "description": "Show state of background job like backup",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "job"
+                ],
+                "summary": "Show job",
+                "operationId": "job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UUID of job",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.jobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/ras": {
+            "get": {
+                "description": "Show named ras entrypoints of registry, every route of /cluster and /agent\nis available as /ras/{ras}/cluster and /ras/{ras}/agent without entrypoint parameter",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ras"
+                ],
+                "summary": "Show ras entrypoints",
+                "operationId": "entrypoints",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.rasResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/reaper/audit": {
+            "get": {
+                "description": "Show the latest sessions terminated by reaper policies and why, newest first;\nin dry-run mode sessions are only found",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "session"
+                ],
+                "summary": "Show audit trail of reaper",
+                "operationId": "reaperAudit",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.reaperAuditResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/schedules": {
+            "get": {
+                "description": "Show scheduled backups with time of next run and outcome of last one",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "infobase backup"
+                ],
+                "summary": "Show schedules",
+                "operationId": "schedules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.schedulesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/vault": {
+            "get": {
+                "description": "Show entrypoints, clusters and infobases credentials are stored for, credentials themselves are never shown",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vault"
+                ],
+                "summary": "Show keys of vault",
+                "operationId": "vaultKeys",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.vaultResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Store new or rotate existing credentials of entrypoint (for all its clusters), cluster or infobase,\nthey are used when request has no credentials in headers; changes take effect at once",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vault"
+                ],
+                "summary": "Store credentials",
+                "operationId": "vaultSet",
+                "parameters": [
+                    {
+                        "description": "Key and credentials",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.vaultRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove stored credentials of entrypoint, cluster or infobase",
+                "tags": [
+                    "vault"
+                ],
+                "summary": "Remove credentials",
+                "operationId": "vaultRemove",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entrypoint",
+                        "name": "entrypoint",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of cluster",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "UUID of infobase",
+                        "name": "infobase",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/error.response"
+                        }
+                    }
+                }
+            }
+        }
     },
     "definitions": {
+        "entity.Admin": {
+            "type": "object",
+            "properties": {
+                "auth": {
+                    "type": "string",
+                    "example": "pwd"
+                },
+                "desc": {
+                    "type": "string",
+                    "example": "comments"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "osuser": {
+                    "type": "string",
+                    "example": "DOMAIN\\user"
+                }
+            }
+        },
+        "entity.Backup": {
+            "type": "object",
+            "properties": {
+                "cluster": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "created": {
+                    "type": "string",
+                    "example": "2023-08-10T14:04:43Z"
+                },
+                "duration": {
+                    "type": "number",
+                    "example": 1800.5
+                },
+                "ep": {
+                    "type": "string",
+                    "example": "1capp01:1545"
+                },
+                "ib": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "ibname": {
+                    "type": "string",
+                    "example": "ib"
+                },
+                "path": {
+                    "type": "string",
+                    "example": "1capp01_1541/ib/20230810_140443.dt"
+                },
+                "sha256": {
+                    "type": "string",
+                    "example": "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 1073741824
+                }
+            }
+        },
         "entity.Cluster": {
             "type": "object",
             "properties": {
-                "errth": {
+                "errth": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "exp": {
+                    "type": "integer",
+                    "example": 1200
+                },
+                "host": {
+                    "type": "string",
+                    "example": "localhost"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "kpp": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "lb": {
+                    "type": "string",
+                    "example": "perfomance"
+                },
+                "lt": {
+                    "type": "integer",
+                    "example": 3000
+                },
+                "mms": {
+                    "type": "integer",
+                    "example": 50000
+                },
+                "mmts": {
+                    "type": "integer",
+                    "example": 600
+                },
+                "name": {
+                    "type": "string",
+                    "example": "name"
+                },
+                "port": {
+                    "type": "string",
+                    "example": "1541"
+                },
+                "sftl": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "sl": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "entity.Connection": {
+            "type": "object",
+            "properties": {
+                "appid": {
+                    "type": "string",
+                    "example": "1CV8"
+                },
+                "blocked": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "cid": {
+                    "type": "integer",
+                    "example": 12345
+                },
+                "connected": {
+                    "type": "string",
+                    "example": "2023-08-10T11:40:55"
+                },
+                "host": {
+                    "type": "string",
+                    "example": "localhost"
+                },
+                "ib": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "proc": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "sid": {
+                    "type": "integer",
+                    "example": 12345
+                }
+            }
+        },
+        "entity.Counter": {
+            "type": "object",
+            "properties": {
+                "actses": {
+                    "type": "string",
+                    "example": "not-analyze"
+                },
+                "call": {
+                    "type": "string",
+                    "example": "analyze"
+                },
+                "colltime": {
+                    "type": "string",
+                    "example": "current-call"
+                },
+                "cpu": {
+                    "type": "string",
+                    "example": "analyze"
+                },
+                "dbmsbytes": {
+                    "type": "string",
+                    "example": "not-analyze"
+                },
+                "desc": {
+                    "type": "string",
+                    "example": "comments"
+                },
+                "duration": {
+                    "type": "string",
+                    "example": "analyze"
+                },
+                "durdbms": {
+                    "type": "string",
+                    "example": "not-analyze"
+                },
+                "filter": {
+                    "type": "string",
+                    "example": ""
+                },
+                "ftype": {
+                    "type": "string",
+                    "example": "all"
+                },
+                "group": {
+                    "type": "string",
+                    "example": "users"
+                },
+                "memory": {
+                    "type": "string",
+                    "example": "not-analyze"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "users_cpu"
+                },
+                "read": {
+                    "type": "string",
+                    "example": "not-analyze"
+                },
+                "service": {
+                    "type": "string",
+                    "example": "not-analyze"
+                },
+                "ses": {
+                    "type": "string",
+                    "example": "not-analyze"
+                },
+                "write": {
+                    "type": "string",
+                    "example": "not-analyze"
+                }
+            }
+        },
+        "entity.CounterValue": {
+            "type": "object",
+            "properties": {
+                "actses": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "call": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "cpu": {
+                    "type": "integer",
+                    "example": 800
+                },
+                "dbmsbytes": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "duration": {
+                    "type": "integer",
+                    "example": 1200
+                },
+                "durdbms": {
+                    "type": "integer",
+                    "example": 300
+                },
+                "memory": {
+                    "type": "integer",
+                    "example": 1048576
+                },
+                "object": {
+                    "type": "string",
+                    "example": "ivanov"
+                },
+                "read": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "service": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "ses": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "write": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "entity.Entrypoint": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "1capp01:1545"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "main"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "prod"
+                    ]
+                }
+            }
+        },
+        "entity.FleetError": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string",
+                    "example": "1capp01:1545"
+                },
+                "cluster": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "ep": {
+                    "type": "string",
+                    "example": "main"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "context deadline exceeded"
+                }
+            }
+        },
+        "entity.FleetInfobase": {
+            "type": "object",
+            "properties": {
+                "addr": {
+                    "type": "string",
+                    "example": "1capp01:1545"
+                },
+                "cluster": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "desc": {
+                    "type": "string",
+                    "example": "comments"
+                },
+                "ep": {
+                    "type": "string",
+                    "example": "main"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "name"
+                }
+            }
+        },
+        "entity.FleetSession": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "string",
+                    "example": "2023-08-10T14:04:43"
+                },
+                "addr": {
+                    "type": "string",
+                    "example": "1capp01:1545"
+                },
+                "appid": {
+                    "type": "string",
+                    "example": "1CV8"
+                },
+                "blockdb": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "blockls": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "bytes": {
+                    "type": "integer",
+                    "example": 12345
+                },
+                "bytes5m": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "bytesdb": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "bytesdb5m": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "calls": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "calls5m": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "cluster": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "conn": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "cpu": {
+                    "type": "integer",
+                    "example": 1234
+                },
+                "cpu5m": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "cpucur": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "dbproc": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "dbprocat": {
+                    "type": "string",
+                    "example": ""
+                },
+                "dbproci": {
+                    "type": "string",
+                    "example": ""
+                },
+                "dur": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "dur5m": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "durcur": {
+                    "type": "integer",
+                    "example": 80
+                },
+                "durcurdb": {
+                    "type": "integer",
+                    "example": 80
+                },
+                "durdb": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "durdb5m": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "dursvc": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "dursvc5m": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "dursvccur": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "ep": {
+                    "type": "string",
+                    "example": "main"
+                },
+                "hib": {
+                    "type": "string",
+                    "example": "yes/no"
+                },
+                "hibterm": {
+                    "type": "integer",
+                    "example": 3600
+                },
+                "hibtm": {
+                    "type": "integer",
+                    "example": 1200
+                },
+                "host": {
+                    "type": "string",
+                    "example": "Host"
+                },
+                "ib": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "ibname": {
+                    "type": "string",
+                    "example": "buh"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "loc": {
+                    "type": "string",
+                    "example": "ru"
+                },
+                "mem": {
+                    "type": "integer",
+                    "example": 123456
+                },
+                "mem5m": {
+                    "type": "integer",
+                    "example": 1234
+                },
+                "memcur": {
+                    "type": "integer",
+                    "example": 12345
+                },
+                "proc": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "read": {
+                    "type": "integer",
+                    "example": 56789
+                },
+                "read5m": {
+                    "type": "integer",
+                    "example": 56
+                },
+                "readcur": {
+                    "type": "integer",
+                    "example": 5678
+                },
+                "sep": {
+                    "type": "string",
+                    "example": ""
+                },
+                "sid": {
+                    "type": "integer",
+                    "example": 12345
+                },
+                "started": {
+                    "type": "string",
+                    "example": "2023-08-10T14:04:43"
+                },
+                "svc": {
+                    "type": "string",
+                    "example": "Name"
+                },
+                "uname": {
+                    "type": "string",
+                    "example": "UserName"
+                },
+                "write": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "write5m": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "writecur": {
+                    "type": "integer",
+                    "example": 123
+                }
+            }
+        },
+        "entity.Infobase": {
+            "type": "object",
+            "properties": {
+                "desc": {
+                    "type": "string",
+                    "example": "comments"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "name"
+                }
+            }
+        },
+        "entity.InfobaseDetails": {
+            "type": "object",
+            "properties": {
+                "dateoff": {
+                    "type": "integer",
+                    "example": 2000
+                },
+                "dbms": {
+                    "type": "string",
+                    "example": "MSSQLServer"
+                },
+                "dbname": {
+                    "type": "string",
+                    "example": "buh"
+                },
+                "dbsrv": {
+                    "type": "string",
+                    "example": "sql01"
+                },
+                "dbuser": {
+                    "type": "string",
+                    "example": "sa"
+                },
+                "deniedfrom": {
+                    "type": "string",
+                    "example": "2023-08-10T22:00:00"
+                },
+                "deniedmsg": {
+                    "type": "string",
+                    "example": "Infobase is locked"
+                },
+                "deniedparam": {
+                    "type": "string",
+                    "example": ""
+                },
+                "deniedto": {
+                    "type": "string",
+                    "example": "2023-08-10T23:00:00"
+                },
+                "desc": {
+                    "type": "string",
+                    "example": "comments"
+                },
+                "esm": {
+                    "type": "string",
+                    "example": ""
+                },
+                "esmreq": {
+                    "type": "string",
+                    "example": "yes/no"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "licdist": {
+                    "type": "string",
+                    "example": "allow"
+                },
+                "loc": {
+                    "type": "string",
+                    "example": "ru_RU"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "name"
+                },
+                "permcode": {
+                    "type": "string",
+                    "example": "12345"
+                },
+                "reservewp": {
+                    "type": "string",
+                    "example": "yes/no"
+                },
+                "safeprof": {
+                    "type": "string",
+                    "example": ""
+                },
+                "secprof": {
+                    "type": "string",
+                    "example": ""
+                },
+                "sesdeny": {
+                    "type": "string",
+                    "example": "on/off"
+                },
+                "sjdeny": {
+                    "type": "string",
+                    "example": "on/off"
+                },
+                "sl": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "entity.Job": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "string",
+                    "example": "2023-08-10T14:04:43Z"
+                },
+                "error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "exitcode": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "finished": {
+                    "type": "string",
+                    "example": "2023-08-10T14:34:43Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "input": {
+                    "type": "string",
+                    "example": "/backups/ib_20230810_140443.dt"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "backup"
+                },
+                "log": {
+                    "type": "string",
+                    "example": "Выгрузка информационной базы успешно завершена"
+                },
+                "output": {
+                    "type": "string",
+                    "example": "/backups/ib_20230810_140443.dt"
+                },
+                "started": {
+                    "type": "string",
+                    "example": "2023-08-10T14:04:43Z"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.JobStatus"
+                        }
+                    ],
+                    "example": "running"
+                },
+                "steps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.JobStep"
+                    }
+                }
+            }
+        },
+        "entity.JobStatus": {
+            "type": "string",
+            "enum": [
+                "queued",
+                "running",
+                "succeeded",
+                "failed",
+                "skipped"
+            ],
+            "x-enum-varnames": [
+                "JobQueued",
+                "JobRunning",
+                "JobSucceeded",
+                "JobFailed",
+                "JobSkipped"
+            ]
+        },
+        "entity.JobStep": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "finished": {
+                    "type": "string",
+                    "example": "2023-08-10T14:05:13Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "kick"
+                },
+                "started": {
+                    "type": "string",
+                    "example": "2023-08-10T14:04:43Z"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.JobStatus"
+                        }
+                    ],
+                    "example": "succeeded"
+                }
+            }
+        },
+        "entity.Limit": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "interrupt-current-call"
+                },
+                "actses": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "call": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "counter": {
+                    "type": "string",
+                    "example": "calls"
+                },
+                "cpu": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "dbmsbytes": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "desc": {
+                    "type": "string",
+                    "example": "comments"
+                },
+                "duration": {
+                    "type": "integer",
+                    "example": 60
+                },
+                "durdbms": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "errmsg": {
+                    "type": "string",
+                    "example": "call is too long"
+                },
+                "memory": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "name": {
+                    "type": "string",
+                    "example": "heavy_calls"
+                },
+                "read": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "service": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "ses": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "write": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "entity.Lock": {
+            "type": "object",
+            "properties": {
+                "conn": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "desc": {
+                    "type": "string",
+                    "example": "БД(сеанс ,УППБД,разделяемая)"
+                },
+                "locked": {
+                    "type": "string",
+                    "example": "2023-08-10T14:04:43"
+                },
+                "object": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "owner": {
+                    "$ref": "#/definitions/entity.Session"
+                },
+                "session": {
+                    "type": "string",
+                    "example": "UUID"
+                }
+            }
+        },
+        "entity.Manager": {
+            "type": "object",
+            "properties": {
+                "desc": {
+                    "type": "string",
+                    "example": "Main manager"
+                },
+                "host": {
+                    "type": "string",
+                    "example": "localhost"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "pid": {
+                    "type": "string",
+                    "example": "3820"
+                },
+                "port": {
+                    "type": "string",
+                    "example": "1541"
+                },
+                "using": {
+                    "type": "string",
+                    "example": "normal"
+                }
+            }
+        },
+        "entity.Process": {
+            "type": "object",
+            "properties": {
+                "avgback": {
+                    "type": "number",
+                    "example": 0.001
+                },
+                "avgcall": {
+                    "type": "number",
+                    "example": 0.25
+                },
+                "avgdb": {
+                    "type": "number",
+                    "example": 0.009
+                },
+                "avglock": {
+                    "type": "number",
+                    "example": 0.001
+                },
+                "avgsrv": {
+                    "type": "number",
+                    "example": 0.2
+                },
+                "avgthreads": {
+                    "type": "number",
+                    "example": 0.08
+                },
+                "avperf": {
+                    "type": "integer",
+                    "example": 184
+                },
+                "capacity": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "conns": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "enabled": {
+                    "type": "string",
+                    "example": "yes/no"
+                },
+                "host": {
+                    "type": "string",
+                    "example": "localhost"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "memexc": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "memsize": {
+                    "type": "integer",
+                    "example": 512000
+                },
+                "pid": {
+                    "type": "string",
+                    "example": "5176"
+                },
+                "port": {
+                    "type": "string",
+                    "example": "1560"
+                },
+                "reserve": {
+                    "type": "string",
+                    "example": "yes/no"
+                },
+                "running": {
+                    "type": "string",
+                    "example": "yes/no"
+                },
+                "selsize": {
+                    "type": "integer",
+                    "example": 8437
+                },
+                "started": {
+                    "type": "string",
+                    "example": "2023-08-10T14:04:43"
+                },
+                "use": {
+                    "type": "string",
+                    "example": "used"
+                }
+            }
+        },
+        "entity.Profile": {
+            "type": "object",
+            "properties": {
+                "allmodext": {
+                    "type": "string",
+                    "example": "yes"
+                },
+                "config": {
+                    "type": "string",
+                    "example": "no"
+                },
+                "crypto": {
+                    "type": "string",
+                    "example": "yes"
+                },
+                "desc": {
+                    "type": "string",
+                    "example": "comments"
+                },
+                "modavail": {
+                    "type": "string",
+                    "example": ""
+                },
+                "modnotavail": {
+                    "type": "string",
+                    "example": ""
+                },
+                "name": {
+                    "type": "string",
+                    "example": "restricted"
+                },
+                "priv": {
+                    "type": "string",
+                    "example": "no"
+                },
+                "privroles": {
+                    "type": "string",
+                    "example": ""
+                },
+                "rightext": {
+                    "type": "string",
+                    "example": "no"
+                },
+                "rightroles": {
+                    "type": "string",
+                    "example": ""
+                }
+            }
+        },
+        "entity.ReapRecord": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "string",
+                    "example": "2023-08-10T11:04:43Z"
+                },
+                "appid": {
+                    "type": "string",
+                    "example": "1cv8c"
+                },
+                "cluster": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "dry_run": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "ep": {
+                    "type": "string",
+                    "example": "1capp01:1545"
+                },
+                "error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "host": {
+                    "type": "string",
+                    "example": "pc-101"
+                },
+                "ib": {
+                    "type": "string",
+                    "example": "buh"
+                },
+                "policy": {
+                    "type": "string",
+                    "example": "buh-idle"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "idle for 3h0m0s, rule 1: idle 2h0m0s"
+                },
+                "session": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "sid": {
+                    "type": "integer",
+                    "example": 12345
+                },
+                "time": {
+                    "type": "string",
+                    "example": "2023-08-10T14:04:43Z"
+                },
+                "uname": {
+                    "type": "string",
+                    "example": "ivanov"
+                }
+            }
+        },
+        "entity.RetentionPolicy": {
+            "type": "object",
+            "properties": {
+                "daily": {
+                    "type": "integer",
+                    "example": 14
+                },
+                "last": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "weekly": {
+                    "type": "integer",
+                    "example": 8
+                }
+            }
+        },
+        "entity.Rule": {
+            "type": "object",
+            "properties": {
+                "appext": {
+                    "type": "string",
+                    "example": ""
+                },
+                "ibname": {
+                    "type": "string",
+                    "example": "zup"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "objtype": {
+                    "type": "string",
+                    "example": ""
+                },
+                "priority": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "ruletype": {
+                    "type": "string",
+                    "example": "always"
+                }
+            }
+        },
+        "entity.Schedule": {
+            "type": "object",
+            "properties": {
+                "cluster": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "credentials": {
+                    "type": "string",
+                    "example": "buh"
+                },
+                "cron": {
+                    "type": "string",
+                    "example": "0 2 * * *"
+                },
+                "ep": {
+                    "type": "string",
+                    "example": "1capp01:1545"
+                },
+                "ib": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "last_error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "last_job": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "last_run": {
+                    "type": "string",
+                    "example": "2023-08-10T02:00:00Z"
+                },
+                "last_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.JobStatus"
+                        }
+                    ],
+                    "example": "succeeded"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "buh-nightly"
+                },
+                "next": {
+                    "type": "string",
+                    "example": "2023-08-11T02:00:00Z"
+                },
+                "retention": {
+                    "$ref": "#/definitions/entity.RetentionPolicy"
+                },
+                "safe": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "entity.Server": {
+            "type": "object",
+            "properties": {
+                "ahost": {
+                    "type": "string",
+                    "example": "localhost"
+                },
+                "aport": {
+                    "type": "string",
+                    "example": "1540"
+                },
+                "connlim": {
+                    "type": "integer",
+                    "example": 128
+                },
+                "cport": {
+                    "type": "string",
+                    "example": "1541"
+                },
+                "critmem": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "dedicate": {
+                    "type": "string",
+                    "example": "none"
+                },
+                "iblim": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "id": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "memlim": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Central server"
+                },
+                "ports": {
+                    "type": "string",
+                    "example": "1560:1591"
+                },
+                "restart": {
+                    "type": "string",
+                    "example": ""
+                },
+                "safecall": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "safewpmem": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "spn": {
+                    "type": "string",
+                    "example": ""
+                },
+                "tmpmem": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "tmpmemlim": {
+                    "type": "integer",
+                    "example": 300
+                },
+                "using": {
+                    "type": "string",
+                    "example": "main"
+                }
+            }
+        },
+        "entity.Session": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "string",
+                    "example": "2023-08-10T14:04:43"
+                },
+                "appid": {
+                    "type": "string",
+                    "example": "1CV8"
+                },
+                "blockdb": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "blockls": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "bytes": {
+                    "type": "integer",
+                    "example": 12345
+                },
+                "bytes5m": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "bytesdb": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "bytesdb5m": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "calls": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "calls5m": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "conn": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "cpu": {
+                    "type": "integer",
+                    "example": 1234
+                },
+                "cpu5m": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "cpucur": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "dbproc": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "dbprocat": {
+                    "type": "string",
+                    "example": ""
+                },
+                "dbproci": {
+                    "type": "string",
+                    "example": ""
+                },
+                "dur": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "dur5m": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "durcur": {
+                    "type": "integer",
+                    "example": 80
+                },
+                "durcurdb": {
+                    "type": "integer",
+                    "example": 80
+                },
+                "durdb": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "durdb5m": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "dursvc": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "dursvc5m": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "dursvccur": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "hib": {
+                    "type": "string",
+                    "example": "yes/no"
+                },
+                "hibterm": {
+                    "type": "integer",
+                    "example": 3600
+                },
+                "hibtm": {
+                    "type": "integer",
+                    "example": 1200
+                },
+                "host": {
+                    "type": "string",
+                    "example": "Host"
+                },
+                "ib": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "loc": {
+                    "type": "string",
+                    "example": "ru"
+                },
+                "mem": {
+                    "type": "integer",
+                    "example": 123456
+                },
+                "mem5m": {
+                    "type": "integer",
+                    "example": 1234
+                },
+                "memcur": {
+                    "type": "integer",
+                    "example": 12345
+                },
+                "proc": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "read": {
+                    "type": "integer",
+                    "example": 56789
+                },
+                "read5m": {
+                    "type": "integer",
+                    "example": 56
+                },
+                "readcur": {
+                    "type": "integer",
+                    "example": 5678
+                },
+                "sep": {
+                    "type": "string",
+                    "example": ""
+                },
+                "sid": {
+                    "type": "integer",
+                    "example": 12345
+                },
+                "started": {
+                    "type": "string",
+                    "example": "2023-08-10T14:04:43"
+                },
+                "svc": {
+                    "type": "string",
+                    "example": "Name"
+                },
+                "uname": {
+                    "type": "string",
+                    "example": "UserName"
+                },
+                "write": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "write5m": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "writecur": {
+                    "type": "integer",
+                    "example": 123
+                }
+            }
+        },
+        "entity.SessionEvent": {
+            "type": "object",
+            "properties": {
+                "session": {
+                    "$ref": "#/definitions/entity.Session"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.SessionEventType"
+                        }
+                    ],
+                    "example": "added"
+                }
+            }
+        },
+        "entity.SessionEventType": {
+            "type": "string",
+            "enum": [
+                "added",
+                "removed",
+                "changed"
+            ],
+            "x-enum-varnames": [
+                "SessionAdded",
+                "SessionRemoved",
+                "SessionChanged"
+            ]
+        },
+        "entity.VaultKey": {
+            "type": "object",
+            "properties": {
+                "cluster": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "entrypoint": {
+                    "type": "string",
+                    "example": "1capp01:1545"
+                },
+                "infobase": {
+                    "type": "string",
+                    "example": "UUID"
+                }
+            }
+        },
+        "error.response": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "message"
+                }
+            }
+        },
+        "v1.adminRequest": {
+            "type": "object",
+            "required": [
+                "auth",
+                "name"
+            ],
+            "properties": {
+                "auth": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "pwd",
+                        "os"
+                    ]
+                },
+                "desc": {
+                    "type": "string",
+                    "example": "comments"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "os_user": {
+                    "type": "string",
+                    "example": "DOMAIN\\user"
+                },
+                "pwd": {
+                    "type": "string",
+                    "example": "secret"
+                }
+            }
+        },
+        "v1.adminResponse": {
+            "type": "object",
+            "properties": {
+                "admins": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Admin"
+                    }
+                }
+            }
+        },
+        "v1.backupRequest": {
+            "type": "object",
+            "properties": {
+                "permission_code": {
+                    "type": "string",
+                    "example": "12345"
+                }
+            }
+        },
+        "v1.backupsResponse": {
+            "type": "object",
+            "properties": {
+                "backups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Backup"
+                    }
+                }
+            }
+        },
+        "v1.clusterInsertRequest": {
+            "type": "object",
+            "required": [
+                "host",
+                "port"
+            ],
+            "properties": {
+                "errors_count_threshold": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0,
+                    "example": 0
+                },
+                "expiration_timeout": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1200
+                },
+                "host": {
+                    "type": "string",
+                    "example": "1capp01"
+                },
+                "kill_problem_processes": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "lifetime_limit": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 86400
+                },
+                "load_balancing_mode": {
+                    "type": "string",
+                    "enum": [
+                        "performance",
+                        "memory"
+                    ],
+                    "example": "performance"
+                },
+                "max_memory_size": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 0
+                },
+                "max_memory_time_limit": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 300
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Main cluster"
+                },
+                "port": {
+                    "type": "integer",
+                    "maximum": 65535,
+                    "minimum": 1,
+                    "example": 1541
+                },
+                "security_level": {
+                    "type": "integer",
+                    "maximum": 3,
+                    "minimum": 0,
+                    "example": 0
+                },
+                "session_fault_tolerance_level": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 0
+                }
+            }
+        },
+        "v1.clusterResponse": {
+            "type": "object",
+            "properties": {
+                "clusters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Cluster"
+                    }
+                }
+            }
+        },
+        "v1.clusterUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "errors_count_threshold": {
                     "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0,
                     "example": 0
                 },
-                "exp": {
+                "expiration_timeout": {
                     "type": "integer",
+                    "minimum": 0,
                     "example": 1200
                 },
-                "host": {
-                    "type": "string",
-                    "example": "localhost"
-                },
-                "id": {
-                    "type": "string",
-                    "example": "UUID"
+                "kill_problem_processes": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "kpp": {
+                "lifetime_limit": {
                     "type": "integer",
-                    "example": 0
+                    "minimum": 0,
+                    "example": 86400
                 },
-                "lb": {
+                "load_balancing_mode": {
                     "type": "string",
-                    "example": "perfomance"
-                },
-                "lt": {
-                    "type": "integer",
-                    "example": 3000
+                    "enum": [
+                        "performance",
+                        "memory"
+                    ],
+                    "example": "performance"
                 },
-                "mms": {
+                "max_memory_size": {
                     "type": "integer",
-                    "example": 50000
+                    "minimum": 0,
+                    "example": 0
                 },
-                "mmts": {
+                "max_memory_time_limit": {
                     "type": "integer",
-                    "example": 600
+                    "minimum": 0,
+                    "example": 300
                 },
                 "name": {
                     "type": "string",
-                    "example": "name"
-                },
-                "port": {
-                    "type": "string",
-                    "example": "1541"
+                    "example": "Main cluster"
                 },
-                "sftl": {
+                "security_level": {
                     "type": "integer",
+                    "maximum": 3,
+                    "minimum": 0,
                     "example": 0
                 },
-                "sl": {
+                "session_fault_tolerance_level": {
                     "type": "integer",
+                    "minimum": 0,
                     "example": 0
                 }
             }
         },
-        "entity.Connection": {
+        "v1.connectionResponse": {
             "type": "object",
             "properties": {
-                "appid": {
-                    "type": "string",
-                    "example": "1CV8"
+                "connections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Connection"
+                    }
+                }
+            }
+        },
+        "v1.connectionsDeleteRequest": {
+            "type": "object",
+            "required": [
+                "connections"
+            ],
+            "properties": {
+                "connections": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "UUID"
+                    ]
+                }
+            }
+        },
+        "v1.counterResponse": {
+            "type": "object",
+            "properties": {
+                "counters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Counter"
+                    }
+                }
+            }
+        },
+        "v1.counterValueResponse": {
+            "type": "object",
+            "properties": {
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.CounterValue"
+                    }
+                }
+            }
+        },
+        "v1.fleetInfobasesResponse": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.FleetError"
+                    }
                 },
-                "blocked": {
-                    "type": "integer",
-                    "example": 0
+                "infobases": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.FleetInfobase"
+                    }
+                }
+            }
+        },
+        "v1.fleetSessionsResponse": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.FleetError"
+                    }
                 },
-                "cid": {
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.FleetSession"
+                    }
+                }
+            }
+        },
+        "v1.infobaseCreateRequest": {
+            "type": "object",
+            "required": [
+                "db_name",
+                "db_server",
+                "dbms",
+                "name"
+            ],
+            "properties": {
+                "create_database": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "date_offset": {
                     "type": "integer",
-                    "example": 12345
+                    "minimum": 0,
+                    "example": 2000
                 },
-                "connected": {
+                "db_name": {
                     "type": "string",
-                    "example": "2023-08-10T11:40:55"
+                    "example": "buh_test"
                 },
-                "host": {
+                "db_pwd": {
                     "type": "string",
-                    "example": "localhost"
+                    "example": "secret"
                 },
-                "ib": {
+                "db_server": {
                     "type": "string",
-                    "example": "UUID"
+                    "example": "sql01"
                 },
-                "id": {
+                "db_user": {
                     "type": "string",
-                    "example": "UUID"
+                    "example": "postgres"
                 },
-                "proc": {
+                "dbms": {
                     "type": "string",
-                    "example": "UUID"
+                    "enum": [
+                        "MSSQLServer",
+                        "PostgreSQL",
+                        "IBMDB2",
+                        "OracleDatabase"
+                    ],
+                    "example": "PostgreSQL"
                 },
-                "sid": {
-                    "type": "integer",
-                    "example": 12345
+                "desc": {
+                    "type": "string",
+                    "example": "comments"
+                },
+                "locale": {
+                    "type": "string",
+                    "example": "ru_RU"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "buh_test"
+                },
+                "scheduled_jobs_deny": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "entity.Infobase": {
+        "v1.infobaseResponse": {
+            "type": "object",
+            "properties": {
+                "infobases": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Infobase"
+                    }
+                }
+            }
+        },
+        "v1.infobaseUpdateRequest": {
             "type": "object",
             "properties": {
+                "db_name": {
+                    "type": "string",
+                    "example": "buh"
+                },
+                "db_pwd": {
+                    "type": "string",
+                    "example": "secret"
+                },
+                "db_server": {
+                    "type": "string",
+                    "example": "sql01"
+                },
+                "db_user": {
+                    "type": "string",
+                    "example": "sa"
+                },
+                "dbms": {
+                    "type": "string",
+                    "example": "MSSQLServer"
+                },
+                "denied_from": {
+                    "type": "string",
+                    "example": "2023-08-10T22:00:00Z"
+                },
+                "denied_message": {
+                    "type": "string",
+                    "example": "Infobase is locked"
+                },
+                "denied_parameter": {
+                    "type": "string",
+                    "example": ""
+                },
+                "denied_to": {
+                    "type": "string",
+                    "example": "2023-08-10T23:00:00Z"
+                },
                 "desc": {
                     "type": "string",
                     "example": "comments"
                 },
-                "id": {
+                "license_distribution": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "permission_code": {
                     "type": "string",
-                    "example": "UUID"
+                    "example": "12345"
                 },
-                "name": {
+                "reserve_working_processes": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "safe_mode_security_profile": {
                     "type": "string",
-                    "example": "name"
+                    "example": ""
+                },
+                "scheduled_jobs_deny": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "security_profile": {
+                    "type": "string",
+                    "example": ""
+                },
+                "sessions_deny": {
+                    "type": "boolean",
+                    "example": false
                 }
             }
         },
-        "entity.Session": {
+        "v1.jobResponse": {
             "type": "object",
             "properties": {
-                "active": {
+                "job": {
+                    "$ref": "#/definitions/entity.Job"
+                }
+            }
+        },
+        "v1.limitCreateRequest": {
+            "type": "object",
+            "required": [
+                "action",
+                "counter",
+                "name"
+            ],
+            "properties": {
+                "action": {
                     "type": "string",
-                    "example": "2023-08-10T14:04:43"
+                    "enum": [
+                        "none",
+                        "set-low-priority-thread",
+                        "interrupt-current-call",
+                        "interrupt-session"
+                    ],
+                    "example": "interrupt-current-call"
                 },
-                "appid": {
+                "call": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 0
+                },
+                "counter": {
                     "type": "string",
-                    "example": "1CV8"
+                    "example": "calls"
                 },
-                "blockdb": {
+                "cpu_time": {
                     "type": "integer",
+                    "minimum": 0,
                     "example": 0
                 },
-                "blockls": {
+                "dbms_bytes": {
                     "type": "integer",
+                    "minimum": 0,
                     "example": 0
                 },
-                "bytes": {
-                    "type": "integer",
-                    "example": 12345
+                "desc": {
+                    "type": "string",
+                    "example": "comments"
                 },
-                "bytes5m": {
+                "duration": {
                     "type": "integer",
-                    "example": 123
+                    "minimum": 0,
+                    "example": 60
                 },
-                "bytesdb": {
+                "duration_dbms": {
                     "type": "integer",
-                    "example": 123
+                    "minimum": 0,
+                    "example": 0
                 },
-                "bytesdb5m": {
-                    "type": "integer",
-                    "example": 12
+                "error_message": {
+                    "type": "string",
+                    "example": "call is too long"
                 },
-                "calls": {
+                "memory": {
                     "type": "integer",
-                    "example": 5
+                    "minimum": 0,
+                    "example": 0
                 },
-                "calls5m": {
+                "name": {
+                    "type": "string",
+                    "example": "heavy_calls"
+                },
+                "number_of_active_sessions": {
                     "type": "integer",
-                    "example": 2
+                    "minimum": 0,
+                    "example": 0
                 },
-                "conn": {
-                    "type": "string",
-                    "example": "UUID"
+                "number_of_sessions": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 0
                 },
-                "cpu": {
+                "read": {
                     "type": "integer",
-                    "example": 1234
+                    "minimum": 0,
+                    "example": 0
                 },
-                "cpu5m": {
+                "service": {
                     "type": "integer",
-                    "example": 12
+                    "minimum": 0,
+                    "example": 0
                 },
-                "cpucur": {
+                "write": {
                     "type": "integer",
-                    "example": 123
+                    "minimum": 0,
+                    "example": 0
+                }
+            }
+        },
+        "v1.limitRequest": {
+            "type": "object",
+            "required": [
+                "action",
+                "counter"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "none",
+                        "set-low-priority-thread",
+                        "interrupt-current-call",
+                        "interrupt-session"
+                    ],
+                    "example": "interrupt-current-call"
                 },
-                "dbproc": {
+                "call": {
                     "type": "integer",
-                    "example": 123
+                    "minimum": 0,
+                    "example": 0
                 },
-                "dbprocat": {
+                "counter": {
                     "type": "string",
-                    "example": ""
+                    "example": "calls"
                 },
-                "dbproci": {
+                "cpu_time": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 0
+                },
+                "dbms_bytes": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 0
+                },
+                "desc": {
                     "type": "string",
-                    "example": ""
+                    "example": "comments"
                 },
-                "dur": {
+                "duration": {
                     "type": "integer",
-                    "example": 100
+                    "minimum": 0,
+                    "example": 60
                 },
-                "dur5m": {
+                "duration_dbms": {
                     "type": "integer",
-                    "example": 100
+                    "minimum": 0,
+                    "example": 0
                 },
-                "durcur": {
-                    "type": "integer",
-                    "example": 80
+                "error_message": {
+                    "type": "string",
+                    "example": "call is too long"
                 },
-                "durcurdb": {
+                "memory": {
                     "type": "integer",
-                    "example": 80
+                    "minimum": 0,
+                    "example": 0
                 },
-                "durdb": {
+                "number_of_active_sessions": {
                     "type": "integer",
-                    "example": 100
+                    "minimum": 0,
+                    "example": 0
                 },
-                "durdb5m": {
+                "number_of_sessions": {
                     "type": "integer",
-                    "example": 100
+                    "minimum": 0,
+                    "example": 0
                 },
-                "dursvc": {
+                "read": {
                     "type": "integer",
+                    "minimum": 0,
                     "example": 0
                 },
-                "dursvc5m": {
+                "service": {
                     "type": "integer",
+                    "minimum": 0,
                     "example": 0
                 },
-                "dursvccur": {
+                "write": {
                     "type": "integer",
+                    "minimum": 0,
                     "example": 0
+                }
+            }
+        },
+        "v1.limitResponse": {
+            "type": "object",
+            "properties": {
+                "limits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Limit"
+                    }
+                }
+            }
+        },
+        "v1.lockRequest": {
+            "type": "object",
+            "required": [
+                "permission_code"
+            ],
+            "properties": {
+                "denied_from": {
+                    "type": "string",
+                    "example": "2023-08-10T22:00:00Z"
                 },
-                "hib": {
+                "denied_to": {
                     "type": "string",
-                    "example": "yes/no"
+                    "example": "2023-08-10T23:00:00Z"
                 },
-                "hibterm": {
-                    "type": "integer",
-                    "example": 3600
+                "message": {
+                    "type": "string",
+                    "example": "Infobase is locked for backup"
                 },
-                "hibtm": {
-                    "type": "integer",
-                    "example": 1200
+                "permission_code": {
+                    "type": "string",
+                    "example": "12345"
                 },
-                "host": {
+                "scheduled_jobs_deny": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "v1.lockResponse": {
+            "type": "object",
+            "properties": {
+                "locks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Lock"
+                    }
+                }
+            }
+        },
+        "v1.managerResponse": {
+            "type": "object",
+            "properties": {
+                "managers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Manager"
+                    }
+                }
+            }
+        },
+        "v1.processResponse": {
+            "type": "object",
+            "properties": {
+                "processes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Process"
+                    }
+                }
+            }
+        },
+        "v1.profileCreateRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "all_modules_extension": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "config": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "crypto": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "desc": {
                     "type": "string",
-                    "example": "Host"
+                    "example": "comments"
                 },
-                "ib": {
+                "modules_available_for_extension": {
                     "type": "string",
-                    "example": "UUID"
+                    "example": ""
                 },
-                "id": {
+                "modules_not_available_for_extension": {
                     "type": "string",
-                    "example": "UUID"
+                    "example": ""
                 },
-                "loc": {
+                "name": {
                     "type": "string",
-                    "example": "ru"
+                    "example": "restricted"
                 },
-                "mem": {
-                    "type": "integer",
-                    "example": 123456
+                "priv": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "mem5m": {
-                    "type": "integer",
-                    "example": 1234
+                "privileged_mode_roles": {
+                    "type": "string",
+                    "example": ""
                 },
-                "memcur": {
-                    "type": "integer",
-                    "example": 12345
+                "right_extension": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "proc": {
+                "right_extension_definition_roles": {
                     "type": "string",
-                    "example": "UUID"
+                    "example": ""
+                }
+            }
+        },
+        "v1.profileRequest": {
+            "type": "object",
+            "properties": {
+                "all_modules_extension": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "read": {
-                    "type": "integer",
-                    "example": 56789
+                "config": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "read5m": {
-                    "type": "integer",
-                    "example": 56
+                "crypto": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "readcur": {
-                    "type": "integer",
-                    "example": 5678
+                "desc": {
+                    "type": "string",
+                    "example": "comments"
                 },
-                "sep": {
+                "modules_available_for_extension": {
                     "type": "string",
                     "example": ""
                 },
-                "sid": {
-                    "type": "integer",
-                    "example": 12345
+                "modules_not_available_for_extension": {
+                    "type": "string",
+                    "example": ""
                 },
-                "started": {
+                "priv": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "privileged_mode_roles": {
                     "type": "string",
-                    "example": "2023-08-10T14:04:43"
+                    "example": ""
                 },
-                "svc": {
+                "right_extension": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "right_extension_definition_roles": {
                     "type": "string",
-                    "example": "Name"
+                    "example": ""
+                }
+            }
+        },
+        "v1.profileResponse": {
+            "type": "object",
+            "properties": {
+                "profiles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Profile"
+                    }
+                }
+            }
+        },
+        "v1.rasResponse": {
+            "type": "object",
+            "properties": {
+                "entrypoints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Entrypoint"
+                    }
+                }
+            }
+        },
+        "v1.reaperAuditResponse": {
+            "type": "object",
+            "properties": {
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.ReapRecord"
+                    }
+                }
+            }
+        },
+        "v1.restoreRequest": {
+            "type": "object",
+            "required": [
+                "confirm",
+                "file"
+            ],
+            "properties": {
+                "confirm": {
+                    "type": "string",
+                    "example": "ib"
                 },
-                "uname": {
+                "file": {
                     "type": "string",
-                    "example": "UserName"
+                    "example": "ib_20230810_140443.dt"
                 },
-                "write": {
+                "grace_period": {
                     "type": "integer",
-                    "example": 123
+                    "minimum": 0,
+                    "example": 300
                 },
-                "write5m": {
-                    "type": "integer",
-                    "example": 123
+                "message": {
+                    "type": "string",
+                    "example": "Infobase is locked for restore"
                 },
-                "writecur": {
-                    "type": "integer",
-                    "example": 123
+                "permission_code": {
+                    "type": "string",
+                    "example": "12345"
                 }
             }
         },
-        "error.response": {
+        "v1.ruleRequest": {
             "type": "object",
+            "required": [
+                "rule_type"
+            ],
             "properties": {
-                "error": {
+                "application_ext": {
                     "type": "string",
-                    "example": "message"
+                    "example": ""
+                },
+                "infobase_name": {
+                    "type": "string",
+                    "example": "zup"
+                },
+                "object_type": {
+                    "type": "string",
+                    "example": ""
+                },
+                "position": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 0
+                },
+                "priority": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 0
+                },
+                "rule_type": {
+                    "type": "string",
+                    "enum": [
+                        "auto",
+                        "always",
+                        "never"
+                    ],
+                    "example": "always"
                 }
             }
         },
-        "v1.clusterResponse": {
+        "v1.ruleResponse": {
             "type": "object",
             "properties": {
-                "clusters": {
+                "rules": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/entity.Cluster"
+                        "$ref": "#/definitions/entity.Rule"
                     }
                 }
             }
         },
-        "v1.connectionResponse": {
+        "v1.safeBackupRequest": {
             "type": "object",
             "properties": {
-                "connections": {
+                "grace_period": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 300
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Infobase is locked for backup"
+                },
+                "permission_code": {
+                    "type": "string",
+                    "example": "12345"
+                }
+            }
+        },
+        "v1.schedulesResponse": {
+            "type": "object",
+            "properties": {
+                "schedules": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/entity.Connection"
+                        "$ref": "#/definitions/entity.Schedule"
                     }
                 }
             }
         },
-        "v1.infobaseResponse": {
+        "v1.serverResponse": {
             "type": "object",
             "properties": {
-                "infobases": {
+                "servers": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/entity.Infobase"
+                        "$ref": "#/definitions/entity.Server"
                     }
                 }
             }
@@ -755,6 +5891,73 @@ const docTemplate = `{
                     }
                 }
             }
+        },
+        "v1.sessionsDeleteRequest": {
+            "type": "object",
+            "required": [
+                "sessions"
+            ],
+            "properties": {
+                "sessions": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "UUID"
+                    ]
+                }
+            }
+        },
+        "v1.unlockRequest": {
+            "type": "object",
+            "properties": {
+                "permission_code": {
+                    "type": "string",
+                    "example": "12345"
+                }
+            }
+        },
+        "v1.vaultRequest": {
+            "type": "object",
+            "required": [
+                "entrypoint",
+                "login"
+            ],
+            "properties": {
+                "cluster": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "entrypoint": {
+                    "type": "string",
+                    "example": "1capp01:1545"
+                },
+                "infobase": {
+                    "type": "string",
+                    "example": "UUID"
+                },
+                "login": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "secret"
+                }
+            }
+        },
+        "v1.vaultResponse": {
+            "type": "object",
+            "properties": {
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.VaultKey"
+                    }
+                }
+            }
         }
     }
 }`
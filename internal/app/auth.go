@@ -0,0 +1,55 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/antonmisa/1cctl/config"
+	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/auth"
+)
+
+// ErrVaultWithoutAuth - without authentication anyone could read stored credentials through vault.
+var ErrVaultWithoutAuth = errors.New("vault requires auth to be enabled")
+
+// authenticator - api keys of config and keys of JWKS file when it is set.
+func authenticator(cfg *config.Config) (*auth.Authenticator, error) {
+	keys := make([]auth.APIKey, 0, len(cfg.Auth.Keys))
+
+	for _, k := range cfg.Auth.Keys {
+		role, err := auth.ParseRole(k.Role)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k.Name, err)
+		}
+
+		keys = append(keys, auth.APIKey{Name: k.Name, Hash: k.Hash, Role: role})
+	}
+
+	var jwks auth.JWKS
+
+	if cfg.Auth.JWKS != "" {
+		data, err := os.ReadFile(cfg.Auth.JWKS)
+		if err != nil {
+			return nil, fmt.Errorf("os.ReadFile: %w", err)
+		}
+
+		jwks, err = auth.ParseJWKS(data)
+		if err != nil {
+			return nil, fmt.Errorf("auth.ParseJWKS: %w", err)
+		}
+	}
+
+	grants := make(map[string]auth.Scope, len(cfg.Auth.Grants))
+
+	for principal, gs := range cfg.Auth.Grants {
+		scope := make(auth.Scope, 0, len(gs))
+
+		for _, g := range gs {
+			scope = append(scope, auth.Grant{Entrypoints: g.Entrypoints, Clusters: g.Clusters, Infobases: g.Infobases})
+		}
+
+		grants[principal] = scope
+	}
+
+	return auth.New(keys, jwks, cfg.Auth.Issuer, cfg.Auth.Audience, cfg.Auth.RoleClaim, grants), nil
+}
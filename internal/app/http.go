@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/antonmisa/1cctl/internal/app/tracing"
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
 	"os"
@@ -12,10 +13,20 @@ import (
 
 	"github.com/antonmisa/1cctl/config"
 	v1 "github.com/antonmisa/1cctl/internal/controller/http/v1"
+	"github.com/antonmisa/1cctl/internal/entity"
 	"github.com/antonmisa/1cctl/internal/usecase"
 	ucbackup "github.com/antonmisa/1cctl/internal/usecase/backup"
 	uccache "github.com/antonmisa/1cctl/internal/usecase/cache"
+	uccatalog "github.com/antonmisa/1cctl/internal/usecase/catalog"
+	ucfleet "github.com/antonmisa/1cctl/internal/usecase/fleet"
+	ucjobs "github.com/antonmisa/1cctl/internal/usecase/jobs"
+	ucmetrics "github.com/antonmisa/1cctl/internal/usecase/metrics"
 	ucpipe "github.com/antonmisa/1cctl/internal/usecase/pipe"
+	ucreaper "github.com/antonmisa/1cctl/internal/usecase/reaper"
+	ucregistry "github.com/antonmisa/1cctl/internal/usecase/registry"
+	ucscheduler "github.com/antonmisa/1cctl/internal/usecase/scheduler"
+	ucvault "github.com/antonmisa/1cctl/internal/usecase/vault"
+	ucwatcher "github.com/antonmisa/1cctl/internal/usecase/watcher"
 	"github.com/antonmisa/1cctl/pkg/cache"
 	"github.com/antonmisa/1cctl/pkg/httpserver"
 	"github.com/antonmisa/1cctl/pkg/logger"
@@ -38,19 +49,141 @@ func Run(cfg *config.Config) {
 		l.Fatal(fmt.Errorf("app - Run - pipe.New: %w", err))
 	}
 
+	rp := ucmetrics.NewPiper(p, racEntrypoints(cfg))
+	prometheus.MustRegister(rp)
+
 	cb, err := ucbackup.New(cfg.App.PathTo1C)
 
 	if err != nil {
 		l.Fatal(fmt.Errorf("app - Run - ucbackup.New: %w", err))
 	}
 
+	k, err := uccatalog.New(cfg.Backup.Dir)
+	if err != nil {
+		l.Fatal(fmt.Errorf("app - Run - uccatalog.New: %w", err))
+	}
+
+	j := ucjobs.New(cfg.Backup.Workers, cfg.Backup.Queue)
+	defer j.Close()
+
+	cp := ucpipe.New(rp)
+
 	// Use case
 	ctrlUseCase := usecase.New(
 		uccache.New(c),
-		ucpipe.New(p),
+		cp,
 		cb,
+		j,
+		k,
+		usecase.BackupDir(cfg.Backup.Dir),
+		usecase.LockCode(cfg.App.LockCode),
+		usecase.GracePeriod(cfg.Backup.GracePeriod),
+		usecase.KickRetries(cfg.Backup.KickRetries, cfg.Backup.KickInterval),
+		usecase.LockWindow(cfg.Backup.LockWindow),
+		usecase.Retention(entity.RetentionPolicy{
+			KeepLast:   cfg.Backup.Retention.KeepLast,
+			KeepDaily:  cfg.Backup.Retention.KeepDaily,
+			KeepWeekly: cfg.Backup.Retention.KeepWeekly,
+		}),
 	)
 
+	// Scheduler
+	tasks, err := schedulerTasks(cfg)
+	if err != nil {
+		l.Fatal(fmt.Errorf("app - Run - schedulerTasks: %w", err))
+	}
+
+	s, err := ucscheduler.New(ctrlUseCase, l, cfg.Scheduler.State, tasks)
+	if err != nil {
+		l.Fatal(fmt.Errorf("app - Run - ucscheduler.New: %w", err))
+	}
+
+	s.Start()
+	defer s.Stop()
+
+	// Counters export
+	if cfg.Counters.Export {
+		targets, err := counterTargets(cfg)
+		if err != nil {
+			l.Fatal(fmt.Errorf("app - Run - counterTargets: %w", err))
+		}
+
+		prometheus.MustRegister(ucmetrics.NewCounterCollector(ctrlUseCase, l, targets, cfg.Counters.Timeout))
+	}
+
+	// Sessions export
+	if cfg.Exporter.Enable {
+		targets, err := exporterTargets(cfg)
+		if err != nil {
+			l.Fatal(fmt.Errorf("app - Run - exporterTargets: %w", err))
+		}
+
+		e := ucmetrics.NewExporter(ctrlUseCase, l, targets, cfg.Exporter.Interval, cfg.Exporter.Timeout)
+		prometheus.MustRegister(e)
+
+		e.Start()
+		defer e.Stop()
+	}
+
+	// Idle sessions reaper
+	policies, err := reaperPolicies(cfg)
+	if err != nil {
+		l.Fatal(fmt.Errorf("app - Run - reaperPolicies: %w", err))
+	}
+
+	reaper := ucreaper.New(ctrlUseCase, l, policies, cfg.Reaper.Interval, cfg.Reaper.DryRun, cfg.Reaper.Audit)
+
+	if cfg.Reaper.Enable {
+		reaper.Start()
+		defer reaper.Stop()
+	}
+
+	// Registry of entrypoints
+	entrypoints, err := registryEntrypoints(cfg)
+	if err != nil {
+		l.Fatal(fmt.Errorf("app - Run - registryEntrypoints: %w", err))
+	}
+
+	reg, err := ucregistry.New(entrypoints, cfg.App.Allowlist)
+	if err != nil {
+		l.Fatal(fmt.Errorf("app - Run - ucregistry.New: %w", err))
+	}
+
+	// Authentication
+	var routerOpts []v1.Option
+
+	if cfg.Auth.Enable {
+		a, err := authenticator(cfg)
+		if err != nil {
+			l.Fatal(fmt.Errorf("app - Run - authenticator: %w", err))
+		}
+
+		routerOpts = append(routerOpts, v1.Auth(a))
+	}
+
+	// Vault of credentials
+	var vault usecase.CtrlVault
+
+	if cfg.Vault.Enable {
+		if !cfg.Auth.Enable {
+			l.Fatal(fmt.Errorf("app - Run: %w", ErrVaultWithoutAuth))
+		}
+
+		v, err := ucvault.New(cfg.Vault.Path, cfg.Vault.Key)
+		if err != nil {
+			l.Fatal(fmt.Errorf("app - Run - ucvault.New: %w", err))
+		}
+
+		vault = v
+		routerOpts = append(routerOpts, v1.Vault(v))
+	}
+
+	// Fleet-wide views
+	fleet := ucfleet.New(ctrlUseCase, reg, vault, cfg.Fleet.Timeout)
+
+	// Sessions watch
+	w := ucwatcher.New(cp, l, cfg.Watch.Interval, cfg.Watch.Timeout)
+
 	// Trace start
 	tp, err := tracing.JaegerTraceProvider(cfg.Trace.Endpoint)
 	if err != nil {
@@ -64,7 +197,8 @@ func Run(cfg *config.Config) {
 
 	// HTTP Server
 	handler := gin.New()
-	v1.NewRouter(handler, l, ctrlUseCase, tp.Tracer("1ctrl_main_trace"))
+	routerOpts = append(routerOpts, v1.Registry(reg), v1.Fleet(fleet), v1.Schedules(s), v1.Watch(w), v1.Reaper(reaper))
+	v1.NewRouter(handler, l, ctrlUseCase, tp.Tracer("1ctrl_main_trace"), routerOpts...)
 	httpServer := httpserver.New(handler, httpserver.Port(cfg.HTTP.Port))
 
 	// Waiting signal
@@ -78,7 +212,9 @@ func Run(cfg *config.Config) {
 		l.Error(fmt.Errorf("app - RunHTTP - httpServer.Notify: %w", err))
 	}
 
-	// Shutdown
+	// Shutdown, streams of watchers are closed first so server does not wait for them
+	w.Stop()
+
 	err = httpServer.Shutdown()
 	if err != nil {
 		l.Error(fmt.Errorf("app - RunHTTP - httpServer.Shutdown: %w", err))
@@ -0,0 +1,87 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/antonmisa/1cctl/config"
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucmetrics "github.com/antonmisa/1cctl/internal/usecase/metrics"
+)
+
+// exporterTargets - resolving credentials of entrypoints which clusters are polled by exporter.
+func exporterTargets(cfg *config.Config) ([]ucmetrics.ExporterTarget, error) {
+	targets := make([]ucmetrics.ExporterTarget, 0, len(cfg.Exporter.Entrypoints))
+
+	for _, ee := range cfg.Exporter.Entrypoints {
+		target := ucmetrics.ExporterTarget{
+			Entrypoint: ee.Entrypoint,
+		}
+
+		if ee.Credentials != "" {
+			cred, ok := cfg.Credentials[ee.Credentials]
+			if !ok {
+				return nil, fmt.Errorf("%s: %s: %w", ee.Entrypoint, ee.Credentials, ErrCredentialsNotFound)
+			}
+
+			target.ClusterCred = entity.Credentials{Name: cred.Cluster.Login, Pwd: cred.Cluster.Password}
+		}
+
+		targets = append(targets, target)
+	}
+
+	return targets, nil
+}
+
+// counterTargets - resolving credentials of clusters which counters are exported.
+func counterTargets(cfg *config.Config) ([]ucmetrics.CounterTarget, error) {
+	targets := make([]ucmetrics.CounterTarget, 0, len(cfg.Counters.Targets))
+
+	for _, ct := range cfg.Counters.Targets {
+		target := ucmetrics.CounterTarget{
+			Entrypoint: ct.Entrypoint,
+			Cluster:    entity.Cluster{ID: ct.Cluster},
+			Counters:   ct.Counters,
+		}
+
+		if ct.Credentials != "" {
+			cred, ok := cfg.Credentials[ct.Credentials]
+			if !ok {
+				return nil, fmt.Errorf("%s: %s: %w", ct.Cluster, ct.Credentials, ErrCredentialsNotFound)
+			}
+
+			target.ClusterCred = entity.Credentials{Name: cred.Cluster.Login, Pwd: cred.Cluster.Password}
+		}
+
+		targets = append(targets, target)
+	}
+
+	return targets, nil
+}
+
+// racEntrypoints - values of entrypoint label of rac calls, entrypoints of registry are labelled by name
+// and other configured ones by address.
+func racEntrypoints(cfg *config.Config) map[string]string {
+	labels := make(map[string]string)
+
+	for _, ee := range cfg.Exporter.Entrypoints {
+		labels[ee.Entrypoint] = ee.Entrypoint
+	}
+
+	for _, ct := range cfg.Counters.Targets {
+		labels[ct.Entrypoint] = ct.Entrypoint
+	}
+
+	for _, sc := range cfg.Scheduler.Schedules {
+		labels[sc.Entrypoint] = sc.Entrypoint
+	}
+
+	for _, rp := range cfg.Reaper.Policies {
+		labels[rp.Entrypoint] = rp.Entrypoint
+	}
+
+	for _, ce := range cfg.App.Entrypoints {
+		labels[ce.Address] = ce.Name
+	}
+
+	return labels
+}
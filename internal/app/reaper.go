@@ -0,0 +1,55 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/antonmisa/1cctl/config"
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucreaper "github.com/antonmisa/1cctl/internal/usecase/reaper"
+)
+
+var ErrReaperIdleInvalid = errors.New("idle of reaper rule must be positive")
+
+// reaperPolicies - resolving credentials of configured policies, common exclusions are added to each policy.
+// Rule without idle would match every session so it is rejected.
+func reaperPolicies(cfg *config.Config) ([]ucreaper.Policy, error) {
+	policies := make([]ucreaper.Policy, 0, len(cfg.Reaper.Policies))
+
+	for _, rp := range cfg.Reaper.Policies {
+		policy := ucreaper.Policy{
+			Name:          rp.Name,
+			Entrypoint:    rp.Entrypoint,
+			Cluster:       entity.Cluster{ID: rp.Cluster},
+			Rules:         make([]ucreaper.Rule, 0, len(rp.Rules)),
+			ExcludeAppIDs: append(append([]string{}, cfg.Reaper.Exclude.AppIDs...), rp.Exclude.AppIDs...),
+			ExcludeUsers:  append(append([]string{}, cfg.Reaper.Exclude.Users...), rp.Exclude.Users...),
+		}
+
+		for i, rule := range rp.Rules {
+			if rule.Idle <= 0 {
+				return nil, fmt.Errorf("%s: rule %d: %w", rp.Name, i+1, ErrReaperIdleInvalid)
+			}
+
+			policy.Rules = append(policy.Rules, ucreaper.Rule{
+				Infobase:   rule.Infobase,
+				Idle:       rule.Idle,
+				AppIDs:     rule.AppIDs,
+				Hibernated: rule.Hibernated,
+			})
+		}
+
+		if rp.Credentials != "" {
+			cred, ok := cfg.Credentials[rp.Credentials]
+			if !ok {
+				return nil, fmt.Errorf("%s: %s: %w", rp.Name, rp.Credentials, ErrCredentialsNotFound)
+			}
+
+			policy.ClusterCred = entity.Credentials{Name: cred.Cluster.Login, Pwd: cred.Cluster.Password}
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
@@ -0,0 +1,55 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/config"
+)
+
+func TestReaperPolicies(t *testing.T) {
+	cases := []struct {
+		name  string
+		rules []config.ReaperRule
+		errIs error
+	}{
+		{
+			name:  "Success",
+			rules: []config.ReaperRule{{Infobase: "buh", Idle: 2 * time.Hour}},
+		},
+		{
+			name:  "Error rule wo idle",
+			rules: []config.ReaperRule{{Infobase: "buh", Idle: 2 * time.Hour}, {Infobase: "zup"}},
+			errIs: ErrReaperIdleInvalid,
+		},
+		{
+			name:  "Error negative idle",
+			rules: []config.ReaperRule{{Idle: -time.Minute}},
+			errIs: ErrReaperIdleInvalid,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &config.Config{}
+			cfg.Reaper.Policies = []config.ReaperPolicy{{Name: "idle", Entrypoint: "1capp01:1545", Cluster: "1234-5678", Rules: tc.rules}}
+
+			policies, err := reaperPolicies(cfg)
+			if tc.errIs != nil {
+				require.ErrorIs(t, err, tc.errIs)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, policies, 1)
+			require.Len(t, policies[0].Rules, len(tc.rules))
+		})
+	}
+}
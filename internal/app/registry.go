@@ -0,0 +1,34 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/antonmisa/1cctl/config"
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+// registryEntrypoints - resolving default cluster credentials of configured entrypoints.
+func registryEntrypoints(cfg *config.Config) ([]entity.Entrypoint, error) {
+	entrypoints := make([]entity.Entrypoint, 0, len(cfg.App.Entrypoints))
+
+	for _, ce := range cfg.App.Entrypoints {
+		ep := entity.Entrypoint{
+			Name:    ce.Name,
+			Address: ce.Address,
+			Tags:    ce.Tags,
+		}
+
+		if ce.Credentials != "" {
+			cred, ok := cfg.Credentials[ce.Credentials]
+			if !ok {
+				return nil, fmt.Errorf("%s: %s: %w", ce.Name, ce.Credentials, ErrCredentialsNotFound)
+			}
+
+			ep.ClusterCred = entity.Credentials{Name: cred.Cluster.Login, Pwd: cred.Cluster.Password}
+		}
+
+		entrypoints = append(entrypoints, ep)
+	}
+
+	return entrypoints, nil
+}
@@ -0,0 +1,53 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/antonmisa/1cctl/config"
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucscheduler "github.com/antonmisa/1cctl/internal/usecase/scheduler"
+)
+
+var ErrCredentialsNotFound = errors.New("credentials not found")
+
+// schedulerTasks - resolving credentials of configured schedules.
+func schedulerTasks(cfg *config.Config) ([]ucscheduler.Task, error) {
+	tasks := make([]ucscheduler.Task, 0, len(cfg.Scheduler.Schedules))
+
+	for _, sc := range cfg.Scheduler.Schedules {
+		task := ucscheduler.Task{
+			Schedule: entity.Schedule{
+				Name:        sc.Name,
+				Cron:        sc.Cron,
+				Entrypoint:  sc.Entrypoint,
+				ClusterID:   sc.Cluster,
+				InfobaseID:  sc.Infobase,
+				Credentials: sc.Credentials,
+				Safe:        sc.Safe,
+			},
+		}
+
+		if sc.Retention != nil {
+			task.Retention = &entity.RetentionPolicy{
+				KeepLast:   sc.Retention.KeepLast,
+				KeepDaily:  sc.Retention.KeepDaily,
+				KeepWeekly: sc.Retention.KeepWeekly,
+			}
+		}
+
+		if sc.Credentials != "" {
+			cred, ok := cfg.Credentials[sc.Credentials]
+			if !ok {
+				return nil, fmt.Errorf("%s: %s: %w", sc.Name, sc.Credentials, ErrCredentialsNotFound)
+			}
+
+			task.ClusterCred = entity.Credentials{Name: cred.Cluster.Login, Pwd: cred.Cluster.Password}
+			task.InfobaseCred = entity.Credentials{Name: cred.Infobase.Login, Pwd: cred.Infobase.Password}
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
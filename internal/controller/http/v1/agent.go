@@ -0,0 +1,149 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/codes"
+
+	v1e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+)
+
+type agentAdminNameRequest struct {
+	Name string `uri:"name"  binding:"required"  example:"admin"`
+}
+
+// @Summary     Show agent administrators
+// @Description Show all administrators of central server agent, agent credentials are passed in headers agent-login and agent-password
+// @ID          agentAdmins
+// @Tags  	    agent admin
+// @Produce     json
+// @Param       entrypoint  query    string         true 	"Entrypoint for agent"
+// @Success     200 {object} adminResponse
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /agent/admin/list [get]
+func (r *ctrlRoutes) agentAdmins(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "agentAdmins")
+	defer span.End()
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	agentCred, _ := c.MustGet(common.AgentCred).(entity.Credentials)
+
+	span.AddEvent("get list of agent admins")
+
+	admins, err := r.c.AgentAdmins(ctx, entrypoint, agentCred)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - agentAdmins")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, adminResponse{admins})
+}
+
+// @Summary     Register agent administrator
+// @Description Add administrator to central server agent, first administrator may be added without credentials
+// @ID          registerAgentAdmin
+// @Tags  	    agent admin
+// @Accept      json
+// @Param       entrypoint  query    string         true 	"Entrypoint for agent"
+// @Param       request     body     adminRequest 	true 	"Name, password, authentication kinds and OS user of administrator"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /agent/admin [post]
+func (r *ctrlRoutes) registerAgentAdmin(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "registerAgentAdmin")
+	defer span.End()
+
+	var body adminRequest
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - registerAgentAdmin")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	agentCred, _ := c.MustGet(common.AgentCred).(entity.Credentials)
+
+	span.AddEvent("register agent admin")
+
+	err := r.c.RegisterAgentAdmin(ctx, entrypoint, agentCred, body.options())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - registerAgentAdmin")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary     Remove agent administrator
+// @Description Delete administrator of central server agent by name
+// @ID          removeAgentAdmin
+// @Tags  	    agent admin
+// @Param		name	    path	 string			true	"Name of administrator"
+// @Param       entrypoint  query    string         true 	"Entrypoint for agent"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /agent/admin/:name [delete]
+func (r *ctrlRoutes) removeAgentAdmin(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "removeAgentAdmin")
+	defer span.End()
+
+	var request agentAdminNameRequest
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - removeAgentAdmin")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	agentCred, _ := c.MustGet(common.AgentCred).(entity.Credentials)
+
+	span.AddEvent("remove agent admin")
+
+	err := r.c.RemoveAgentAdmin(ctx, entrypoint, agentCred, request.Name)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - removeAgentAdmin")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
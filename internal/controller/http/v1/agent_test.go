@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func TestAgentAdminRoute(t *testing.T) {
+	agentCred := entity.Credentials{Name: "agent", Pwd: "apwd"}
+
+	cases := []struct {
+		name          string
+		method        string
+		uri           string
+		body          string
+		mockMethod    string
+		mockArg       any
+		ctrlMockError error
+		code          int
+		retVal        string
+	}{
+		{
+			name:   "Error wo entrypoint",
+			method: http.MethodGet,
+			uri:    "/v1/agent/admin/list",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"bad request\"}",
+		},
+		{
+			name:       "Success list",
+			method:     http.MethodGet,
+			uri:        "/v1/agent/admin/list?entrypoint=1capp01:1545",
+			mockMethod: "AgentAdmins",
+			code:       http.StatusOK,
+			retVal:     "{\"admins\":[{\"name\":\"agent\",\"auth\":\"pwd\",\"osuser\":\"\",\"desc\":\"\"}]}",
+		},
+		{
+			name:          "Error list",
+			method:        http.MethodGet,
+			uri:           "/v1/agent/admin/list?entrypoint=1capp01:1545",
+			mockMethod:    "AgentAdmins",
+			ctrlMockError: errors.New("rac error"),
+			code:          http.StatusInternalServerError,
+			retVal:        "{\"error\":\"internal problems\"}",
+		},
+		{
+			name:       "Success register",
+			method:     http.MethodPost,
+			uri:        "/v1/agent/admin?entrypoint=1capp01:1545",
+			body:       "{\"name\":\"operator\",\"pwd\":\"secret\",\"auth\":[\"pwd\"]}",
+			mockMethod: "RegisterAgentAdmin",
+			mockArg:    entity.AdminOptions{Name: "operator", Pwd: "secret", Auth: "pwd"},
+			code:       http.StatusNoContent,
+		},
+		{
+			name:       "Success remove",
+			method:     http.MethodDelete,
+			uri:        "/v1/agent/admin/operator?entrypoint=1capp01:1545",
+			mockMethod: "RemoveAgentAdmin",
+			mockArg:    "operator",
+			code:       http.StatusNoContent,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			anyCtx := mock.MatchedBy(func(ctx context.Context) bool { return true })
+
+			switch tc.mockMethod {
+			case "AgentAdmins":
+				var admins []entity.Admin
+
+				if tc.ctrlMockError == nil {
+					admins = []entity.Admin{{Name: "agent", Auth: "pwd"}}
+				}
+
+				ctrlMock.On("AgentAdmins", anyCtx, "1capp01:1545", agentCred).
+					Return(admins, tc.ctrlMockError)
+			case "RegisterAgentAdmin", "RemoveAgentAdmin":
+				ctrlMock.On(tc.mockMethod, anyCtx, "1capp01:1545", agentCred, tc.mockArg).
+					Return(tc.ctrlMockError)
+			}
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, strings.NewReader(tc.body))
+			req.Header.Set("agent-login", agentCred.Name)
+			req.Header.Set("agent-password", agentCred.Pwd)
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,420 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/codes"
+
+	v1e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+)
+
+type clusterUpdateRequest struct {
+	Name                       *string `json:"name"                           example:"Main cluster"`
+	ExpirationTimeout          *int    `json:"expiration_timeout"             binding:"omitempty,min=0"  example:"1200"`
+	LifetimeLimit              *int    `json:"lifetime_limit"                 binding:"omitempty,min=0"  example:"86400"`
+	MaxMemorySize              *int    `json:"max_memory_size"                binding:"omitempty,min=0"  example:"0"`
+	MaxMemoryTimeLimit         *int    `json:"max_memory_time_limit"          binding:"omitempty,min=0"  example:"300"`
+	SecurityLevel              *int    `json:"security_level"                 binding:"omitempty,min=0,max=3"  example:"0"`
+	SessionFaultToleranceLevel *int    `json:"session_fault_tolerance_level"  binding:"omitempty,min=0"  example:"0"`
+	LoadBalancingMode          *string `json:"load_balancing_mode"            binding:"omitempty,oneof=performance memory"  example:"performance"`
+	ErrorsCountThreshold       *int    `json:"errors_count_threshold"         binding:"omitempty,min=0,max=100"  example:"0"`
+	KillProblemProcesses       *bool   `json:"kill_problem_processes"         example:"true"`
+}
+
+// @Summary     Update cluster settings
+// @Description Change settings of cluster, only fields present in body are changed
+// @ID          updateCluster
+// @Tags  	    cluster
+// @Accept      json
+// @Produce     json
+// @Param		cluster	    path	 string					true	"UUID of cluster"
+// @Param       entrypoint  query    string         		true 	"Entrypoint for cluster"
+// @Param       request     body     clusterUpdateRequest 	true 	"Settings to change"
+// @Success     200 {object} entity.Cluster
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster [patch]
+func (r *ctrlRoutes) updateCluster(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "updateCluster")
+	defer span.End()
+
+	var (
+		request requestWoInfobase
+		body    clusterUpdateRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - updateCluster")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - updateCluster")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("update cluster")
+
+	cluster, err := r.c.UpdateCluster(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, body.options())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - updateCluster")
+
+		if errors.Is(err, usecase.ErrClusterNotFound) {
+			v1e.ErrorResponse(c, http.StatusNotFound, "cluster not found")
+
+			return
+		}
+
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, cluster)
+}
+
+func (u clusterUpdateRequest) options() entity.ClusterUpdateOptions {
+	return entity.ClusterUpdateOptions{
+		Name:                       u.Name,
+		ExpirationTimeout:          u.ExpirationTimeout,
+		LifetimeLimit:              u.LifetimeLimit,
+		MaxMemorySize:              u.MaxMemorySize,
+		MaxMemoryTimeLimit:         u.MaxMemoryTimeLimit,
+		SecurityLevel:              u.SecurityLevel,
+		SessionFaultToleranceLevel: u.SessionFaultToleranceLevel,
+		LoadBalancingMode:          u.LoadBalancingMode,
+		ErrorsCountThreshold:       u.ErrorsCountThreshold,
+		KillProblemProcesses:       u.KillProblemProcesses,
+	}
+}
+
+type clusterInsertRequest struct {
+	Host string `json:"host"  binding:"required"                 example:"1capp01"`
+	Port int    `json:"port"  binding:"required,min=1,max=65535"  example:"1541"`
+	clusterUpdateRequest
+}
+
+// @Summary     Register cluster
+// @Description Register new cluster on central server agent, agent credentials are passed in headers agent-login and agent-password
+// @ID          insertCluster
+// @Tags  	    cluster
+// @Accept      json
+// @Produce     json
+// @Param       entrypoint  query    string         		true 	"Entrypoint for cluster"
+// @Param       request     body     clusterInsertRequest 	true 	"Host and port of cluster manager and cluster settings"
+// @Success     201 {object} entity.Cluster
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster [post]
+func (r *ctrlRoutes) insertCluster(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "insertCluster")
+	defer span.End()
+
+	var body clusterInsertRequest
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - insertCluster")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	agentCred, _ := c.MustGet(common.AgentCred).(entity.Credentials)
+
+	opts := entity.ClusterInsertOptions{
+		Host:                 body.Host,
+		Port:                 body.Port,
+		ClusterUpdateOptions: body.options(),
+	}
+
+	span.AddEvent("insert cluster")
+
+	cluster, err := r.c.InsertCluster(ctx, entrypoint, agentCred, opts)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - insertCluster")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusCreated, cluster)
+}
+
+// @Summary     Unregister cluster
+// @Description Remove cluster from central server agent, agent credentials are passed in headers agent-login and agent-password
+// @ID          removeCluster
+// @Tags  	    cluster
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster [delete]
+func (r *ctrlRoutes) removeCluster(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "removeCluster")
+	defer span.End()
+
+	var request requestWoInfobase
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - removeCluster")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	agentCred, _ := c.MustGet(common.AgentCred).(entity.Credentials)
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("remove cluster")
+
+	err := r.c.RemoveCluster(ctx, entrypoint, agentCred, entity.Cluster{ID: request.Cluster}, clusterCred)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - removeCluster")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+type adminResponse struct {
+	Admins []entity.Admin `json:"admins"`
+}
+
+// @Summary     Show cluster administrators
+// @Description Show all administrators of cluster
+// @ID          clusterAdmins
+// @Tags  	    cluster admin
+// @Produce     json
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Success     200 {object} adminResponse
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/admin/list [get]
+func (r *ctrlRoutes) clusterAdmins(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "clusterAdmins")
+	defer span.End()
+
+	var request requestWoInfobase
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - clusterAdmins")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("get list of cluster admins")
+
+	admins, err := r.c.ClusterAdmins(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - clusterAdmins")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, adminResponse{admins})
+}
+
+type adminRequest struct {
+	Name   string   `json:"name"     binding:"required"                          example:"admin"`
+	Pwd    string   `json:"pwd"                                                  example:"secret"`
+	Auth   []string `json:"auth"     binding:"required,min=1,dive,oneof=pwd os"  example:"pwd,os"`
+	OSUser string   `json:"os_user"                                              example:"DOMAIN\\user"`
+	Desc   string   `json:"desc"                                                 example:"comments"`
+}
+
+// @Summary     Register cluster administrator
+// @Description Add administrator to cluster, first administrator may be added without credentials
+// @ID          registerClusterAdmin
+// @Tags  	    cluster admin
+// @Accept      json
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Param       request     body     adminRequest 	true 	"Name, password, authentication kinds and OS user of administrator"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/admin [post]
+func (r *ctrlRoutes) registerClusterAdmin(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "registerClusterAdmin")
+	defer span.End()
+
+	var (
+		request requestWoInfobase
+		body    adminRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - registerClusterAdmin")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - registerClusterAdmin")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("register cluster admin")
+
+	err := r.c.RegisterClusterAdmin(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, body.options())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - registerClusterAdmin")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (a adminRequest) options() entity.AdminOptions {
+	return entity.AdminOptions{
+		Name:   a.Name,
+		Pwd:    a.Pwd,
+		Auth:   strings.Join(a.Auth, ","),
+		OSUser: a.OSUser,
+		Desc:   a.Desc,
+	}
+}
+
+type adminNameRequest struct {
+	Cluster string `uri:"cluster"  binding:"required"  example:"UUID"`
+	Name    string `uri:"name"     binding:"required"  example:"admin"`
+}
+
+// @Summary     Remove cluster administrator
+// @Description Delete administrator of cluster by name
+// @ID          removeClusterAdmin
+// @Tags  	    cluster admin
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param		name	    path	 string			true	"Name of administrator"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/admin/:name [delete]
+func (r *ctrlRoutes) removeClusterAdmin(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "removeClusterAdmin")
+	defer span.End()
+
+	var request adminNameRequest
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - removeClusterAdmin")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("remove cluster admin")
+
+	err := r.c.RemoveClusterAdmin(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, request.Name)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - removeClusterAdmin")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,200 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func TestClusterRoute(t *testing.T) {
+	timeout := 1200
+	mode := "memory"
+
+	cases := []struct {
+		name          string
+		method        string
+		uri           string
+		body          string
+		mockMethod    string
+		mockArg       any
+		ctrlMockError error
+		code          int
+		retVal        string
+	}{
+		{
+			name:   "Error update wo entrypoint",
+			method: http.MethodPatch,
+			uri:    "/v1/cluster/1234-5678",
+			body:   "{\"expiration_timeout\":1200}",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"bad request\"}",
+		},
+		{
+			name:   "Error update balancing mode",
+			method: http.MethodPatch,
+			uri:    "/v1/cluster/1234-5678?entrypoint=1capp01:1545",
+			body:   "{\"load_balancing_mode\":\"fast\"}",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request body\"}",
+		},
+		{
+			name:       "Success update",
+			method:     http.MethodPatch,
+			uri:        "/v1/cluster/1234-5678?entrypoint=1capp01:1545",
+			body:       "{\"expiration_timeout\":1200,\"load_balancing_mode\":\"memory\"}",
+			mockMethod: "UpdateCluster",
+			mockArg:    entity.ClusterUpdateOptions{ExpirationTimeout: &timeout, LoadBalancingMode: &mode},
+			code:       http.StatusOK,
+			retVal: "{\"id\":\"1234-5678\",\"host\":\"\",\"port\":\"\",\"name\":\"\",\"exp\":1200,\"lt\":0,\"mms\":0,\"mmts\":0,\"sl\":0," +
+				"\"sftl\":0,\"lb\":\"memory\",\"errth\":0,\"kpp\":0}",
+		},
+		{
+			name:          "Error update cluster not found",
+			method:        http.MethodPatch,
+			uri:           "/v1/cluster/1234-5678?entrypoint=1capp01:1545",
+			body:          "{\"expiration_timeout\":1200,\"load_balancing_mode\":\"memory\"}",
+			mockMethod:    "UpdateCluster",
+			mockArg:       entity.ClusterUpdateOptions{ExpirationTimeout: &timeout, LoadBalancingMode: &mode},
+			ctrlMockError: fmt.Errorf("1234-5678: %w", usecase.ErrClusterNotFound),
+			code:          http.StatusNotFound,
+			retVal:        "{\"error\":\"cluster not found\"}",
+		},
+		{
+			name:   "Error insert wo port",
+			method: http.MethodPost,
+			uri:    "/v1/cluster?entrypoint=1capp01:1545",
+			body:   "{\"host\":\"1capp01\"}",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request body\"}",
+		},
+		{
+			name:       "Success insert",
+			method:     http.MethodPost,
+			uri:        "/v1/cluster?entrypoint=1capp01:1545",
+			body:       "{\"host\":\"1capp01\",\"port\":1541,\"expiration_timeout\":1200}",
+			mockMethod: "InsertCluster",
+			mockArg:    entity.ClusterInsertOptions{Host: "1capp01", Port: 1541, ClusterUpdateOptions: entity.ClusterUpdateOptions{ExpirationTimeout: &timeout}},
+			code:       http.StatusCreated,
+			retVal: "{\"id\":\"1234-5678\",\"host\":\"\",\"port\":\"\",\"name\":\"\",\"exp\":1200,\"lt\":0,\"mms\":0,\"mmts\":0,\"sl\":0," +
+				"\"sftl\":0,\"lb\":\"memory\",\"errth\":0,\"kpp\":0}",
+		},
+		{
+			name:       "Success remove cluster",
+			method:     http.MethodDelete,
+			uri:        "/v1/cluster/1234-5678?entrypoint=1capp01:1545",
+			mockMethod: "RemoveCluster",
+			code:       http.StatusNoContent,
+		},
+		{
+			name:       "Success admin list",
+			method:     http.MethodGet,
+			uri:        "/v1/cluster/1234-5678/admin/list?entrypoint=1capp01:1545",
+			mockMethod: "ClusterAdmins",
+			code:       http.StatusOK,
+			retVal:     "{\"admins\":[{\"name\":\"admin\",\"auth\":\"pwd\",\"osuser\":\"\",\"desc\":\"\"}]}",
+		},
+		{
+			name:   "Error register wo auth",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1234-5678/admin?entrypoint=1capp01:1545",
+			body:   "{\"name\":\"operator\",\"pwd\":\"secret\"}",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request body\"}",
+		},
+		{
+			name:       "Success register",
+			method:     http.MethodPost,
+			uri:        "/v1/cluster/1234-5678/admin?entrypoint=1capp01:1545",
+			body:       "{\"name\":\"operator\",\"pwd\":\"secret\",\"auth\":[\"pwd\",\"os\"]}",
+			mockMethod: "RegisterClusterAdmin",
+			mockArg:    entity.AdminOptions{Name: "operator", Pwd: "secret", Auth: "pwd,os"},
+			code:       http.StatusNoContent,
+		},
+		{
+			name:       "Success remove",
+			method:     http.MethodDelete,
+			uri:        "/v1/cluster/1234-5678/admin/operator?entrypoint=1capp01:1545",
+			mockMethod: "RemoveClusterAdmin",
+			mockArg:    "operator",
+			code:       http.StatusNoContent,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			anyCtx := mock.MatchedBy(func(ctx context.Context) bool { return true })
+			cluster := entity.Cluster{ID: "1234-5678"}
+
+			switch tc.mockMethod {
+			case "UpdateCluster":
+				ctrlMock.On("UpdateCluster", anyCtx, "1capp01:1545", cluster, entity.Credentials{}, tc.mockArg).
+					Return(entity.Cluster{ID: "1234-5678", Exp: 1200, LBMode: "memory"}, tc.ctrlMockError)
+			case "InsertCluster":
+				ctrlMock.On("InsertCluster", anyCtx, "1capp01:1545", entity.Credentials{}, tc.mockArg).
+					Return(entity.Cluster{ID: "1234-5678", Exp: 1200, LBMode: "memory"}, tc.ctrlMockError)
+			case "RemoveCluster":
+				ctrlMock.On("RemoveCluster", anyCtx, "1capp01:1545", entity.Credentials{}, cluster, entity.Credentials{}).
+					Return(tc.ctrlMockError)
+			case "ClusterAdmins":
+				ctrlMock.On("ClusterAdmins", anyCtx, "1capp01:1545", cluster, entity.Credentials{}).
+					Return([]entity.Admin{{Name: "admin", Auth: "pwd"}}, tc.ctrlMockError)
+			case "RegisterClusterAdmin", "RemoveClusterAdmin":
+				ctrlMock.On(tc.mockMethod, anyCtx, "1capp01:1545", cluster, entity.Credentials{}, tc.mockArg).
+					Return(tc.ctrlMockError)
+			}
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, strings.NewReader(tc.body))
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/codes"
+
+	v1e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+)
+
+type counterResponse struct {
+	Counters []entity.Counter `json:"counters"`
+}
+
+type counterValueResponse struct {
+	Values []entity.CounterValue `json:"values"`
+}
+
+type counterRequest struct {
+	Cluster string `uri:"cluster"  binding:"required"  example:"UUID"`
+	Counter string `uri:"counter"  binding:"required"  example:"users_cpu"`
+}
+
+type counterObjectQuery struct {
+	Object string `form:"object"  example:"ivanov"`
+}
+
+// @Summary     Show resource consumption counters
+// @Description Show all resource consumption counters of cluster
+// @ID          counters
+// @Tags  	    counter
+// @Produce     json
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Success     200 {object} counterResponse
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/counter/list [get]
+func (r *ctrlRoutes) counters(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "counters")
+	defer span.End()
+
+	var request requestWoInfobase
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - counters")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("get list of counters")
+
+	counters, err := r.c.Counters(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - counters")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, counterResponse{counters})
+}
+
+// @Summary     Show counter values
+// @Description Show accumulated values of resource consumption counter for each object
+// @ID          counterValues
+// @Tags  	    counter
+// @Produce     json
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param		counter	    path	 string			true	"Name of counter"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Param       object      query    string         false 	"Only values of object (user, infobase, etc.)"
+// @Success     200 {object} counterValueResponse
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/counter/:counter/values [get]
+func (r *ctrlRoutes) counterValues(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "counterValues")
+	defer span.End()
+
+	var (
+		request counterRequest
+		query   counterObjectQuery
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - counterValues")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - counterValues")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("get counter values")
+
+	values, err := r.c.CounterValues(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, request.Counter, query.Object)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - counterValues")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, counterValueResponse{values})
+}
+
+// @Summary     Clear counter values
+// @Description Reset accumulated values of resource consumption counter
+// @ID          clearCounter
+// @Tags  	    counter
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param		counter	    path	 string			true	"Name of counter"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Param       object      query    string         false 	"Only values of object (user, infobase, etc.)"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/counter/:counter/clear [post]
+func (r *ctrlRoutes) clearCounter(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "clearCounter")
+	defer span.End()
+
+	var (
+		request counterRequest
+		query   counterObjectQuery
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - clearCounter")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - clearCounter")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("clear counter")
+
+	err := r.c.ClearCounter(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, request.Counter, query.Object)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - clearCounter")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,134 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func TestCounterRoute(t *testing.T) {
+	cases := []struct {
+		name          string
+		method        string
+		uri           string
+		mockMethod    string
+		object        string
+		ctrlMockError error
+		code          int
+		retVal        string
+	}{
+		{
+			name:       "Success list",
+			method:     http.MethodGet,
+			uri:        "/v1/cluster/1234-5678/counter/list?entrypoint=1capp01:1545",
+			mockMethod: "Counters",
+			code:       http.StatusOK,
+			retVal: "{\"counters\":[{\"name\":\"users_cpu\",\"colltime\":\"\",\"group\":\"users\",\"ftype\":\"\",\"filter\":\"\",\"duration\":\"\"," +
+				"\"cpu\":\"analyze\",\"memory\":\"\",\"read\":\"\",\"write\":\"\",\"durdbms\":\"\",\"dbmsbytes\":\"\",\"service\":\"\",\"call\":\"\"," +
+				"\"actses\":\"\",\"ses\":\"\",\"desc\":\"\"}]}",
+		},
+		{
+			name:       "Success values w object",
+			method:     http.MethodGet,
+			uri:        "/v1/cluster/1234-5678/counter/users_cpu/values?entrypoint=1capp01:1545&object=ivanov",
+			mockMethod: "CounterValues",
+			object:     "ivanov",
+			code:       http.StatusOK,
+			retVal: "{\"values\":[{\"object\":\"ivanov\",\"duration\":0,\"cpu\":800,\"memory\":0,\"read\":0,\"write\":0,\"durdbms\":0," +
+				"\"dbmsbytes\":0,\"service\":0,\"call\":15,\"actses\":0,\"ses\":0}]}",
+		},
+		{
+			name:          "Error values",
+			method:        http.MethodGet,
+			uri:           "/v1/cluster/1234-5678/counter/users_cpu/values?entrypoint=1capp01:1545",
+			mockMethod:    "CounterValues",
+			ctrlMockError: errors.New("counter not found"),
+			code:          http.StatusInternalServerError,
+			retVal:        "{\"error\":\"internal problems\"}",
+		},
+		{
+			name:       "Success clear",
+			method:     http.MethodPost,
+			uri:        "/v1/cluster/1234-5678/counter/users_cpu/clear?entrypoint=1capp01:1545",
+			mockMethod: "ClearCounter",
+			code:       http.StatusNoContent,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			anyCtx := mock.MatchedBy(func(ctx context.Context) bool { return true })
+			cluster := entity.Cluster{ID: "1234-5678"}
+
+			switch tc.mockMethod {
+			case "Counters":
+				ctrlMock.On("Counters", anyCtx, "1capp01:1545", cluster, entity.Credentials{}).
+					Return([]entity.Counter{{Name: "users_cpu", Group: "users", CPUTime: "analyze"}}, tc.ctrlMockError)
+			case "CounterValues":
+				var values []entity.CounterValue
+
+				if tc.ctrlMockError == nil {
+					values = []entity.CounterValue{{Object: "ivanov", CPUTime: 800, Call: 15}}
+				}
+
+				ctrlMock.On("CounterValues", anyCtx, "1capp01:1545", cluster, entity.Credentials{}, "users_cpu", tc.object).
+					Return(values, tc.ctrlMockError)
+			case "ClearCounter":
+				ctrlMock.On("ClearCounter", anyCtx, "1capp01:1545", cluster, entity.Credentials{}, "users_cpu", tc.object).
+					Return(tc.ctrlMockError)
+			}
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, strings.NewReader(""))
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
@@ -1,14 +1,21 @@
 package v1
 
 import (
+	"errors"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
 	v1e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/agentcredentials"
+	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/auth"
 	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/clustercredentials"
+	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/commonqueryparams"
+	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/infobasecredentials"
 	"github.com/antonmisa/1cctl/internal/entity"
 	"github.com/antonmisa/1cctl/internal/usecase"
 	"github.com/antonmisa/1cctl/internal/usecase/common"
@@ -26,15 +33,97 @@ func newCtrlRoutes(handler *gin.RouterGroup, t usecase.Ctrl, l logger.Interface,
 
 	h := handler.Group("/cluster")
 	{
+		h.Use(commonqueryparams.UseCommonQueryParams(l))
 		h.Use(clustercredentials.UseClusterCredentials(l))
+		h.Use(infobasecredentials.UseInfobaseCredentials(l))
+		h.Use(agentcredentials.UseAgentCredentials(l))
+		h.Use(auth.UseScope(l, t))
 
-		h.GET("/list", r.clusters)
-		h.GET("/:cluster/infobase/list", r.infobases)
-		h.GET("/:cluster/infobase/:infobase/session/list", r.sessionsByInfobase)
-		h.GET("/:cluster/infobase/:infobase/connection/list", r.connectionsByInfobase)
-		h.GET("/:cluster/session/list", r.sessions)
-		h.GET("/:cluster/connection/list", r.connections)
+		r.clusterRoutes(h)
 	}
+
+	a := handler.Group("/agent")
+	{
+		a.Use(commonqueryparams.UseCommonQueryParams(l))
+		a.Use(agentcredentials.UseAgentCredentials(l))
+		a.Use(auth.UseScope(l, t))
+
+		r.agentRoutes(a)
+	}
+
+	j := handler.Group("/jobs")
+	{
+		j.GET("/:id", r.job)
+	}
+
+	b := handler.Group("/backups")
+	{
+		b.GET("", r.backups)
+	}
+}
+
+// clusterRoutes - registering routes of cluster group, shared by /cluster and /ras/:ras/cluster.
+func (r *ctrlRoutes) clusterRoutes(h *gin.RouterGroup) {
+	h.GET("/list", r.clusters)
+	h.POST("", r.insertCluster)
+	h.PATCH("/:cluster", r.updateCluster)
+	h.DELETE("/:cluster", r.removeCluster)
+	h.GET("/:cluster/admin/list", r.clusterAdmins)
+	h.POST("/:cluster/admin", r.registerClusterAdmin)
+	h.DELETE("/:cluster/admin/:name", r.removeClusterAdmin)
+	h.GET("/:cluster/infobase/list", r.infobases)
+	h.GET("/:cluster/infobase/:infobase/session/list", r.sessionsByInfobase)
+	h.GET("/:cluster/infobase/:infobase/connection/list", r.connectionsByInfobase)
+	h.GET("/:cluster/infobase/:infobase/lock/list", r.locks)
+	h.GET("/:cluster/session/list", r.sessions)
+	h.GET("/:cluster/connection/list", r.connections)
+	h.GET("/:cluster/process/list", r.processes)
+	h.GET("/:cluster/server/list", r.servers)
+	h.GET("/:cluster/manager/list", r.managers)
+
+	h.GET("/:cluster/profile/list", r.profiles)
+	h.POST("/:cluster/profile", r.createProfile)
+	h.PUT("/:cluster/profile/:name", r.updateProfile)
+	h.DELETE("/:cluster/profile/:name", r.removeProfile)
+
+	h.GET("/:cluster/limit/list", r.limits)
+	h.POST("/:cluster/limit", r.createLimit)
+	h.PUT("/:cluster/limit/:name", r.updateLimit)
+	h.DELETE("/:cluster/limit/:name", r.removeLimit)
+
+	h.GET("/:cluster/counter/list", r.counters)
+	h.GET("/:cluster/counter/:counter/values", r.counterValues)
+	h.POST("/:cluster/counter/:counter/clear", r.clearCounter)
+
+	h.GET("/:cluster/server/:server/rule/list", r.rules)
+	h.POST("/:cluster/server/:server/rule", r.insertRule)
+	h.PUT("/:cluster/server/:server/rule/:rule", r.updateRule)
+	h.DELETE("/:cluster/server/:server/rule/:rule", r.removeRule)
+	h.POST("/:cluster/rule/apply", r.applyRules)
+
+	h.DELETE("/:cluster/session/:session", r.deleteSession)
+	h.DELETE("/:cluster/session", r.deleteSessions)
+	h.DELETE("/:cluster/connection/:connection", r.deleteConnection)
+	h.DELETE("/:cluster/connection", r.deleteConnections)
+
+	h.GET("/:cluster/infobase/:infobase", r.infobaseDetails)
+	h.PATCH("/:cluster/infobase/:infobase", r.updateInfobase)
+	h.POST("/:cluster/infobase", r.createInfobase)
+	h.DELETE("/:cluster/infobase/:infobase", r.dropInfobase)
+
+	h.POST("/:cluster/infobase/:infobase/lock", r.lockInfobase)
+	h.POST("/:cluster/infobase/:infobase/unlock", r.unlockInfobase)
+
+	h.POST("/:cluster/infobase/:infobase/backup", r.backup)
+	h.POST("/:cluster/infobase/:infobase/safebackup", r.safeBackup)
+	h.POST("/:cluster/infobase/:infobase/restore", r.restore)
+}
+
+// agentRoutes - registering routes of agent group, shared by /agent and /ras/:ras/agent.
+func (r *ctrlRoutes) agentRoutes(a *gin.RouterGroup) {
+	a.GET("/admin/list", r.agentAdmins)
+	a.POST("/admin", r.registerAgentAdmin)
+	a.DELETE("/admin/:name", r.removeAgentAdmin)
 }
 
 type clusterResponse struct {
@@ -77,6 +166,10 @@ func (r *ctrlRoutes) clusters(c *gin.Context) {
 		return
 	}
 
+	clusters = inScope(c, clusters, func(cl entity.Cluster) auth.Target {
+		return requestTarget(c, cl.ID, "")
+	})
+
 	span.AddEvent("generate json response")
 
 	c.JSON(http.StatusOK, clusterResponse{clusters})
@@ -143,6 +236,10 @@ func (r *ctrlRoutes) infobases(c *gin.Context) {
 		return
 	}
 
+	infobases = inScope(c, infobases, func(ib entity.Infobase) auth.Target {
+		return requestTarget(c, request.Cluster, ib.Name)
+	})
+
 	span.AddEvent("generate json response")
 
 	c.JSON(http.StatusOK, infobaseResponse{infobases})
@@ -209,6 +306,19 @@ func (r *ctrlRoutes) sessions(c *gin.Context) {
 		return
 	}
 
+	granted, err := r.grantedInfobases(ctx, c, entity.Cluster{ID: request.Cluster}, clusterCred)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - sessions")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	sessions = onlyGranted(sessions, granted, func(s entity.Session) string { return s.InfobaseID })
+
 	span.AddEvent("generate json response")
 
 	c.JSON(http.StatusOK, sessionResponse{sessions})
@@ -335,6 +445,19 @@ func (r *ctrlRoutes) connections(c *gin.Context) {
 		return
 	}
 
+	granted, err := r.grantedInfobases(ctx, c, entity.Cluster{ID: request.Cluster}, clusterCred)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - connections")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	connections = onlyGranted(connections, granted, func(cn entity.Connection) string { return cn.InfobaseID })
+
 	span.AddEvent("generate json response")
 
 	c.JSON(http.StatusOK, connectionResponse{connections})
@@ -396,3 +519,826 @@ func (r *ctrlRoutes) connectionsByInfobase(c *gin.Context) {
 
 	c.JSON(http.StatusOK, connectionResponse{connections})
 }
+
+type sessionRequest struct {
+	Cluster string `uri:"cluster"       binding:"required"  example:"UUID"`
+	Session string `uri:"session"       binding:"required"  example:"UUID"`
+}
+
+// @Summary     Terminate session
+// @Description Terminate session in cluster
+// @ID          deleteSession
+// @Tags  	    session delete
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param		session	    path	 string			true	"UUID of session"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/session/:session [delete]
+func (r *ctrlRoutes) deleteSession(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "deleteSession")
+	defer span.End()
+
+	var request sessionRequest
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - deleteSession")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("terminate session")
+
+	err := r.c.DeleteSession(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, entity.Session{ID: request.Session})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - deleteSession")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+type sessionsDeleteRequest struct {
+	Sessions []string `json:"sessions"  binding:"required,min=1,dive,required"  example:"UUID"`
+}
+
+// @Summary     Terminate sessions
+// @Description Terminate list of sessions in cluster
+// @ID          deleteSessions
+// @Tags  	    session delete
+// @Accept      json
+// @Param		cluster	    path	 string					true	"UUID of cluster"
+// @Param       entrypoint  query    string         		true 	"Entrypoint for cluster"
+// @Param       request     body     sessionsDeleteRequest 	true 	"UUIDs of sessions"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/session [delete]
+func (r *ctrlRoutes) deleteSessions(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "deleteSessions")
+	defer span.End()
+
+	var (
+		request requestWoInfobase
+		body    sessionsDeleteRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - deleteSessions")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - deleteSessions")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	sessions := make([]entity.Session, 0, len(body.Sessions))
+	for _, id := range body.Sessions {
+		sessions = append(sessions, entity.Session{ID: id})
+	}
+
+	span.AddEvent("terminate sessions")
+
+	err := r.c.DeleteSessions(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, sessions)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - deleteSessions")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+type connectionRequest struct {
+	Cluster    string `uri:"cluster"       binding:"required"  example:"UUID"`
+	Connection string `uri:"connection"    binding:"required"  example:"UUID"`
+}
+
+// @Summary     Disconnect connection
+// @Description Disconnect connection in cluster
+// @ID          deleteConnection
+// @Tags  	    connection delete
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param		connection	path	 string			true	"UUID of connection"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/connection/:connection [delete]
+func (r *ctrlRoutes) deleteConnection(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "deleteConnection")
+	defer span.End()
+
+	var request connectionRequest
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - deleteConnection")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("disconnect connection")
+
+	err := r.c.DeleteConnection(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, entity.Connection{ID: request.Connection})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - deleteConnection")
+
+		if errors.Is(err, usecase.ErrConnectionNotFound) {
+			v1e.ErrorResponse(c, http.StatusNotFound, "connection not found")
+
+			return
+		}
+
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+type connectionsDeleteRequest struct {
+	Connections []string `json:"connections"  binding:"required,min=1,dive,required"  example:"UUID"`
+}
+
+// @Summary     Disconnect connections
+// @Description Disconnect list of connections in cluster
+// @ID          deleteConnections
+// @Tags  	    connection delete
+// @Accept      json
+// @Param		cluster	    path	 string						true	"UUID of cluster"
+// @Param       entrypoint  query    string         			true 	"Entrypoint for cluster"
+// @Param       request     body     connectionsDeleteRequest 	true 	"UUIDs of connections"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/connection [delete]
+func (r *ctrlRoutes) deleteConnections(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "deleteConnections")
+	defer span.End()
+
+	var (
+		request requestWoInfobase
+		body    connectionsDeleteRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - deleteConnections")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - deleteConnections")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	connections := make([]entity.Connection, 0, len(body.Connections))
+	for _, id := range body.Connections {
+		connections = append(connections, entity.Connection{ID: id})
+	}
+
+	span.AddEvent("disconnect connections")
+
+	err := r.c.DeleteConnections(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, connections)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - deleteConnections")
+
+		if errors.Is(err, usecase.ErrConnectionNotFound) {
+			v1e.ErrorResponse(c, http.StatusNotFound, "connection not found")
+
+			return
+		}
+
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+type lockRequest struct {
+	DeniedFrom        time.Time `json:"denied_from"          example:"2023-08-10T22:00:00Z"`
+	DeniedTo          time.Time `json:"denied_to"            example:"2023-08-10T23:00:00Z"`
+	Message           string    `json:"message"              example:"Infobase is locked for backup"`
+	PermissionCode    string    `json:"permission_code"      binding:"required"  example:"12345"`
+	ScheduledJobsDeny *bool     `json:"scheduled_jobs_deny"  example:"true"`
+}
+
+// @Summary     Lock infobase
+// @Description Deny new sessions and scheduled jobs in infobase for a window of time
+// @ID          lockInfobase
+// @Tags  	    infobase lock
+// @Accept      json
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param		infobase    path	 string			true	"UUID of infobase"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Param       request     body     lockRequest 	true 	"Lock parameters, empty window means from now for an hour"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/infobase/:infobase/lock [post]
+func (r *ctrlRoutes) lockInfobase(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "lockInfobase")
+	defer span.End()
+
+	var (
+		request requestWInfobase
+		body    lockRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - lockInfobase")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - lockInfobase")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+	infobaseCred, _ := c.MustGet(common.InfobaseCred).(entity.Credentials)
+
+	opts := entity.LockOptions{
+		DeniedFrom:        body.DeniedFrom,
+		DeniedTo:          body.DeniedTo,
+		Message:           body.Message,
+		PermissionCode:    body.PermissionCode,
+		ScheduledJobsDeny: body.ScheduledJobsDeny == nil || *body.ScheduledJobsDeny,
+	}
+
+	span.AddEvent("lock infobase")
+
+	err := r.c.LockInfobase(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, entity.Infobase{ID: request.Infobase}, infobaseCred, opts)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - lockInfobase")
+
+		if errors.Is(err, usecase.ErrLockWindowInvalid) {
+			v1e.ErrorResponse(c, http.StatusBadRequest, "invalid lock window")
+
+			return
+		}
+
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+type unlockRequest struct {
+	PermissionCode string `json:"permission_code"  example:"12345"`
+}
+
+// @Summary     Unlock infobase
+// @Description Allow sessions and scheduled jobs in infobase
+// @ID          unlockInfobase
+// @Tags  	    infobase lock
+// @Accept      json
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param		infobase    path	 string			true	"UUID of infobase"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Param       request     body     unlockRequest 	false 	"Permission code to set"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/infobase/:infobase/unlock [post]
+func (r *ctrlRoutes) unlockInfobase(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "unlockInfobase")
+	defer span.End()
+
+	var (
+		request requestWInfobase
+		body    unlockRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - unlockInfobase")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	// Permission code is optional so request may come without body at all.
+	if err := c.ShouldBindJSON(&body); err != nil && !errors.Is(err, io.EOF) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - unlockInfobase")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+	infobaseCred, _ := c.MustGet(common.InfobaseCred).(entity.Credentials)
+
+	span.AddEvent("unlock infobase")
+
+	err := r.c.UnlockInfobase(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, entity.Infobase{ID: request.Infobase}, infobaseCred, body.PermissionCode)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - unlockInfobase")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+type backupRequest struct {
+	PermissionCode string `json:"permission_code"  example:"12345"`
+}
+
+type jobResponse struct {
+	Job entity.Job `json:"job"`
+}
+
+// @Summary     Backup infobase
+// @Description Enqueue dump of infobase to backup directory, state of dump is got by job id
+// @ID          backup
+// @Tags  	    infobase backup
+// @Accept      json
+// @Produce     json
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param		infobase    path	 string			true	"UUID of infobase"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Param       request     body     backupRequest 	true 	"Permission code of locked infobase, default one from config if empty"
+// @Success     202 {object} jobResponse
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Failure     503 {object} error.response
+// @Router      /cluster/:cluster/infobase/:infobase/backup [post]
+func (r *ctrlRoutes) backup(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "backup")
+	defer span.End()
+
+	var (
+		request requestWInfobase
+		body    backupRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - backup")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - backup")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+	infobaseCred, _ := c.MustGet(common.InfobaseCred).(entity.Credentials)
+
+	span.AddEvent("enqueue backup")
+
+	job, err := r.c.Backup(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, entity.Infobase{ID: request.Infobase}, infobaseCred, entity.BackupOptions{LockCode: body.PermissionCode})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - backup")
+
+		switch {
+		case errors.Is(err, usecase.ErrClusterNotFound):
+			v1e.ErrorResponse(c, http.StatusNotFound, "cluster not found")
+		case errors.Is(err, usecase.ErrInfobaseNotFound):
+			v1e.ErrorResponse(c, http.StatusNotFound, "infobase not found")
+		case errors.Is(err, usecase.ErrJobQueueFull):
+			v1e.ErrorResponse(c, http.StatusServiceUnavailable, "job queue is full")
+		default:
+			v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+		}
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusAccepted, jobResponse{job})
+}
+
+type safeBackupRequest struct {
+	PermissionCode string `json:"permission_code"                      example:"12345"`
+	Message        string `json:"message"                              example:"Infobase is locked for backup"`
+	GracePeriod    int    `json:"grace_period"     binding:"min=0"     example:"300"`
+}
+
+// @Summary     Safe backup infobase
+// @Description Enqueue job which denies sessions to infobase, waits grace period while users see lock message,
+// @Description terminates remaining sessions, dumps infobase and allows sessions again even if dump failed
+// @ID          safebackup
+// @Tags  	    infobase backup
+// @Accept      json
+// @Produce     json
+// @Param		cluster	    path	 string				true	"UUID of cluster"
+// @Param		infobase    path	 string				true	"UUID of infobase"
+// @Param       entrypoint  query    string         	true 	"Entrypoint for cluster"
+// @Param       request     body     safeBackupRequest 	true 	"Permission code, lock message and grace period in seconds, defaults from config if empty"
+// @Success     202 {object} jobResponse
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Failure     503 {object} error.response
+// @Router      /cluster/:cluster/infobase/:infobase/safebackup [post]
+func (r *ctrlRoutes) safeBackup(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "safeBackup")
+	defer span.End()
+
+	var (
+		request requestWInfobase
+		body    safeBackupRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - safeBackup")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - safeBackup")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+	infobaseCred, _ := c.MustGet(common.InfobaseCred).(entity.Credentials)
+
+	opts := entity.SafeBackupOptions{
+		LockCode:    body.PermissionCode,
+		Message:     body.Message,
+		GracePeriod: time.Duration(body.GracePeriod) * time.Second,
+	}
+
+	span.AddEvent("enqueue safe backup")
+
+	job, err := r.c.SafeBackup(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, entity.Infobase{ID: request.Infobase}, infobaseCred, opts)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - safeBackup")
+
+		switch {
+		case errors.Is(err, usecase.ErrClusterNotFound):
+			v1e.ErrorResponse(c, http.StatusNotFound, "cluster not found")
+		case errors.Is(err, usecase.ErrInfobaseNotFound):
+			v1e.ErrorResponse(c, http.StatusNotFound, "infobase not found")
+		case errors.Is(err, usecase.ErrJobQueueFull):
+			v1e.ErrorResponse(c, http.StatusServiceUnavailable, "job queue is full")
+		default:
+			v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+		}
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusAccepted, jobResponse{job})
+}
+
+type restoreRequest struct {
+	File           string `json:"file"             binding:"required"  example:"ib_20230810_140443.dt"`
+	Confirm        string `json:"confirm"          binding:"required"  example:"ib"`
+	PermissionCode string `json:"permission_code"                      example:"12345"`
+	Message        string `json:"message"                              example:"Infobase is locked for restore"`
+	GracePeriod    int    `json:"grace_period"     binding:"min=0"     example:"300"`
+}
+
+// @Summary     Restore infobase
+// @Description Enqueue job which denies sessions to infobase, waits grace period while users see lock message,
+// @Description terminates remaining sessions, loads infobase from dump and allows sessions again even if load failed.
+// @Description Dump must be in backup directory, confirm must be equal to name of infobase
+// @ID          restore
+// @Tags  	    infobase backup
+// @Accept      json
+// @Produce     json
+// @Param		cluster	    path	 string				true	"UUID of cluster"
+// @Param		infobase    path	 string				true	"UUID of infobase"
+// @Param       entrypoint  query    string         	true 	"Entrypoint for cluster"
+// @Param       request     body     restoreRequest 	true 	"Dump file in backup directory, name of infobase as confirmation, permission code, lock message and grace period in seconds"
+// @Success     202 {object} jobResponse
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Failure     503 {object} error.response
+// @Router      /cluster/:cluster/infobase/:infobase/restore [post]
+func (r *ctrlRoutes) restore(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "restore")
+	defer span.End()
+
+	var (
+		request requestWInfobase
+		body    restoreRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - restore")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - restore")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+	infobaseCred, _ := c.MustGet(common.InfobaseCred).(entity.Credentials)
+
+	opts := entity.RestoreOptions{
+		File:        body.File,
+		Confirm:     body.Confirm,
+		LockCode:    body.PermissionCode,
+		Message:     body.Message,
+		GracePeriod: time.Duration(body.GracePeriod) * time.Second,
+	}
+
+	span.AddEvent("enqueue restore")
+
+	job, err := r.c.Restore(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, entity.Infobase{ID: request.Infobase}, infobaseCred, opts)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - restore")
+
+		switch {
+		case errors.Is(err, usecase.ErrBackupFileNotFound):
+			v1e.ErrorResponse(c, http.StatusNotFound, "backup file not found")
+		case errors.Is(err, usecase.ErrRestoreNotConfirmed):
+			v1e.ErrorResponse(c, http.StatusBadRequest, "restore is not confirmed")
+		case errors.Is(err, usecase.ErrClusterNotFound):
+			v1e.ErrorResponse(c, http.StatusNotFound, "cluster not found")
+		case errors.Is(err, usecase.ErrInfobaseNotFound):
+			v1e.ErrorResponse(c, http.StatusNotFound, "infobase not found")
+		case errors.Is(err, usecase.ErrJobQueueFull):
+			v1e.ErrorResponse(c, http.StatusServiceUnavailable, "job queue is full")
+		default:
+			v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+		}
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusAccepted, jobResponse{job})
+}
+
+type jobRequest struct {
+	ID string `uri:"id"       binding:"required"  example:"UUID"`
+}
+
+// @Summary     Show job
+// @Description Show state of background job like backup
+// @ID          job
+// @Tags  	    job
+// @Produce     json
+// @Param		id	        path	 string			true	"UUID of job"
+// @Success     200 {object} jobResponse
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /jobs/:id [get]
+func (r *ctrlRoutes) job(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "job")
+	defer span.End()
+
+	var request jobRequest
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - job")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	span.AddEvent("get job")
+
+	job, err := r.c.Job(ctx, request.ID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - job")
+
+		if errors.Is(err, usecase.ErrJobNotFound) {
+			v1e.ErrorResponse(c, http.StatusNotFound, "job not found")
+
+			return
+		}
+
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, jobResponse{job})
+}
+
+type backupsRequest struct {
+	Cluster  string `form:"cluster"   example:"UUID"`
+	Infobase string `form:"infobase"  example:"UUID"`
+}
+
+type backupsResponse struct {
+	Backups []entity.Backup `json:"backups"`
+}
+
+// @Summary     Show backups
+// @Description Show dumps registered in backup catalog from oldest to newest with size, duration and checksum
+// @ID          backups
+// @Tags  	    infobase backup
+// @Produce     json
+// @Param		cluster	    query	 string			false	"UUID of cluster"
+// @Param		infobase    query	 string			false	"UUID of infobase"
+// @Success     200 {object} backupsResponse
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /backups [get]
+func (r *ctrlRoutes) backups(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "backups")
+	defer span.End()
+
+	var request backupsRequest
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindQuery(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - backups")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	span.AddEvent("get list of backups")
+
+	backups, err := r.c.Backups(ctx, entity.Cluster{ID: request.Cluster}, entity.Infobase{ID: request.Infobase})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - backups - r.c.Backups")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, backupsResponse{backups})
+}
@@ -6,9 +6,12 @@ import (
 	"go.opentelemetry.io/otel"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
 	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
 	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
 	"github.com/gin-gonic/gin"
@@ -673,3 +676,1229 @@ func TestConnectionsByInfobaseRoute(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteSessionRoute(t *testing.T) {
+	cases := []struct {
+		name          string
+		method        string
+		uri           string
+		body          string
+		ctrlMockError error
+		code          int
+		retVal        string
+	}{
+		{
+			name:   "Erorr wo entrypoint",
+			method: http.MethodDelete,
+			uri:    "/v1/cluster/1capp01:1541/session/1234-5678",
+			body:   ``,
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"bad request\"}",
+		},
+		{
+			name:   "Success",
+			method: http.MethodDelete,
+			uri:    "/v1/cluster/1capp01:1541/session/1234-5678?entrypoint=1capp01:1545",
+			body:   ``,
+			code:   http.StatusNoContent,
+			retVal: "",
+		},
+		{
+			name:          "Error entrypoint incorrect",
+			method:        http.MethodDelete,
+			uri:           "/v1/cluster/1capp01:1541/session/1234-5678?entrypoint=unknown:1545",
+			body:          ``,
+			ctrlMockError: errors.New("error entrypoint incorrect"),
+			code:          500,
+			retVal:        "{\"error\":\"internal problems\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			ctrlMock.On("DeleteSession",
+				mock.MatchedBy(func(ctx context.Context) bool { return true }),
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Return(tc.ctrlMockError).
+				Maybe()
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, strings.NewReader(tc.body))
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
+
+func TestDeleteSessionsRoute(t *testing.T) {
+	cases := []struct {
+		name          string
+		method        string
+		uri           string
+		body          string
+		ctrlMockError error
+		code          int
+		retVal        string
+	}{
+		{
+			name:   "Erorr wo entrypoint",
+			method: http.MethodDelete,
+			uri:    "/v1/cluster/1capp01:1541/session",
+			body:   `{"sessions":["1234-5678","1234-5679"]}`,
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"bad request\"}",
+		},
+		{
+			name:   "Error empty body",
+			method: http.MethodDelete,
+			uri:    "/v1/cluster/1capp01:1541/session?entrypoint=1capp01:1545",
+			body:   `{"sessions":[]}`,
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request body\"}",
+		},
+		{
+			name:   "Success",
+			method: http.MethodDelete,
+			uri:    "/v1/cluster/1capp01:1541/session?entrypoint=1capp01:1545",
+			body:   `{"sessions":["1234-5678","1234-5679"]}`,
+			code:   http.StatusNoContent,
+			retVal: "",
+		},
+		{
+			name:          "Error entrypoint incorrect",
+			method:        http.MethodDelete,
+			uri:           "/v1/cluster/1capp01:1541/session?entrypoint=unknown:1545",
+			body:          `{"sessions":["1234-5678","1234-5679"]}`,
+			ctrlMockError: errors.New("error entrypoint incorrect"),
+			code:          500,
+			retVal:        "{\"error\":\"internal problems\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			ctrlMock.On("DeleteSessions",
+				mock.MatchedBy(func(ctx context.Context) bool { return true }),
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Return(tc.ctrlMockError).
+				Maybe()
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, strings.NewReader(tc.body))
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
+
+func TestDeleteConnectionRoute(t *testing.T) {
+	cases := []struct {
+		name          string
+		method        string
+		uri           string
+		body          string
+		ctrlMockError error
+		code          int
+		retVal        string
+	}{
+		{
+			name:   "Erorr wo entrypoint",
+			method: http.MethodDelete,
+			uri:    "/v1/cluster/1capp01:1541/connection/1234-5678",
+			body:   ``,
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"bad request\"}",
+		},
+		{
+			name:   "Success",
+			method: http.MethodDelete,
+			uri:    "/v1/cluster/1capp01:1541/connection/1234-5678?entrypoint=1capp01:1545",
+			body:   ``,
+			code:   http.StatusNoContent,
+			retVal: "",
+		},
+		{
+			name:          "Error connection not found",
+			method:        http.MethodDelete,
+			uri:           "/v1/cluster/1capp01:1541/connection/1234-5678?entrypoint=1capp01:1545",
+			body:          ``,
+			ctrlMockError: usecase.ErrConnectionNotFound,
+			code:          http.StatusNotFound,
+			retVal:        "{\"error\":\"connection not found\"}",
+		},
+		{
+			name:          "Error entrypoint incorrect",
+			method:        http.MethodDelete,
+			uri:           "/v1/cluster/1capp01:1541/connection/1234-5678?entrypoint=unknown:1545",
+			body:          ``,
+			ctrlMockError: errors.New("error entrypoint incorrect"),
+			code:          500,
+			retVal:        "{\"error\":\"internal problems\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			ctrlMock.On("DeleteConnection",
+				mock.MatchedBy(func(ctx context.Context) bool { return true }),
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Return(tc.ctrlMockError).
+				Maybe()
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, strings.NewReader(tc.body))
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
+
+func TestDeleteConnectionsRoute(t *testing.T) {
+	cases := []struct {
+		name          string
+		method        string
+		uri           string
+		body          string
+		ctrlMockError error
+		code          int
+		retVal        string
+	}{
+		{
+			name:   "Erorr wo entrypoint",
+			method: http.MethodDelete,
+			uri:    "/v1/cluster/1capp01:1541/connection",
+			body:   `{"connections":["1234-5678"]}`,
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"bad request\"}",
+		},
+		{
+			name:   "Error empty body",
+			method: http.MethodDelete,
+			uri:    "/v1/cluster/1capp01:1541/connection?entrypoint=1capp01:1545",
+			body:   `{"connections":[]}`,
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request body\"}",
+		},
+		{
+			name:   "Success",
+			method: http.MethodDelete,
+			uri:    "/v1/cluster/1capp01:1541/connection?entrypoint=1capp01:1545",
+			body:   `{"connections":["1234-5678"]}`,
+			code:   http.StatusNoContent,
+			retVal: "",
+		},
+		{
+			name:          "Error connection not found",
+			method:        http.MethodDelete,
+			uri:           "/v1/cluster/1capp01:1541/connection?entrypoint=1capp01:1545",
+			body:          `{"connections":["1234-5678"]}`,
+			ctrlMockError: usecase.ErrConnectionNotFound,
+			code:          http.StatusNotFound,
+			retVal:        "{\"error\":\"connection not found\"}",
+		},
+		{
+			name:          "Error entrypoint incorrect",
+			method:        http.MethodDelete,
+			uri:           "/v1/cluster/1capp01:1541/connection?entrypoint=unknown:1545",
+			body:          `{"connections":["1234-5678"]}`,
+			ctrlMockError: errors.New("error entrypoint incorrect"),
+			code:          500,
+			retVal:        "{\"error\":\"internal problems\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			ctrlMock.On("DeleteConnections",
+				mock.MatchedBy(func(ctx context.Context) bool { return true }),
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Return(tc.ctrlMockError).
+				Maybe()
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, strings.NewReader(tc.body))
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
+
+func TestLockInfobaseRoute(t *testing.T) {
+	cases := []struct {
+		name          string
+		method        string
+		uri           string
+		body          string
+		ctrlMockError error
+		code          int
+		retVal        string
+	}{
+		{
+			name:   "Erorr wo entrypoint",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1capp01:1541/infobase/1234-5678/lock",
+			body:   `{"permission_code":"12345"}`,
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"bad request\"}",
+		},
+		{
+			name:   "Error wo permission code",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1capp01:1541/infobase/1234-5678/lock?entrypoint=1capp01:1545",
+			body:   `{"message":"locked"}`,
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request body\"}",
+		},
+		{
+			name:          "Error lock window",
+			method:        http.MethodPost,
+			uri:           "/v1/cluster/1capp01:1541/infobase/1234-5678/lock?entrypoint=1capp01:1545",
+			body:          `{"permission_code":"12345","denied_from":"2023-08-10T23:00:00Z","denied_to":"2023-08-10T22:00:00Z"}`,
+			ctrlMockError: usecase.ErrLockWindowInvalid,
+			code:          http.StatusBadRequest,
+			retVal:        "{\"error\":\"invalid lock window\"}",
+		},
+		{
+			name:   "Success",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1capp01:1541/infobase/1234-5678/lock?entrypoint=1capp01:1545",
+			body:   `{"permission_code":"12345","message":"locked","scheduled_jobs_deny":false}`,
+			code:   http.StatusNoContent,
+			retVal: "",
+		},
+		{
+			name:          "Error entrypoint incorrect",
+			method:        http.MethodPost,
+			uri:           "/v1/cluster/1capp01:1541/infobase/1234-5678/lock?entrypoint=unknown:1545",
+			body:          `{"permission_code":"12345"}`,
+			ctrlMockError: errors.New("error entrypoint incorrect"),
+			code:          500,
+			retVal:        "{\"error\":\"internal problems\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			ctrlMock.On("LockInfobase",
+				mock.MatchedBy(func(ctx context.Context) bool { return true }),
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Return(tc.ctrlMockError).
+				Maybe()
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, strings.NewReader(tc.body))
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
+
+func TestUnlockInfobaseRoute(t *testing.T) {
+	cases := []struct {
+		name          string
+		method        string
+		uri           string
+		body          string
+		ctrlMockError error
+		code          int
+		retVal        string
+	}{
+		{
+			name:   "Erorr wo entrypoint",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1capp01:1541/infobase/1234-5678/unlock",
+			body:   `{}`,
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"bad request\"}",
+		},
+		{
+			name:   "Success",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1capp01:1541/infobase/1234-5678/unlock?entrypoint=1capp01:1545",
+			body:   `{"permission_code":""}`,
+			code:   http.StatusNoContent,
+			retVal: "",
+		},
+		{
+			name:   "Success wo body",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1capp01:1541/infobase/1234-5678/unlock?entrypoint=1capp01:1545",
+			code:   http.StatusNoContent,
+			retVal: "",
+		},
+		{
+			name:          "Error entrypoint incorrect",
+			method:        http.MethodPost,
+			uri:           "/v1/cluster/1capp01:1541/infobase/1234-5678/unlock?entrypoint=unknown:1545",
+			body:          `{}`,
+			ctrlMockError: errors.New("error entrypoint incorrect"),
+			code:          500,
+			retVal:        "{\"error\":\"internal problems\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			ctrlMock.On("UnlockInfobase",
+				mock.MatchedBy(func(ctx context.Context) bool { return true }),
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Return(tc.ctrlMockError).
+				Maybe()
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, strings.NewReader(tc.body))
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
+
+func TestBackupRoute(t *testing.T) {
+	job := entity.Job{ID: "1", Kind: "backup", Status: entity.JobQueued, OutputPath: "/backups/ib.dt"}
+
+	cases := []struct {
+		name          string
+		method        string
+		uri           string
+		body          string
+		ctrlMockError error
+		code          int
+		retVal        string
+	}{
+		{
+			name:   "Erorr wo entrypoint",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1capp01:1541/infobase/1234-5678/backup",
+			body:   `{}`,
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"bad request\"}",
+		},
+		{
+			name:   "Error wo body",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1capp01:1541/infobase/1234-5678/backup?entrypoint=1capp01:1545",
+			body:   "",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request body\"}",
+		},
+		{
+			name:          "Error cluster not found",
+			method:        http.MethodPost,
+			uri:           "/v1/cluster/1capp01:1541/infobase/1234-5678/backup?entrypoint=1capp01:1545",
+			body:          `{}`,
+			ctrlMockError: usecase.ErrClusterNotFound,
+			code:          http.StatusNotFound,
+			retVal:        "{\"error\":\"cluster not found\"}",
+		},
+		{
+			name:          "Error infobase not found",
+			method:        http.MethodPost,
+			uri:           "/v1/cluster/1capp01:1541/infobase/1234-5678/backup?entrypoint=1capp01:1545",
+			body:          `{}`,
+			ctrlMockError: usecase.ErrInfobaseNotFound,
+			code:          http.StatusNotFound,
+			retVal:        "{\"error\":\"infobase not found\"}",
+		},
+		{
+			name:          "Error queue is full",
+			method:        http.MethodPost,
+			uri:           "/v1/cluster/1capp01:1541/infobase/1234-5678/backup?entrypoint=1capp01:1545",
+			body:          `{"permission_code":"12345"}`,
+			ctrlMockError: usecase.ErrJobQueueFull,
+			code:          http.StatusServiceUnavailable,
+			retVal:        "{\"error\":\"job queue is full\"}",
+		},
+		{
+			name:   "Success",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1capp01:1541/infobase/1234-5678/backup?entrypoint=1capp01:1545",
+			body:   `{"permission_code":"12345"}`,
+			code:   http.StatusAccepted,
+			retVal: "{\"job\":{\"id\":\"1\",\"kind\":\"backup\",\"status\":\"queued\",\"created\":\"0001-01-01T00:00:00Z\",\"started\":\"0001-01-01T00:00:00Z\",\"finished\":\"0001-01-01T00:00:00Z\",\"output\":\"/backups/ib.dt\",\"exitcode\":0,\"log\":\"\",\"error\":\"\"}}",
+		},
+		{
+			name:          "Error entrypoint incorrect",
+			method:        http.MethodPost,
+			uri:           "/v1/cluster/1capp01:1541/infobase/1234-5678/backup?entrypoint=unknown:1545",
+			body:          `{}`,
+			ctrlMockError: errors.New("error entrypoint incorrect"),
+			code:          500,
+			retVal:        "{\"error\":\"internal problems\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			ctrlMock.On("Backup",
+				mock.MatchedBy(func(ctx context.Context) bool { return true }),
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.AnythingOfType("entity.BackupOptions")).
+				Return(job, tc.ctrlMockError).
+				Maybe()
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, strings.NewReader(tc.body))
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
+
+func TestSafeBackupRoute(t *testing.T) {
+	job := entity.Job{ID: "1", Kind: "safebackup", Status: entity.JobQueued, OutputPath: "/backups/ib.dt"}
+
+	cases := []struct {
+		name          string
+		method        string
+		uri           string
+		body          string
+		ctrlMockError error
+		code          int
+		retVal        string
+	}{
+		{
+			name:   "Erorr wo entrypoint",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1capp01:1541/infobase/1234-5678/safebackup",
+			body:   `{}`,
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"bad request\"}",
+		},
+		{
+			name:   "Error wo body",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1capp01:1541/infobase/1234-5678/safebackup?entrypoint=1capp01:1545",
+			body:   "",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request body\"}",
+		},
+		{
+			name:   "Error negative grace period",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1capp01:1541/infobase/1234-5678/safebackup?entrypoint=1capp01:1545",
+			body:   `{"grace_period":-1}`,
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request body\"}",
+		},
+		{
+			name:          "Error cluster not found",
+			method:        http.MethodPost,
+			uri:           "/v1/cluster/1capp01:1541/infobase/1234-5678/safebackup?entrypoint=1capp01:1545",
+			body:          `{}`,
+			ctrlMockError: usecase.ErrClusterNotFound,
+			code:          http.StatusNotFound,
+			retVal:        "{\"error\":\"cluster not found\"}",
+		},
+		{
+			name:          "Error infobase not found",
+			method:        http.MethodPost,
+			uri:           "/v1/cluster/1capp01:1541/infobase/1234-5678/safebackup?entrypoint=1capp01:1545",
+			body:          `{}`,
+			ctrlMockError: usecase.ErrInfobaseNotFound,
+			code:          http.StatusNotFound,
+			retVal:        "{\"error\":\"infobase not found\"}",
+		},
+		{
+			name:          "Error queue is full",
+			method:        http.MethodPost,
+			uri:           "/v1/cluster/1capp01:1541/infobase/1234-5678/safebackup?entrypoint=1capp01:1545",
+			body:          `{"permission_code":"12345"}`,
+			ctrlMockError: usecase.ErrJobQueueFull,
+			code:          http.StatusServiceUnavailable,
+			retVal:        "{\"error\":\"job queue is full\"}",
+		},
+		{
+			name:   "Success",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1capp01:1541/infobase/1234-5678/safebackup?entrypoint=1capp01:1545",
+			body:   `{"permission_code":"12345","message":"backup","grace_period":60}`,
+			code:   http.StatusAccepted,
+			retVal: "{\"job\":{\"id\":\"1\",\"kind\":\"safebackup\",\"status\":\"queued\",\"created\":\"0001-01-01T00:00:00Z\",\"started\":\"0001-01-01T00:00:00Z\",\"finished\":\"0001-01-01T00:00:00Z\",\"output\":\"/backups/ib.dt\",\"exitcode\":0,\"log\":\"\",\"error\":\"\"}}",
+		},
+		{
+			name:          "Error entrypoint incorrect",
+			method:        http.MethodPost,
+			uri:           "/v1/cluster/1capp01:1541/infobase/1234-5678/safebackup?entrypoint=unknown:1545",
+			body:          `{}`,
+			ctrlMockError: errors.New("error entrypoint incorrect"),
+			code:          500,
+			retVal:        "{\"error\":\"internal problems\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			ctrlMock.On("SafeBackup",
+				mock.MatchedBy(func(ctx context.Context) bool { return true }),
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.AnythingOfType("entity.SafeBackupOptions")).
+				Return(job, tc.ctrlMockError).
+				Maybe()
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, strings.NewReader(tc.body))
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
+
+func TestRestoreRoute(t *testing.T) {
+	job := entity.Job{ID: "1", Kind: "restore", Status: entity.JobQueued, InputPath: "/backups/ib.dt"}
+
+	cases := []struct {
+		name          string
+		method        string
+		uri           string
+		body          string
+		ctrlMockError error
+		code          int
+		retVal        string
+	}{
+		{
+			name:   "Erorr wo entrypoint",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1capp01:1541/infobase/1234-5678/restore",
+			body:   `{"file":"ib.dt","confirm":"ib"}`,
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"bad request\"}",
+		},
+		{
+			name:   "Error wo body",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1capp01:1541/infobase/1234-5678/restore?entrypoint=1capp01:1545",
+			body:   "",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request body\"}",
+		},
+		{
+			name:   "Error negative grace period",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1capp01:1541/infobase/1234-5678/restore?entrypoint=1capp01:1545",
+			body:   `{"file":"ib.dt","confirm":"ib","grace_period":-1}`,
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request body\"}",
+		},
+		{
+			name:   "Error wo confirm",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1capp01:1541/infobase/1234-5678/restore?entrypoint=1capp01:1545",
+			body:   `{"file":"ib.dt"}`,
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request body\"}",
+		},
+		{
+			name:          "Error not confirmed",
+			method:        http.MethodPost,
+			uri:           "/v1/cluster/1capp01:1541/infobase/1234-5678/restore?entrypoint=1capp01:1545",
+			body:          `{"file":"ib.dt","confirm":"other"}`,
+			ctrlMockError: usecase.ErrRestoreNotConfirmed,
+			code:          http.StatusBadRequest,
+			retVal:        "{\"error\":\"restore is not confirmed\"}",
+		},
+		{
+			name:          "Error backup file not found",
+			method:        http.MethodPost,
+			uri:           "/v1/cluster/1capp01:1541/infobase/1234-5678/restore?entrypoint=1capp01:1545",
+			body:          `{"file":"../etc/passwd","confirm":"ib"}`,
+			ctrlMockError: usecase.ErrBackupFileNotFound,
+			code:          http.StatusNotFound,
+			retVal:        "{\"error\":\"backup file not found\"}",
+		},
+		{
+			name:          "Error cluster not found",
+			method:        http.MethodPost,
+			uri:           "/v1/cluster/1capp01:1541/infobase/1234-5678/restore?entrypoint=1capp01:1545",
+			body:          `{"file":"ib.dt","confirm":"ib"}`,
+			ctrlMockError: usecase.ErrClusterNotFound,
+			code:          http.StatusNotFound,
+			retVal:        "{\"error\":\"cluster not found\"}",
+		},
+		{
+			name:          "Error infobase not found",
+			method:        http.MethodPost,
+			uri:           "/v1/cluster/1capp01:1541/infobase/1234-5678/restore?entrypoint=1capp01:1545",
+			body:          `{"file":"ib.dt","confirm":"ib"}`,
+			ctrlMockError: usecase.ErrInfobaseNotFound,
+			code:          http.StatusNotFound,
+			retVal:        "{\"error\":\"infobase not found\"}",
+		},
+		{
+			name:          "Error queue is full",
+			method:        http.MethodPost,
+			uri:           "/v1/cluster/1capp01:1541/infobase/1234-5678/restore?entrypoint=1capp01:1545",
+			body:          `{"file":"ib.dt","confirm":"ib","permission_code":"12345"}`,
+			ctrlMockError: usecase.ErrJobQueueFull,
+			code:          http.StatusServiceUnavailable,
+			retVal:        "{\"error\":\"job queue is full\"}",
+		},
+		{
+			name:   "Success",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1capp01:1541/infobase/1234-5678/restore?entrypoint=1capp01:1545",
+			body:   `{"file":"ib.dt","confirm":"ib","message":"restore","grace_period":60}`,
+			code:   http.StatusAccepted,
+			retVal: "{\"job\":{\"id\":\"1\",\"kind\":\"restore\",\"status\":\"queued\",\"created\":\"0001-01-01T00:00:00Z\",\"started\":\"0001-01-01T00:00:00Z\",\"finished\":\"0001-01-01T00:00:00Z\",\"input\":\"/backups/ib.dt\",\"output\":\"\",\"exitcode\":0,\"log\":\"\",\"error\":\"\"}}",
+		},
+		{
+			name:          "Error entrypoint incorrect",
+			method:        http.MethodPost,
+			uri:           "/v1/cluster/1capp01:1541/infobase/1234-5678/restore?entrypoint=unknown:1545",
+			body:          `{"file":"ib.dt","confirm":"ib"}`,
+			ctrlMockError: errors.New("error entrypoint incorrect"),
+			code:          500,
+			retVal:        "{\"error\":\"internal problems\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			ctrlMock.On("Restore",
+				mock.MatchedBy(func(ctx context.Context) bool { return true }),
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.AnythingOfType("entity.RestoreOptions")).
+				Return(job, tc.ctrlMockError).
+				Maybe()
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, strings.NewReader(tc.body))
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
+
+func TestJobRoute(t *testing.T) {
+	job := entity.Job{ID: "1", Kind: "backup", Status: entity.JobSucceeded, OutputPath: "/backups/ib.dt", Log: "done"}
+
+	cases := []struct {
+		name          string
+		method        string
+		uri           string
+		ctrlMockError error
+		code          int
+		retVal        string
+	}{
+		{
+			name:          "Error job not found",
+			method:        http.MethodGet,
+			uri:           "/v1/jobs/unknown",
+			ctrlMockError: usecase.ErrJobNotFound,
+			code:          http.StatusNotFound,
+			retVal:        "{\"error\":\"job not found\"}",
+		},
+		{
+			name:   "Success",
+			method: http.MethodGet,
+			uri:    "/v1/jobs/1",
+			code:   http.StatusOK,
+			retVal: "{\"job\":{\"id\":\"1\",\"kind\":\"backup\",\"status\":\"succeeded\",\"created\":\"0001-01-01T00:00:00Z\",\"started\":\"0001-01-01T00:00:00Z\",\"finished\":\"0001-01-01T00:00:00Z\",\"output\":\"/backups/ib.dt\",\"exitcode\":0,\"log\":\"done\",\"error\":\"\"}}",
+		},
+		{
+			name:          "Error internal",
+			method:        http.MethodGet,
+			uri:           "/v1/jobs/1",
+			ctrlMockError: errors.New("error internal"),
+			code:          500,
+			retVal:        "{\"error\":\"internal problems\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			ctrlMock.On("Job",
+				mock.MatchedBy(func(ctx context.Context) bool { return true }),
+				mock.AnythingOfType("string")).
+				Return(job, tc.ctrlMockError).
+				Maybe()
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, nil)
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
+
+func TestBackupsRoute(t *testing.T) {
+	backups := []entity.Backup{
+		{
+			Path:         "1capp01_1541/ib/20230810_140443.dt",
+			Entrypoint:   "1capp01:1545",
+			ClusterID:    "1234-5678",
+			InfobaseID:   "8765-4321",
+			InfobaseName: "ib",
+			Created:      time.Date(2023, 8, 10, 14, 4, 43, 0, time.UTC),
+			Duration:     60.5,
+			Size:         4,
+			Checksum:     "b6ca0868bca6a2926b70aa1a71592038d9030fe26d4214edcfbd6cf41f2f4654",
+		},
+	}
+
+	cases := []struct {
+		name          string
+		method        string
+		uri           string
+		ctrlMockError error
+		code          int
+		retVal        string
+	}{
+		{
+			name:   "Success",
+			method: http.MethodGet,
+			uri:    "/v1/backups",
+			code:   http.StatusOK,
+			retVal: "{\"backups\":[{\"path\":\"1capp01_1541/ib/20230810_140443.dt\",\"ep\":\"1capp01:1545\",\"cluster\":\"1234-5678\",\"ib\":\"8765-4321\",\"ibname\":\"ib\",\"created\":\"2023-08-10T14:04:43Z\",\"duration\":60.5,\"size\":4,\"sha256\":\"b6ca0868bca6a2926b70aa1a71592038d9030fe26d4214edcfbd6cf41f2f4654\"}]}",
+		},
+		{
+			name:   "Success with filter",
+			method: http.MethodGet,
+			uri:    "/v1/backups?cluster=1234-5678&infobase=8765-4321",
+			code:   http.StatusOK,
+			retVal: "{\"backups\":[{\"path\":\"1capp01_1541/ib/20230810_140443.dt\",\"ep\":\"1capp01:1545\",\"cluster\":\"1234-5678\",\"ib\":\"8765-4321\",\"ibname\":\"ib\",\"created\":\"2023-08-10T14:04:43Z\",\"duration\":60.5,\"size\":4,\"sha256\":\"b6ca0868bca6a2926b70aa1a71592038d9030fe26d4214edcfbd6cf41f2f4654\"}]}",
+		},
+		{
+			name:          "Error internal",
+			method:        http.MethodGet,
+			uri:           "/v1/backups",
+			ctrlMockError: errors.New("error internal"),
+			code:          500,
+			retVal:        "{\"error\":\"internal problems\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			ctrlMock.On("Backups",
+				mock.MatchedBy(func(ctx context.Context) bool { return true }),
+				mock.AnythingOfType("entity.Cluster"),
+				mock.AnythingOfType("entity.Infobase")).
+				Return(backups, tc.ctrlMockError).
+				Maybe()
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, nil)
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package v1
+
+import (
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	v1e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/auth"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+	"github.com/antonmisa/1cctl/pkg/logger"
+)
+
+type fleetRoutes struct {
+	f usecase.CtrlFleet
+	l logger.Interface
+	t trace.Tracer
+}
+
+func newFleetRoutes(handler *gin.RouterGroup, f usecase.CtrlFleet, l logger.Interface, tr trace.Tracer) {
+	r := &fleetRoutes{f, l, tr}
+
+	h := handler.Group("/fleet")
+	{
+		h.GET("/sessions", r.sessions)
+		h.GET("/infobases", r.infobases)
+	}
+}
+
+type fleetQuery struct {
+	Cache    bool   `form:"usecache"`
+	Tag      string `form:"tag"       example:"prod"`
+	Infobase string `form:"infobase"  example:"buh"`
+	User     string `form:"user"      example:"ivanov"`
+}
+
+type fleetSessionsResponse struct {
+	Sessions []entity.FleetSession `json:"sessions"`
+	Errors   []entity.FleetError   `json:"errors"`
+}
+
+type fleetInfobasesResponse struct {
+	Infobases []entity.FleetInfobase `json:"infobases"`
+	Errors    []entity.FleetError    `json:"errors"`
+}
+
+// @Summary     Show sessions of fleet
+// @Description Show sessions of all clusters of all ras entrypoints in registry, entrypoints are read concurrently;
+// @Description entrypoints and clusters which failed are listed in errors along with sessions of other ones
+// @ID          fleetSessions
+// @Tags  	    session
+// @Produce     json
+// @Param		usecache	query	bool	false	"Firstly try to find from Cache"
+// @Param		tag			query	string	false	"Only entrypoints with tag"
+// @Param		infobase	query	string	false	"Only sessions of infobase (name)"
+// @Param		user		query	string	false	"Only sessions of user (name)"
+// @Success     200 {object} fleetSessionsResponse
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /fleet/sessions [get]
+func (r *fleetRoutes) sessions(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "fleetSessions")
+	defer span.End()
+
+	var query fleetQuery
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - fleetSessions")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	filter := entity.FleetFilter{Tag: query.Tag, Infobase: query.Infobase, UserName: query.User}
+
+	args := map[string]any{
+		common.UseCache: query.Cache,
+	}
+
+	span.AddEvent("get sessions of fleet")
+
+	sessions, errs, err := r.f.Sessions(ctx, filter, args)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - fleetSessions - r.f.Sessions")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	sessions = inScope(c, sessions, func(s entity.FleetSession) auth.Target {
+		return auth.Target{Entrypoint: s.Address, EntrypointName: s.Entrypoint, Cluster: s.ClusterID, Infobase: s.InfobaseName}
+	})
+	errs = inScope(c, errs, fleetErrorTarget)
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, fleetSessionsResponse{sessions, errs})
+}
+
+// @Summary     Show infobases of fleet
+// @Description Show infobases of all clusters of all ras entrypoints in registry, entrypoints are read concurrently;
+// @Description entrypoints and clusters which failed are listed in errors along with infobases of other ones
+// @ID          fleetInfobases
+// @Tags  	    infobase
+// @Produce     json
+// @Param		usecache	query	bool	false	"Firstly try to find from Cache"
+// @Param		tag			query	string	false	"Only entrypoints with tag"
+// @Param		infobase	query	string	false	"Only infobases with name"
+// @Success     200 {object} fleetInfobasesResponse
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /fleet/infobases [get]
+func (r *fleetRoutes) infobases(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "fleetInfobases")
+	defer span.End()
+
+	var query fleetQuery
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - fleetInfobases")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	filter := entity.FleetFilter{Tag: query.Tag, Infobase: query.Infobase}
+
+	args := map[string]any{
+		common.UseCache: query.Cache,
+	}
+
+	span.AddEvent("get infobases of fleet")
+
+	infobases, errs, err := r.f.Infobases(ctx, filter, args)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - fleetInfobases - r.f.Infobases")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	infobases = inScope(c, infobases, func(ib entity.FleetInfobase) auth.Target {
+		return auth.Target{Entrypoint: ib.Address, EntrypointName: ib.Entrypoint, Cluster: ib.ClusterID, Infobase: ib.Name}
+	})
+	errs = inScope(c, errs, fleetErrorTarget)
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, fleetInfobasesResponse{infobases, errs})
+}
+
+// fleetErrorTarget - failures are shown only for entrypoints and clusters the principal is granted.
+func fleetErrorTarget(fe entity.FleetError) auth.Target {
+	return auth.Target{Entrypoint: fe.Address, EntrypointName: fe.Entrypoint, Cluster: fe.ClusterID}
+}
@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func TestFleetRoute(t *testing.T) {
+	sessions := []entity.FleetSession{
+		{Entrypoint: "main", Address: "1capp01:1545", ClusterID: "1234-5678", InfobaseName: "buh", Session: entity.Session{ID: "8765-4321", UserName: "ivanov"}},
+	}
+	infobases := []entity.FleetInfobase{
+		{Entrypoint: "main", Address: "1capp01:1545", ClusterID: "1234-5678", Infobase: entity.Infobase{ID: "ib-1", Name: "buh"}},
+	}
+	errs := []entity.FleetError{
+		{Entrypoint: "reserve", Address: "1capp02:1545", Error: "f.ctrl.Clusters: connection refused"},
+	}
+
+	cases := []struct {
+		name           string
+		uri            string
+		mockMethod     string
+		mockFilter     entity.FleetFilter
+		fleetMockError error
+		code           int
+		retVal         string
+	}{
+		{
+			name:       "Success sessions",
+			uri:        "/v1/fleet/sessions?user=ivanov&tag=prod",
+			mockMethod: "Sessions",
+			mockFilter: entity.FleetFilter{Tag: "prod", UserName: "ivanov"},
+			code:       http.StatusOK,
+			retVal:     "{\"sessions\":[{\"ep\":\"main\",\"addr\":\"1capp01:1545\",\"cluster\":\"1234-5678\",\"ibname\":\"buh\",\"id\":\"8765-4321\"",
+		},
+		{
+			name:       "Success infobases",
+			uri:        "/v1/fleet/infobases?infobase=buh",
+			mockMethod: "Infobases",
+			mockFilter: entity.FleetFilter{Infobase: "buh"},
+			code:       http.StatusOK,
+			retVal: "{\"infobases\":[{\"ep\":\"main\",\"addr\":\"1capp01:1545\",\"cluster\":\"1234-5678\",\"id\":\"ib-1\",\"name\":\"buh\",\"desc\":\"\"}]," +
+				"\"errors\":[{\"ep\":\"reserve\",\"addr\":\"1capp02:1545\",\"error\":\"f.ctrl.Clusters: connection refused\"}]}",
+		},
+		{
+			name:   "Error usecache",
+			uri:    "/v1/fleet/sessions?usecache=maybe",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request parameters\"}",
+		},
+		{
+			name:           "Error internal",
+			uri:            "/v1/fleet/infobases",
+			mockMethod:     "Infobases",
+			fleetMockError: errors.New("error internal"),
+			code:           http.StatusInternalServerError,
+			retVal:         "{\"error\":\"internal problems\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			fleetMock := ucm.NewCtrlFleet(t)
+
+			switch tc.mockMethod {
+			case "Sessions":
+				fleetMock.On("Sessions",
+					mock.MatchedBy(func(ctx context.Context) bool { return true }),
+					tc.mockFilter,
+					map[string]any{common.UseCache: false}).
+					Return(sessions, errs, tc.fleetMockError)
+			case "Infobases":
+				fleetMock.On("Infobases",
+					mock.MatchedBy(func(ctx context.Context) bool { return true }),
+					tc.mockFilter,
+					map[string]any{common.UseCache: false}).
+					Return(infobases, errs, tc.fleetMockError)
+			}
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ucm.NewCtrl(t), tracer, Fleet(fleetMock))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tc.uri, nil)
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Contains(t, w.Body.String(), tc.retVal)
+		})
+	}
+}
@@ -0,0 +1,377 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/codes"
+
+	v1e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+)
+
+// @Summary     Show infobase settings
+// @Description Show DBMS, locale, locks and security settings of infobase, infobase credentials are required by 1C
+// @ID          infobaseDetails
+// @Tags  	    infobase
+// @Produce     json
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param		infobase    path	 string			true	"UUID of infobase"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Success     200 {object} entity.InfobaseDetails
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/infobase/:infobase [get]
+func (r *ctrlRoutes) infobaseDetails(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "infobaseDetails")
+	defer span.End()
+
+	var request requestWInfobase
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - infobaseDetails")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+	infobaseCred, _ := c.MustGet(common.InfobaseCred).(entity.Credentials)
+
+	span.AddEvent("get infobase details")
+
+	details, err := r.c.InfobaseDetails(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, entity.Infobase{ID: request.Infobase}, infobaseCred)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - infobaseDetails")
+
+		if errors.Is(err, usecase.ErrInfobaseNotFound) {
+			v1e.ErrorResponse(c, http.StatusNotFound, "infobase not found")
+
+			return
+		}
+
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, details)
+}
+
+type infobaseUpdateRequest struct {
+	Desc                    *string    `json:"desc"                             example:"comments"`
+	DBMS                    *string    `json:"dbms"                             example:"MSSQLServer"`
+	DBServer                *string    `json:"db_server"                        example:"sql01"`
+	DBName                  *string    `json:"db_name"                          example:"buh"`
+	DBUser                  *string    `json:"db_user"                          example:"sa"`
+	DBPwd                   *string    `json:"db_pwd"                           example:"secret"`
+	DeniedFrom              *time.Time `json:"denied_from"                      example:"2023-08-10T22:00:00Z"`
+	DeniedTo                *time.Time `json:"denied_to"                        example:"2023-08-10T23:00:00Z"`
+	DeniedMessage           *string    `json:"denied_message"                   example:"Infobase is locked"`
+	DeniedParameter         *string    `json:"denied_parameter"                 example:""`
+	PermissionCode          *string    `json:"permission_code"                  example:"12345"`
+	SessionsDeny            *bool      `json:"sessions_deny"                    example:"false"`
+	ScheduledJobsDeny       *bool      `json:"scheduled_jobs_deny"              example:"false"`
+	LicenseDistribution     *bool      `json:"license_distribution"             example:"true"`
+	SecurityProfile         *string    `json:"security_profile"                 example:""`
+	SafeModeSecurityProfile *string    `json:"safe_mode_security_profile"       example:""`
+	ReserveWorkingProcesses *bool      `json:"reserve_working_processes"        example:"false"`
+}
+
+// @Summary     Update infobase settings
+// @Description Change settings of infobase, only fields present in body are changed
+// @ID          updateInfobase
+// @Tags  	    infobase
+// @Accept      json
+// @Produce     json
+// @Param		cluster	    path	 string					true	"UUID of cluster"
+// @Param		infobase    path	 string					true	"UUID of infobase"
+// @Param       entrypoint  query    string         		true 	"Entrypoint for cluster"
+// @Param       request     body     infobaseUpdateRequest 	true 	"Settings to change"
+// @Success     200 {object} entity.InfobaseDetails
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/infobase/:infobase [patch]
+func (r *ctrlRoutes) updateInfobase(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "updateInfobase")
+	defer span.End()
+
+	var (
+		request requestWInfobase
+		body    infobaseUpdateRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - updateInfobase")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - updateInfobase")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+	infobaseCred, _ := c.MustGet(common.InfobaseCred).(entity.Credentials)
+
+	opts := entity.InfobaseUpdateOptions{
+		Desc:                    body.Desc,
+		DBMS:                    body.DBMS,
+		DBServer:                body.DBServer,
+		DBName:                  body.DBName,
+		DBUser:                  body.DBUser,
+		DBPwd:                   body.DBPwd,
+		DeniedFrom:              body.DeniedFrom,
+		DeniedTo:                body.DeniedTo,
+		DeniedMessage:           body.DeniedMessage,
+		DeniedParameter:         body.DeniedParameter,
+		PermissionCode:          body.PermissionCode,
+		SessionsDeny:            body.SessionsDeny,
+		ScheduledJobsDeny:       body.ScheduledJobsDeny,
+		LicenseDistribution:     body.LicenseDistribution,
+		SecurityProfile:         body.SecurityProfile,
+		SafeModeSecurityProfile: body.SafeModeSecurityProfile,
+		ReserveWorkingProcesses: body.ReserveWorkingProcesses,
+	}
+
+	span.AddEvent("update infobase")
+
+	details, err := r.c.UpdateInfobase(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, entity.Infobase{ID: request.Infobase}, infobaseCred, opts)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - updateInfobase")
+
+		switch {
+		case errors.Is(err, usecase.ErrLockWindowInvalid):
+			v1e.ErrorResponse(c, http.StatusBadRequest, "invalid lock window")
+		case errors.Is(err, usecase.ErrInfobaseNotFound):
+			v1e.ErrorResponse(c, http.StatusNotFound, "infobase not found")
+		default:
+			v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+		}
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, details)
+}
+
+type infobaseCreateRequest struct {
+	Name              string `json:"name"                 binding:"required"                                               example:"buh_test"`
+	Desc              string `json:"desc"                                                                                  example:"comments"`
+	DBMS              string `json:"dbms"                 binding:"required,oneof=MSSQLServer PostgreSQL IBMDB2 OracleDatabase"  example:"PostgreSQL"`
+	DBServer          string `json:"db_server"            binding:"required"                                               example:"sql01"`
+	DBName            string `json:"db_name"              binding:"required"                                               example:"buh_test"`
+	DBUser            string `json:"db_user"                                                                               example:"postgres"`
+	DBPwd             string `json:"db_pwd"                                                                                example:"secret"`
+	Locale            string `json:"locale"                                                                                example:"ru_RU"`
+	DateOffset        int    `json:"date_offset"          binding:"min=0"                                                  example:"2000"`
+	CreateDatabase    bool   `json:"create_database"                                                                       example:"true"`
+	ScheduledJobsDeny bool   `json:"scheduled_jobs_deny"                                                                   example:"true"`
+}
+
+// @Summary     Create infobase
+// @Description Register new infobase in cluster, database is created by DBMS if create_database is set
+// @ID          createInfobase
+// @Tags  	    infobase
+// @Accept      json
+// @Produce     json
+// @Param		cluster	    path	 string					true	"UUID of cluster"
+// @Param       entrypoint  query    string         		true 	"Entrypoint for cluster"
+// @Param       request     body     infobaseCreateRequest 	true 	"Parameters of new infobase"
+// @Success     201 {object} entity.Infobase
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/infobase [post]
+func (r *ctrlRoutes) createInfobase(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "createInfobase")
+	defer span.End()
+
+	var (
+		request requestWoInfobase
+		body    infobaseCreateRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - createInfobase")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - createInfobase")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	opts := entity.InfobaseCreateOptions{
+		Name:              body.Name,
+		Desc:              body.Desc,
+		DBMS:              body.DBMS,
+		DBServer:          body.DBServer,
+		DBName:            body.DBName,
+		DBUser:            body.DBUser,
+		DBPwd:             body.DBPwd,
+		Locale:            body.Locale,
+		DateOffset:        body.DateOffset,
+		CreateDatabase:    body.CreateDatabase,
+		ScheduledJobsDeny: body.ScheduledJobsDeny,
+	}
+
+	span.AddEvent("create infobase")
+
+	infobase, err := r.c.CreateInfobase(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, opts)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - createInfobase")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusCreated, infobase)
+}
+
+type infobaseDropRequest struct {
+	Confirm       string `form:"confirm"         binding:"required"  example:"buh_test"`
+	DropDatabase  bool   `form:"drop_database"                       example:"false"`
+	ClearDatabase bool   `form:"clear_database"                      example:"false"`
+}
+
+// @Summary     Drop infobase
+// @Description Remove infobase from cluster, confirm must be equal to name of infobase.
+// @Description Database is kept unless drop_database or clear_database is set
+// @ID          dropInfobase
+// @Tags  	    infobase
+// @Param		cluster	        path	 string			true	"UUID of cluster"
+// @Param		infobase        path	 string			true	"UUID of infobase"
+// @Param       entrypoint      query    string         true 	"Entrypoint for cluster"
+// @Param       confirm         query    string         true 	"Name of infobase as confirmation"
+// @Param       drop_database   query    bool           false 	"Drop database of infobase"
+// @Param       clear_database  query    bool           false 	"Clear database of infobase"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/infobase/:infobase [delete]
+func (r *ctrlRoutes) dropInfobase(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "dropInfobase")
+	defer span.End()
+
+	var (
+		request requestWInfobase
+		query   infobaseDropRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - dropInfobase")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - dropInfobase")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+	infobaseCred, _ := c.MustGet(common.InfobaseCred).(entity.Credentials)
+
+	opts := entity.InfobaseDropOptions{
+		Confirm:       query.Confirm,
+		DropDatabase:  query.DropDatabase,
+		ClearDatabase: query.ClearDatabase,
+	}
+
+	span.AddEvent("drop infobase")
+
+	err := r.c.DropInfobase(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, entity.Infobase{ID: request.Infobase}, infobaseCred, opts)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - dropInfobase")
+
+		switch {
+		case errors.Is(err, usecase.ErrDropNotConfirmed):
+			v1e.ErrorResponse(c, http.StatusBadRequest, "drop is not confirmed")
+		case errors.Is(err, usecase.ErrDropModeInvalid):
+			v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+		case errors.Is(err, usecase.ErrClusterNotFound), errors.Is(err, usecase.ErrInfobaseNotFound):
+			v1e.ErrorResponse(c, http.StatusNotFound, "infobase not found")
+		default:
+			v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+		}
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
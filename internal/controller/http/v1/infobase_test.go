@@ -0,0 +1,265 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func TestInfobaseDetailsRoute(t *testing.T) {
+	desc := "new desc"
+	deny := true
+
+	cases := []struct {
+		name          string
+		method        string
+		uri           string
+		body          string
+		mockMethod    string
+		opts          entity.InfobaseUpdateOptions
+		ctrlMockError error
+		code          int
+		retVal        string
+	}{
+		{
+			name:   "Error wo entrypoint",
+			method: http.MethodGet,
+			uri:    "/v1/cluster/1234-5678/infobase/8765-4321",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"bad request\"}",
+		},
+		{
+			name:       "Success details",
+			method:     http.MethodGet,
+			uri:        "/v1/cluster/1234-5678/infobase/8765-4321?entrypoint=1capp01:1545",
+			mockMethod: "InfobaseDetails",
+			code:       http.StatusOK,
+		},
+		{
+			name:          "Error details not found",
+			method:        http.MethodGet,
+			uri:           "/v1/cluster/1234-5678/infobase/8765-4321?entrypoint=1capp01:1545",
+			mockMethod:    "InfobaseDetails",
+			ctrlMockError: fmt.Errorf("8765-4321: %w", usecase.ErrInfobaseNotFound),
+			code:          http.StatusNotFound,
+			retVal:        "{\"error\":\"infobase not found\"}",
+		},
+		{
+			name:   "Error update body",
+			method: http.MethodPatch,
+			uri:    "/v1/cluster/1234-5678/infobase/8765-4321?entrypoint=1capp01:1545",
+			body:   "{\"sessions_deny\":\"yes\"}",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request body\"}",
+		},
+		{
+			name:       "Success update",
+			method:     http.MethodPatch,
+			uri:        "/v1/cluster/1234-5678/infobase/8765-4321?entrypoint=1capp01:1545",
+			body:       "{\"desc\":\"new desc\",\"sessions_deny\":true}",
+			mockMethod: "UpdateInfobase",
+			opts:       entity.InfobaseUpdateOptions{Desc: &desc, SessionsDeny: &deny},
+			code:       http.StatusOK,
+		},
+		{
+			name:          "Error update lock window",
+			method:        http.MethodPatch,
+			uri:           "/v1/cluster/1234-5678/infobase/8765-4321?entrypoint=1capp01:1545",
+			body:          "{\"desc\":\"new desc\",\"sessions_deny\":true}",
+			mockMethod:    "UpdateInfobase",
+			opts:          entity.InfobaseUpdateOptions{Desc: &desc, SessionsDeny: &deny},
+			ctrlMockError: usecase.ErrLockWindowInvalid,
+			code:          http.StatusBadRequest,
+			retVal:        "{\"error\":\"invalid lock window\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			details := entity.InfobaseDetails{ID: "8765-4321", Name: "buh"}
+
+			switch tc.mockMethod {
+			case "InfobaseDetails":
+				ctrlMock.On("InfobaseDetails", mock.MatchedBy(func(ctx context.Context) bool { return true }), "1capp01:1545",
+					entity.Cluster{ID: "1234-5678"}, entity.Credentials{}, entity.Infobase{ID: "8765-4321"}, entity.Credentials{}).
+					Return(details, tc.ctrlMockError)
+			case "UpdateInfobase":
+				ctrlMock.On("UpdateInfobase", mock.MatchedBy(func(ctx context.Context) bool { return true }), "1capp01:1545",
+					entity.Cluster{ID: "1234-5678"}, entity.Credentials{}, entity.Infobase{ID: "8765-4321"}, entity.Credentials{}, tc.opts).
+					Return(details, tc.ctrlMockError)
+			}
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, strings.NewReader(tc.body))
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+
+			if tc.retVal != "" {
+				require.Equal(t, tc.retVal, w.Body.String())
+			} else {
+				require.Contains(t, w.Body.String(), "\"id\":\"8765-4321\",\"name\":\"buh\"")
+			}
+		})
+	}
+}
+
+func TestCreateDropInfobaseRoute(t *testing.T) {
+	cases := []struct {
+		name          string
+		method        string
+		uri           string
+		body          string
+		mockMethod    string
+		mockArg       any
+		ctrlMockError error
+		code          int
+		retVal        string
+	}{
+		{
+			name:   "Error create wo dbms",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1234-5678/infobase?entrypoint=1capp01:1545",
+			body:   "{\"name\":\"buh_test\",\"db_server\":\"sql01\",\"db_name\":\"buh_test\"}",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request body\"}",
+		},
+		{
+			name:       "Success create",
+			method:     http.MethodPost,
+			uri:        "/v1/cluster/1234-5678/infobase?entrypoint=1capp01:1545",
+			body:       "{\"name\":\"buh_test\",\"dbms\":\"PostgreSQL\",\"db_server\":\"sql01\",\"db_name\":\"buh_test\",\"create_database\":true}",
+			mockMethod: "CreateInfobase",
+			mockArg: entity.InfobaseCreateOptions{Name: "buh_test", DBMS: "PostgreSQL", DBServer: "sql01", DBName: "buh_test",
+				CreateDatabase: true},
+			code:   http.StatusCreated,
+			retVal: "{\"id\":\"8765-4321\",\"name\":\"buh_test\",\"desc\":\"\"}",
+		},
+		{
+			name:   "Error drop wo confirm",
+			method: http.MethodDelete,
+			uri:    "/v1/cluster/1234-5678/infobase/8765-4321?entrypoint=1capp01:1545",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request parameters\"}",
+		},
+		{
+			name:          "Error drop not confirmed",
+			method:        http.MethodDelete,
+			uri:           "/v1/cluster/1234-5678/infobase/8765-4321?entrypoint=1capp01:1545&confirm=buh",
+			mockMethod:    "DropInfobase",
+			mockArg:       entity.InfobaseDropOptions{Confirm: "buh"},
+			ctrlMockError: usecase.ErrDropNotConfirmed,
+			code:          http.StatusBadRequest,
+			retVal:        "{\"error\":\"drop is not confirmed\"}",
+		},
+		{
+			name:       "Success drop",
+			method:     http.MethodDelete,
+			uri:        "/v1/cluster/1234-5678/infobase/8765-4321?entrypoint=1capp01:1545&confirm=buh_test&drop_database=true",
+			mockMethod: "DropInfobase",
+			mockArg:    entity.InfobaseDropOptions{Confirm: "buh_test", DropDatabase: true},
+			code:       http.StatusNoContent,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			switch tc.mockMethod {
+			case "CreateInfobase":
+				ctrlMock.On("CreateInfobase", mock.MatchedBy(func(ctx context.Context) bool { return true }), "1capp01:1545",
+					entity.Cluster{ID: "1234-5678"}, entity.Credentials{}, tc.mockArg).
+					Return(entity.Infobase{ID: "8765-4321", Name: "buh_test"}, tc.ctrlMockError)
+			case "DropInfobase":
+				ctrlMock.On("DropInfobase", mock.MatchedBy(func(ctx context.Context) bool { return true }), "1capp01:1545",
+					entity.Cluster{ID: "1234-5678"}, entity.Credentials{}, entity.Infobase{ID: "8765-4321"}, entity.Credentials{}, tc.mockArg).
+					Return(tc.ctrlMockError)
+			}
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, strings.NewReader(tc.body))
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
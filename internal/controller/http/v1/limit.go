@@ -0,0 +1,324 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/codes"
+
+	v1e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+)
+
+type limitResponse struct {
+	Limits []entity.Limit `json:"limits"`
+}
+
+type limitNameRequest struct {
+	Cluster string `uri:"cluster"  binding:"required"  example:"UUID"`
+	Name    string `uri:"name"     binding:"required"  example:"heavy_calls"`
+}
+
+type limitRequest struct {
+	Counter                string `json:"counter"                    binding:"required"  example:"calls"`
+	Action                 string `json:"action"                     binding:"required,oneof=none set-low-priority-thread interrupt-current-call interrupt-session"  example:"interrupt-current-call"`
+	Duration               int    `json:"duration"                   binding:"min=0"     example:"60"`
+	CPUTime                int    `json:"cpu_time"                   binding:"min=0"     example:"0"`
+	Memory                 int    `json:"memory"                     binding:"min=0"     example:"0"`
+	Read                   int    `json:"read"                       binding:"min=0"     example:"0"`
+	Write                  int    `json:"write"                      binding:"min=0"     example:"0"`
+	DurationDBMS           int    `json:"duration_dbms"              binding:"min=0"     example:"0"`
+	DBMSBytes              int    `json:"dbms_bytes"                 binding:"min=0"     example:"0"`
+	Service                int    `json:"service"                    binding:"min=0"     example:"0"`
+	Call                   int    `json:"call"                       binding:"min=0"     example:"0"`
+	NumberOfActiveSessions int    `json:"number_of_active_sessions"  binding:"min=0"     example:"0"`
+	NumberOfSessions       int    `json:"number_of_sessions"         binding:"min=0"     example:"0"`
+	ErrorMessage           string `json:"error_message"                                  example:"call is too long"`
+	Desc                   string `json:"desc"                                           example:"comments"`
+}
+
+type limitCreateRequest struct {
+	Name string `json:"name"  binding:"required"  example:"heavy_calls"`
+	limitRequest
+}
+
+func (l limitRequest) options(name string) entity.LimitOptions {
+	return entity.LimitOptions{
+		Name:                   name,
+		Counter:                l.Counter,
+		Action:                 l.Action,
+		Duration:               l.Duration,
+		CPUTime:                l.CPUTime,
+		Memory:                 l.Memory,
+		Read:                   l.Read,
+		Write:                  l.Write,
+		DurationDBMS:           l.DurationDBMS,
+		DBMSBytes:              l.DBMSBytes,
+		Service:                l.Service,
+		Call:                   l.Call,
+		NumberOfActiveSessions: l.NumberOfActiveSessions,
+		NumberOfSessions:       l.NumberOfSessions,
+		ErrorMessage:           l.ErrorMessage,
+		Desc:                   l.Desc,
+	}
+}
+
+// @Summary     Show resource consumption limits
+// @Description Show all resource consumption limits of cluster
+// @ID          limits
+// @Tags  	    limit
+// @Produce     json
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Success     200 {object} limitResponse
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/limit/list [get]
+func (r *ctrlRoutes) limits(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "limits")
+	defer span.End()
+
+	var request requestWoInfobase
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - limits")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("get list of limits")
+
+	limits, err := r.c.Limits(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - limits")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, limitResponse{limits})
+}
+
+// @Summary     Create resource consumption limit
+// @Description Add resource consumption limit to cluster
+// @ID          createLimit
+// @Tags  	    limit
+// @Accept      json
+// @Produce     json
+// @Param		cluster	    path	 string					true	"UUID of cluster"
+// @Param       entrypoint  query    string         		true 	"Entrypoint for cluster"
+// @Param       request     body     limitCreateRequest 	true 	"Name and settings of limit"
+// @Success     201 {object} entity.Limit
+// @Failure     400 {object} error.response
+// @Failure     409 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/limit [post]
+func (r *ctrlRoutes) createLimit(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "createLimit")
+	defer span.End()
+
+	var (
+		request requestWoInfobase
+		body    limitCreateRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - createLimit")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - createLimit")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("create limit")
+
+	limit, err := r.c.CreateLimit(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, body.options(body.Name))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - createLimit")
+
+		if errors.Is(err, usecase.ErrLimitExists) {
+			v1e.ErrorResponse(c, http.StatusConflict, "limit already exists")
+
+			return
+		}
+
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusCreated, limit)
+}
+
+// @Summary     Update resource consumption limit
+// @Description Change settings of resource consumption limit, all thresholds are written
+// @ID          updateLimit
+// @Tags  	    limit
+// @Accept      json
+// @Produce     json
+// @Param		cluster	    path	 string				true	"UUID of cluster"
+// @Param		name	    path	 string				true	"Name of limit"
+// @Param       entrypoint  query    string         	true 	"Entrypoint for cluster"
+// @Param       request     body     limitRequest 	true 	"Settings of limit"
+// @Success     200 {object} entity.Limit
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/limit/:name [put]
+func (r *ctrlRoutes) updateLimit(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "updateLimit")
+	defer span.End()
+
+	var (
+		request limitNameRequest
+		body    limitRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - updateLimit")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - updateLimit")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("update limit")
+
+	limit, err := r.c.UpdateLimit(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, body.options(request.Name))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - updateLimit")
+
+		if errors.Is(err, usecase.ErrLimitNotFound) {
+			v1e.ErrorResponse(c, http.StatusNotFound, "limit not found")
+
+			return
+		}
+
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, limit)
+}
+
+// @Summary     Remove resource consumption limit
+// @Description Delete resource consumption limit of cluster by name
+// @ID          removeLimit
+// @Tags  	    limit
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param		name	    path	 string			true	"Name of limit"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/limit/:name [delete]
+func (r *ctrlRoutes) removeLimit(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "removeLimit")
+	defer span.End()
+
+	var request limitNameRequest
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - removeLimit")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("remove limit")
+
+	err := r.c.RemoveLimit(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, request.Name)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - removeLimit")
+
+		if errors.Is(err, usecase.ErrLimitNotFound) {
+			v1e.ErrorResponse(c, http.StatusNotFound, "limit not found")
+
+			return
+		}
+
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/codes"
+
+	v1e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+)
+
+type locksRequest struct {
+	Connection string `form:"connection"  example:"UUID"`
+	Session    string `form:"session"     example:"UUID"`
+}
+
+type lockResponse struct {
+	Locks []entity.Lock `json:"locks"`
+}
+
+// @Summary     Show managed locks in infobase
+// @Description Show managed locks of infobase with sessions holding them, list may be narrowed to connection or session
+// @ID          locks
+// @Tags  	    lock list infobase
+// @Produce     json
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param		infobase    path	 string			true	"UUID of infobase"
+// @Param		connection  query	 string			false	"UUID of connection"
+// @Param		session     query	 string			false	"UUID of session"
+// @Param		cache	    query	 bool			false	"Firstly try to find sessions from Cache"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Success     200 {object} lockResponse
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/infobase/:infobase/lock/list [get]
+func (r *ctrlRoutes) locks(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "locks")
+	defer span.End()
+
+	var (
+		request requestWInfobase
+		filter  locksRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - locks")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - locks")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	args := map[string]any{
+		common.UseCache:    c.MustGet(common.UseCache),
+		common.ClusterCred: c.MustGet(common.ClusterCred),
+	}
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("get list of locks")
+
+	locks, err := r.c.Locks(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, entity.Infobase{ID: request.Infobase},
+		entity.LockFilter{Connection: filter.Connection, Session: filter.Session}, args)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - locks")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, lockResponse{locks})
+}
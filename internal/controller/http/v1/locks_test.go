@@ -0,0 +1,120 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func TestLocksRoute(t *testing.T) {
+	cases := []struct {
+		name           string
+		uri            string
+		filter         *entity.LockFilter
+		ctrlMockResult []entity.Lock
+		ctrlMockError  error
+		code           int
+		retVal         string
+	}{
+		{
+			name:   "Erorr wo entrypoint",
+			uri:    "/v1/cluster/1234-5678/infobase/8765-4321/lock/list",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"bad request\"}",
+		},
+		{
+			name:   "Success",
+			uri:    "/v1/cluster/1234-5678/infobase/8765-4321/lock/list?entrypoint=1capp01:1545",
+			filter: &entity.LockFilter{},
+			ctrlMockResult: []entity.Lock{
+				{SessionID: "1111", Object: "1", Owner: &entity.Session{ID: "1111", UserName: "buh"}},
+				{SessionID: "2222", Object: "2"},
+			},
+			code:   http.StatusOK,
+			retVal: "{\"locks\":[{\"conn\":\"\",\"session\":\"1111\",\"object\":\"1\",\"locked\":\"0001-01-01T00:00:00Z\",\"desc\":\"\",\"owner\":{\"id\":\"1111\",\"sid\":0,\"ib\":\"\",\"conn\":\"\",\"proc\":\"\",\"uname\":\"buh\",\"host\":\"\",\"appid\":\"\",\"loc\":\"\",\"started\":\"0001-01-01T00:00:00Z\",\"active\":\"0001-01-01T00:00:00Z\",\"hib\":\"\",\"hibtm\":0,\"hibterm\":0,\"blockdb\":0,\"blockls\":0,\"bytes\":0,\"bytes5m\":0,\"calls\":0,\"calls5m\":0,\"bytesdb\":0,\"bytesdb5m\":0,\"dbproci\":\"\",\"dbproc\":0,\"dbprocat\":\"\",\"dur\":0,\"durdb\":0,\"durcur\":0,\"durcurdb\":0,\"dur5m\":0,\"durdb5m\":0,\"memcur\":0,\"mem5m\":0,\"mem\":0,\"readcur\":0,\"read5m\":0,\"read\":0,\"writecur\":0,\"write5m\":0,\"write\":0,\"dursvccur\":0,\"dursvc5m\":0,\"dursvc\":0,\"svc\":\"\",\"cpucur\":0,\"cpu5m\":0,\"cpu\":0,\"sep\":\"\"}},{\"conn\":\"\",\"session\":\"2222\",\"object\":\"2\",\"locked\":\"0001-01-01T00:00:00Z\",\"desc\":\"\"}]}",
+		},
+		{
+			name:           "Success filtered",
+			uri:            "/v1/cluster/1234-5678/infobase/8765-4321/lock/list?entrypoint=1capp01:1545&connection=1-1&session=2-2",
+			filter:         &entity.LockFilter{Connection: "1-1", Session: "2-2"},
+			ctrlMockResult: []entity.Lock{},
+			code:           http.StatusOK,
+			retVal:         "{\"locks\":[]}",
+		},
+		{
+			name:          "Error locks",
+			uri:           "/v1/cluster/1234-5678/infobase/8765-4321/lock/list?entrypoint=1capp01:1545",
+			filter:        &entity.LockFilter{},
+			ctrlMockError: errors.New("rac error"),
+			code:          http.StatusInternalServerError,
+			retVal:        "{\"error\":\"internal problems\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			if tc.filter != nil {
+				ctrlMock.On("Locks",
+					mock.MatchedBy(func(ctx context.Context) bool { return true }),
+					"1capp01:1545",
+					entity.Cluster{ID: "1234-5678"},
+					mock.Anything,
+					entity.Infobase{ID: "8765-4321"},
+					*tc.filter,
+					mock.Anything).
+					Return(tc.ctrlMockResult, tc.ctrlMockError)
+			}
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tc.uri, nil)
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package agentcredentials
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+	"github.com/antonmisa/1cctl/pkg/logger"
+)
+
+type agentCred struct {
+	Login    string `header:"agent-login"`
+	Password string `header:"agent-password"`
+}
+
+func UseAgentCredentials(l logger.Interface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		agentCred := agentCred{}
+
+		if err := c.ShouldBindHeader(&agentCred); err != nil {
+			l.Error(err, "http - v1 - UseAgentCredentials")
+			e.ErrorResponse(c, http.StatusBadRequest, "bad request")
+
+			return
+		}
+
+		c.Set(common.AgentCred, entity.Credentials{Name: agentCred.Login, Pwd: agentCred.Password})
+
+		c.Next()
+	}
+}
@@ -0,0 +1,156 @@
+// Package auth authenticates requests by API key or bearer JWT and authorizes them by role.
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+	"github.com/antonmisa/1cctl/pkg/logger"
+)
+
+const (
+	apiKeyHeader = "X-API-Key"
+	bearerPrefix = "Bearer "
+)
+
+var (
+	ErrNoCredentials = errors.New("no api key or bearer token")
+	ErrUnknownAPIKey = errors.New("unknown api key")
+	ErrNoRole        = errors.New("token has no known role")
+)
+
+// APIKey - key is kept as hex of its sha256 only.
+type APIKey struct {
+	Name string
+	Hash string
+	Role Role
+}
+
+// Authenticator - known api keys and keys of JWT issuer, role of token is taken from roleClaim.
+type Authenticator struct {
+	keys      []APIKey
+	jwks      JWKS
+	issuer    string
+	audience  string
+	roleClaim string
+	grants    map[string]Scope
+	now       func() time.Time
+}
+
+// New - jwks may be empty to accept api keys only;
+// grants are set by name of api key or subject of token, principals without grants are not scoped.
+func New(keys []APIKey, jwks JWKS, issuer, audience, roleClaim string, grants map[string]Scope) *Authenticator {
+	return &Authenticator{
+		keys:      keys,
+		jwks:      jwks,
+		issuer:    issuer,
+		audience:  audience,
+		roleClaim: roleClaim,
+		grants:    grants,
+		now:       time.Now,
+	}
+}
+
+// HashAPIKey - value to be put in config for key.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// Authenticate - subject and role of request.
+func (a *Authenticator) Authenticate(r *http.Request) (string, Role, error) {
+	if key := r.Header.Get(apiKeyHeader); key != "" {
+		return a.authenticateKey(key)
+	}
+
+	if h := r.Header.Get("Authorization"); strings.HasPrefix(h, bearerPrefix) && len(a.jwks) > 0 {
+		return a.authenticateToken(strings.TrimPrefix(h, bearerPrefix))
+	}
+
+	return "", RoleNone, ErrNoCredentials
+}
+
+func (a *Authenticator) authenticateKey(key string) (string, Role, error) {
+	hash := []byte(HashAPIKey(key))
+
+	for _, k := range a.keys {
+		if subtle.ConstantTimeCompare(hash, []byte(strings.ToLower(k.Hash))) == 1 {
+			return k.Name, k.Role, nil
+		}
+	}
+
+	return "", RoleNone, ErrUnknownAPIKey
+}
+
+// authenticateToken - the highest of roles in claim is taken.
+func (a *Authenticator) authenticateToken(token string) (string, Role, error) {
+	claims, err := verifyJWT(token, a.jwks, a.issuer, a.audience, a.now())
+	if err != nil {
+		return "", RoleNone, fmt.Errorf("verifyJWT: %w", err)
+	}
+
+	role := RoleNone
+
+	for _, name := range claims.Strings(a.roleClaim) {
+		if r, err := ParseRole(name); err == nil && r > role {
+			role = r
+		}
+	}
+
+	if role == RoleNone {
+		return "", RoleNone, ErrNoRole
+	}
+
+	return claims.String("sub"), role, nil
+}
+
+// UseAuth - rejects requests without valid api key or token (401)
+// and ones whose role is lower than required by route (403);
+// scoped principal is allowed routes which are not checked by UseScope only when it's granted everything.
+func UseAuth(l logger.Interface, a *Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+
+		subject, role, err := a.Authenticate(c.Request)
+		if err != nil {
+			l.Error(err, "http - v1 - UseAuth")
+			e.ErrorResponse(c, http.StatusUnauthorized, "unauthorized")
+
+			return
+		}
+
+		if required := RequiredRole(c.Request.Method, c.FullPath()); role < required {
+			l.Warn("http - v1 - UseAuth: %s with role %s requires %s for %s %s", subject, role, required, c.Request.Method, c.FullPath())
+			e.ErrorResponse(c, http.StatusForbidden, "forbidden")
+
+			return
+		}
+
+		scope := a.grants[subject]
+		if scope != nil && !scopedRoute(c.FullPath()) && !scope.Allows(Target{}, false) {
+			l.Warn("http - v1 - UseAuth: %s is not granted %s %s", subject, c.Request.Method, c.FullPath())
+			e.ErrorResponse(c, http.StatusForbidden, "forbidden")
+
+			return
+		}
+
+		c.Set(common.Subject, subject)
+		c.Set(common.Role, role.String())
+
+		if scope != nil {
+			c.Set(common.Scope, scope)
+		}
+
+		c.Next()
+	}
+}
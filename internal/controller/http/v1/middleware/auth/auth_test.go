@@ -0,0 +1,262 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+var now = time.Date(2023, 8, 10, 14, 0, 0, 0, time.UTC)
+
+func encode(v any) string {
+	b, _ := json.Marshal(v)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]any) string {
+	signed := encode(map[string]string{"alg": "RS256", "kid": kid}) + "." + encode(claims)
+	digest := sha256.Sum256([]byte(signed))
+
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func signES256(t *testing.T, key *ecdsa.PrivateKey, kid string, claims map[string]any) string {
+	signed := encode(map[string]string{"alg": "ES256", "kid": kid}) + "." + encode(claims)
+	digest := sha256.Sum256([]byte(signed))
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestParseJWKS(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	data, _ := json.Marshal(map[string]any{"keys": []map[string]string{
+		{
+			"kty": "RSA", "kid": "rsa-1", "use": "sig",
+			"n": base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+			"e": base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes()),
+		},
+		{
+			"kty": "EC", "kid": "ec-1", "crv": "P-256",
+			"x": base64.RawURLEncoding.EncodeToString(ecKey.X.Bytes()),
+			"y": base64.RawURLEncoding.EncodeToString(ecKey.Y.Bytes()),
+		},
+		{
+			"kty": "RSA", "kid": "enc-1", "use": "enc",
+		},
+	}})
+
+	keys, err := ParseJWKS(data)
+	require.NoError(t, err)
+
+	require.Len(t, keys, 2)
+	require.True(t, rsaKey.PublicKey.Equal(keys["rsa-1"]))
+	require.True(t, ecKey.PublicKey.Equal(keys["ec-1"]))
+
+	_, err = ParseJWKS([]byte(`{"keys":[{"kty":"oct","kid":"hmac"}]}`))
+	require.Error(t, err)
+}
+
+func TestUseAuth(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwks := JWKS{"rsa-1": &rsaKey.PublicKey, "ec-1": &ecKey.PublicKey}
+
+	keys := []APIKey{
+		{Name: "monitoring", Hash: HashAPIKey("viewer-key"), Role: RoleViewer},
+		{Name: "ops", Hash: HashAPIKey("operator-key"), Role: RoleOperator},
+	}
+
+	claims := func(role any, exp time.Time) map[string]any {
+		return map[string]any{"sub": "ivanov", "iss": "idp", "aud": []string{"1cctl"}, "role": role, "exp": exp.Unix()}
+	}
+
+	cases := []struct {
+		name   string
+		method string
+		path   string
+		apiKey string
+		token  string
+		code   int
+	}{
+		{
+			name:   "Without credentials",
+			method: http.MethodGet,
+			path:   "/v1/cluster/list",
+			code:   http.StatusUnauthorized,
+		},
+		{
+			name:   "Unknown api key",
+			method: http.MethodGet,
+			path:   "/v1/cluster/list",
+			apiKey: "guess",
+			code:   http.StatusUnauthorized,
+		},
+		{
+			name:   "Viewer lists",
+			method: http.MethodGet,
+			path:   "/v1/cluster/list",
+			apiKey: "viewer-key",
+			code:   http.StatusOK,
+		},
+		{
+			name:   "Viewer deletes session",
+			method: http.MethodDelete,
+			path:   "/v1/cluster/1234-5678/session/8765-4321",
+			apiKey: "viewer-key",
+			code:   http.StatusForbidden,
+		},
+		{
+			name:   "Operator deletes session",
+			method: http.MethodDelete,
+			path:   "/v1/cluster/1234-5678/session/8765-4321",
+			apiKey: "operator-key",
+			code:   http.StatusOK,
+		},
+		{
+			name:   "Operator drops infobase",
+			method: http.MethodDelete,
+			path:   "/v1/ras/main/cluster/1234-5678/infobase/ib-1",
+			apiKey: "operator-key",
+			code:   http.StatusForbidden,
+		},
+		{
+			name:   "Admin token drops infobase",
+			method: http.MethodDelete,
+			path:   "/v1/ras/main/cluster/1234-5678/infobase/ib-1",
+			token:  signRS256(t, rsaKey, "rsa-1", claims([]string{"viewer", "admin"}, now.Add(time.Hour))),
+			code:   http.StatusOK,
+		},
+		{
+			name:   "Operator token of EC key",
+			method: http.MethodDelete,
+			path:   "/v1/cluster/1234-5678/session/8765-4321",
+			token:  signES256(t, ecKey, "ec-1", claims("operator", now.Add(time.Hour))),
+			code:   http.StatusOK,
+		},
+		{
+			name:   "Expired token",
+			method: http.MethodGet,
+			path:   "/v1/cluster/list",
+			token:  signRS256(t, rsaKey, "rsa-1", claims("admin", now.Add(-time.Minute))),
+			code:   http.StatusUnauthorized,
+		},
+		{
+			name:   "Token signed by other key",
+			method: http.MethodGet,
+			path:   "/v1/cluster/list",
+			token:  signRS256(t, otherKey, "rsa-1", claims("admin", now.Add(time.Hour))),
+			code:   http.StatusUnauthorized,
+		},
+		{
+			name:   "Token without role",
+			method: http.MethodGet,
+			path:   "/v1/cluster/list",
+			token:  signRS256(t, rsaKey, "rsa-1", claims("guest", now.Add(time.Hour))),
+			code:   http.StatusUnauthorized,
+		},
+		{
+			name:   "Token of other audience",
+			method: http.MethodGet,
+			path:   "/v1/cluster/list",
+			token: signRS256(t, rsaKey, "rsa-1",
+				map[string]any{"sub": "ivanov", "iss": "idp", "aud": "other", "role": "admin", "exp": now.Add(time.Hour).Unix()}),
+			code: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Warn", mock.AnythingOfType("string"), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+			logMock.On("Error", mock.Anything, mock.Anything).Maybe()
+
+			a := New(keys, jwks, "idp", "1cctl", "role", nil)
+			a.now = func() time.Time { return now }
+
+			handler := gin.New()
+
+			h := handler.Group("/v1")
+			{
+				h.Use(UseAuth(logMock, a))
+
+				h.GET("/cluster/list", func(c *gin.Context) { c.Status(http.StatusOK) })
+				h.DELETE("/cluster/:cluster/session/:session", func(c *gin.Context) { c.Status(http.StatusOK) })
+				h.DELETE("/ras/:ras/cluster/:cluster/infobase/:infobase", func(c *gin.Context) { c.Status(http.StatusOK) })
+			}
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.path, nil)
+
+			if tc.apiKey != "" {
+				req.Header.Set("X-API-Key", tc.apiKey)
+			}
+
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
+func TestRequiredRole(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, RoleViewer, RequiredRole(http.MethodGet, "/v1/cluster/:cluster/session/list"))
+	require.Equal(t, RoleViewer, RequiredRole(http.MethodGet, "/v1/ras"))
+	require.Equal(t, RoleOperator, RequiredRole(http.MethodPost, "/v1/cluster/:cluster/infobase/:infobase/lock"))
+	require.Equal(t, RoleAdmin, RequiredRole(http.MethodPost, "/v1/cluster"))
+	require.Equal(t, RoleAdmin, RequiredRole(http.MethodGet, "/v1/agent/admin/list"))
+	require.Equal(t, RoleAdmin, RequiredRole(http.MethodDelete, "/v1/ras/:ras/cluster/:cluster/admin/:name"))
+	require.Equal(t, RoleAdmin, RequiredRole(http.MethodPatch, "/v1/ras/:ras/cluster/:cluster"))
+	require.Equal(t, RoleAdmin, RequiredRole(http.MethodGet, "/v1/vault"))
+}
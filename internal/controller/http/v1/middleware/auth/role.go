@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Role - roles are ordered, each one is allowed everything lower roles are.
+type Role int
+
+const (
+	RoleNone Role = iota
+	RoleViewer
+	RoleOperator
+	RoleAdmin
+)
+
+var ErrUnknownRole = errors.New("unknown role")
+
+var roleNames = map[Role]string{
+	RoleViewer:   "viewer",
+	RoleOperator: "operator",
+	RoleAdmin:    "admin",
+}
+
+// ParseRole - role by name ignoring case.
+func ParseRole(name string) (Role, error) {
+	for r, n := range roleNames {
+		if strings.EqualFold(n, name) {
+			return r, nil
+		}
+	}
+
+	return RoleNone, fmt.Errorf("%s: %w", name, ErrUnknownRole)
+}
+
+func (r Role) String() string {
+	if n, ok := roleNames[r]; ok {
+		return n
+	}
+
+	return "none"
+}
+
+// adminRoutes - routes registering and removing clusters, infobases and administrators.
+var adminRoutes = map[string]struct{}{
+	http.MethodPost + " /cluster":                               {},
+	http.MethodPatch + " /cluster/:cluster":                     {},
+	http.MethodDelete + " /cluster/:cluster":                    {},
+	http.MethodPost + " /cluster/:cluster/infobase":             {},
+	http.MethodDelete + " /cluster/:cluster/infobase/:infobase": {},
+}
+
+// RequiredRole - reading needs viewer and changing needs operator,
+// clusters, infobases and administrators are registered and removed by admin only, so is vault of credentials;
+// routes of /ras/:ras are the same as their origins.
+func RequiredRole(method, path string) Role {
+	path = strings.TrimPrefix(path, "/v1")
+
+	if strings.HasPrefix(path, "/ras/:ras/") {
+		path = strings.TrimPrefix(path, "/ras/:ras")
+	}
+
+	if _, ok := adminRoutes[method+" "+path]; ok {
+		return RoleAdmin
+	}
+
+	if path == "/vault" || strings.HasSuffix(path, "/admin") || strings.Contains(path, "/admin/") {
+		return RoleAdmin
+	}
+
+	if method == http.MethodGet || method == http.MethodHead {
+		return RoleViewer
+	}
+
+	return RoleOperator
+}
@@ -0,0 +1,182 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+	"github.com/antonmisa/1cctl/pkg/logger"
+)
+
+// Grant - entrypoints (address or name in registry), clusters (id) and infobases (name) principal may access,
+// values are shell patterns matched ignoring case, empty list allows any.
+type Grant struct {
+	Entrypoints []string
+	Clusters    []string
+	Infobases   []string
+}
+
+// Target - objects of request, empty fields are not specified by route.
+type Target struct {
+	Entrypoint     string
+	EntrypointName string
+	Cluster        string
+	Infobase       string
+}
+
+// Scope - grants of principal, principal without grants is not scoped at all.
+type Scope []Grant
+
+// filteredRoutes - lists narrowed by scope, so they are allowed to principal granted part of objects.
+var filteredRoutes = map[string]struct{}{
+	"/ras":                              {},
+	"/fleet/sessions":                   {},
+	"/fleet/infobases":                  {},
+	"/cluster/list":                     {},
+	"/cluster/:cluster/infobase/list":   {},
+	"/cluster/:cluster/session/list":    {},
+	"/cluster/:cluster/connection/list": {},
+}
+
+// Allows - some grant covers target; objects not specified by target must be granted entirely
+// unless partial is set, then it's enough to have any of them granted.
+func (s Scope) Allows(t Target, partial bool) bool {
+	for _, g := range s {
+		if allows(g.Entrypoints, partial, t.Entrypoint, t.EntrypointName) &&
+			allows(g.Clusters, partial, t.Cluster) &&
+			allows(g.Infobases, partial, t.Infobase) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s Scope) restrictsInfobases() bool {
+	for _, g := range s {
+		if len(g.Infobases) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func allows(patterns []string, partial bool, values ...string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+
+	specified := false
+
+	for _, v := range values {
+		if v == "" {
+			continue
+		}
+
+		specified = true
+
+		for _, p := range patterns {
+			if ok, _ := path.Match(strings.ToLower(p), strings.ToLower(entity.TrimName(v))); ok {
+				return true
+			}
+		}
+	}
+
+	return !specified && partial
+}
+
+// ScopeOf - scope of principal of request, nil when principal is not scoped.
+func ScopeOf(c *gin.Context) Scope {
+	v, _ := c.Get(common.Scope)
+	s, _ := v.(Scope)
+
+	return s
+}
+
+// scopedRoute - routes checked by UseScope or filtered by their handlers.
+func scopedRoute(p string) bool {
+	p = strings.TrimPrefix(p, "/v1")
+
+	if p == "/cluster" || p == "/ras" {
+		return true
+	}
+
+	for _, prefix := range []string{"/cluster/", "/agent/", "/ras/", "/fleet/"} {
+		if strings.HasPrefix(p, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UseScope - rejects requests to objects principal is not granted (403),
+// infobase of route is matched by its name which is read from cached list of infobases of cluster.
+func UseScope(l logger.Interface, t usecase.Ctrl) gin.HandlerFunc {
+	return func(c *gin.Context) {
+
+		s := ScopeOf(c)
+		if s == nil {
+			c.Next()
+
+			return
+		}
+
+		target := Target{
+			Entrypoint:     c.GetString(common.Entrypoint),
+			EntrypointName: c.GetString(common.EntrypointName),
+			Cluster:        c.Param("cluster"),
+		}
+
+		if id := c.Param("infobase"); id != "" && s.restrictsInfobases() {
+			clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+			name, err := infobaseName(c.Request.Context(), t, target, clusterCred, id)
+			if err != nil {
+				l.Error(err, "http - v1 - UseScope")
+				e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+				return
+			}
+
+			target.Infobase = name
+		}
+
+		_, partial := filteredRoutes[strings.TrimPrefix(strings.TrimPrefix(c.FullPath(), "/v1"), "/ras/:ras")]
+		partial = partial && c.Request.Method == http.MethodGet
+
+		if !s.Allows(target, partial) {
+			l.Warn("http - v1 - UseScope: %s is not granted %s %s", c.GetString(common.Subject), c.Request.Method, c.Request.URL.Path)
+			e.ErrorResponse(c, http.StatusForbidden, "forbidden")
+
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// infobaseName - id is returned for infobase which is not found, so it's matched by no name pattern.
+func infobaseName(ctx context.Context, t usecase.Ctrl, target Target, clusterCred entity.Credentials, id string) (string, error) {
+	infobases, err := t.Infobases(ctx, target.Entrypoint, entity.Cluster{ID: target.Cluster}, clusterCred, map[string]any{common.UseCache: true})
+	if err != nil {
+		return "", fmt.Errorf("t.Infobases: %w", err)
+	}
+
+	for _, ib := range infobases {
+		if ib.ID == id {
+			return entity.TrimName(ib.Name), nil
+		}
+	}
+
+	return id, nil
+}
@@ -0,0 +1,195 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func TestScopeAllows(t *testing.T) {
+	t.Parallel()
+
+	scope := Scope{
+		{Entrypoints: []string{"main"}, Infobases: []string{"buh*"}},
+		{Entrypoints: []string{"1capp02:*"}, Clusters: []string{"1234-5678"}},
+	}
+
+	cases := []struct {
+		name    string
+		target  Target
+		partial bool
+		allowed bool
+	}{
+		{
+			name:    "Infobase by pattern",
+			target:  Target{Entrypoint: "1capp01:1545", EntrypointName: "main", Cluster: "1234-5678", Infobase: "\"BUH_2023\""},
+			allowed: true,
+		},
+		{
+			name:   "Other infobase",
+			target: Target{Entrypoint: "1capp01:1545", EntrypointName: "main", Cluster: "1234-5678", Infobase: "zup"},
+		},
+		{
+			name:   "Cluster of infobases granted partially",
+			target: Target{Entrypoint: "1capp01:1545", EntrypointName: "main", Cluster: "1234-5678"},
+		},
+		{
+			name:    "List of cluster of infobases granted partially",
+			target:  Target{Entrypoint: "1capp01:1545", EntrypointName: "main", Cluster: "1234-5678"},
+			partial: true,
+			allowed: true,
+		},
+		{
+			name:    "Cluster by entrypoint address",
+			target:  Target{Entrypoint: "1capp02:1545", Cluster: "1234-5678", Infobase: "zup"},
+			allowed: true,
+		},
+		{
+			name:   "Other cluster",
+			target: Target{Entrypoint: "1capp02:1545", Cluster: "8765-4321"},
+		},
+		{
+			name:   "Without entrypoint",
+			target: Target{},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.allowed, scope.Allows(tc.target, tc.partial))
+		})
+	}
+
+	require.True(t, Scope{{}}.Allows(Target{}, false))
+	require.False(t, Scope{}.Allows(Target{}, true))
+}
+
+func TestUseScope(t *testing.T) {
+	cred := entity.Credentials{Name: "admin"}
+
+	cases := []struct {
+		name          string
+		method        string
+		path          string
+		scope         Scope
+		ctrlMockError error
+		code          int
+	}{
+		{
+			name:   "Not scoped",
+			method: http.MethodPost,
+			path:   "/v1/cluster/1234-5678/infobase/ib-2/lock",
+			code:   http.StatusOK,
+		},
+		{
+			name:   "Granted infobase",
+			method: http.MethodPost,
+			path:   "/v1/cluster/1234-5678/infobase/ib-1/lock",
+			scope:  Scope{{Infobases: []string{"buh"}}},
+			code:   http.StatusOK,
+		},
+		{
+			name:   "Not granted infobase",
+			method: http.MethodPost,
+			path:   "/v1/cluster/1234-5678/infobase/ib-2/lock",
+			scope:  Scope{{Infobases: []string{"buh"}}},
+			code:   http.StatusForbidden,
+		},
+		{
+			name:   "Unknown infobase",
+			method: http.MethodPost,
+			path:   "/v1/cluster/1234-5678/infobase/ib-3/lock",
+			scope:  Scope{{Infobases: []string{"buh"}}},
+			code:   http.StatusForbidden,
+		},
+		{
+			name:   "Sessions of cluster of infobases granted partially",
+			method: http.MethodDelete,
+			path:   "/v1/cluster/1234-5678/session",
+			scope:  Scope{{Infobases: []string{"buh"}}},
+			code:   http.StatusForbidden,
+		},
+		{
+			name:   "List of cluster of infobases granted partially",
+			method: http.MethodGet,
+			path:   "/v1/cluster/1234-5678/session/list",
+			scope:  Scope{{Infobases: []string{"buh"}}},
+			code:   http.StatusOK,
+		},
+		{
+			name:   "Other cluster",
+			method: http.MethodGet,
+			path:   "/v1/cluster/8765-4321/session/list",
+			scope:  Scope{{Clusters: []string{"1234-5678"}}},
+			code:   http.StatusForbidden,
+		},
+		{
+			name:          "Error infobases",
+			method:        http.MethodPost,
+			path:          "/v1/cluster/1234-5678/infobase/ib-1/lock",
+			scope:         Scope{{Infobases: []string{"buh"}}},
+			ctrlMockError: errors.New("connection refused"),
+			code:          http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Warn", mock.AnythingOfType("string"), mock.Anything, mock.Anything, mock.Anything).Maybe()
+			logMock.On("Error", mock.Anything, mock.Anything).Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			ctrlMock.On("Infobases", mock.Anything, "1capp01:1545", entity.Cluster{ID: "1234-5678"}, cred, map[string]any{common.UseCache: true}).
+				Return([]entity.Infobase{{ID: "ib-1", Name: "\"buh\""}, {ID: "ib-2", Name: "\"zup\""}}, tc.ctrlMockError).
+				Maybe()
+
+			handler := gin.New()
+
+			h := handler.Group("/v1/cluster")
+			{
+				h.Use(func(c *gin.Context) {
+					c.Set(common.Entrypoint, "1capp01:1545")
+					c.Set(common.ClusterCred, cred)
+
+					if tc.scope != nil {
+						c.Set(common.Scope, tc.scope)
+					}
+				})
+				h.Use(UseScope(logMock, ctrlMock))
+
+				ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+
+				h.POST("/:cluster/infobase/:infobase/lock", ok)
+				h.DELETE("/:cluster/session", ok)
+				h.GET("/:cluster/session/list", ok)
+			}
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.path, nil)
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
 	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
 	"github.com/antonmisa/1cctl/internal/usecase/common"
 	"github.com/antonmisa/1cctl/pkg/logger"
 )
@@ -28,7 +29,23 @@ func UseClusterCredentials(l logger.Interface) gin.HandlerFunc {
 			return
 		}
 
-		c.Set(common.ClusterCred, entity.Credentials{Name: clusterCred.Login, Pwd: clusterCred.Password})
+		cred := entity.Credentials{Name: clusterCred.Login, Pwd: clusterCred.Password}
+
+		if cred.Name == "" {
+			if v, ok := c.Get(common.Vault); ok {
+				if vault, ok := v.(usecase.CtrlVault); ok {
+					cred, _ = vault.ClusterCredentials(c.Request.Context(), c.GetString(common.Entrypoint), c.Param("cluster"))
+				}
+			}
+		}
+
+		if cred.Name == "" {
+			if def, ok := c.Get(common.DefaultClusterCred); ok {
+				cred, _ = def.(entity.Credentials)
+			}
+		}
+
+		c.Set(common.ClusterCred, cred)
 
 		c.Next()
 	}
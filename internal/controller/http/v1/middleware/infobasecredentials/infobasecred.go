@@ -0,0 +1,45 @@
+package infobasecredentials
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+	"github.com/antonmisa/1cctl/pkg/logger"
+)
+
+type infobaseCred struct {
+	Login    string `header:"ib-login"`
+	Password string `header:"ib-password"`
+}
+
+func UseInfobaseCredentials(l logger.Interface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		infobaseCred := infobaseCred{}
+
+		if err := c.ShouldBindHeader(&infobaseCred); err != nil {
+			l.Error(err, "http - v1 - UseInfobaseCredentials")
+			e.ErrorResponse(c, http.StatusBadRequest, "bad request")
+
+			return
+		}
+
+		cred := entity.Credentials{Name: infobaseCred.Login, Pwd: infobaseCred.Password}
+
+		if cred.Name == "" {
+			if v, ok := c.Get(common.Vault); ok {
+				if vault, ok := v.(usecase.CtrlVault); ok {
+					cred, _ = vault.InfobaseCredentials(c.Request.Context(), c.GetString(common.Entrypoint), c.Param("cluster"), c.Param("infobase"))
+				}
+			}
+		}
+
+		c.Set(common.InfobaseCred, cred)
+
+		c.Next()
+	}
+}
@@ -0,0 +1,84 @@
+package rasentrypoint
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+	"github.com/antonmisa/1cctl/internal/usecase/registry"
+	"github.com/antonmisa/1cctl/pkg/logger"
+)
+
+type rasRequestQuery struct {
+	Cache bool `form:"usecache"`
+}
+
+type rasRequestPath struct {
+	Name string `uri:"ras" binding:"required"`
+}
+
+// UseRASEntrypoint - resolves entrypoint by its name in path,
+// default cluster credentials of entrypoint are used when request has none.
+func UseRASEntrypoint(l logger.Interface, reg usecase.CtrlRegistry) gin.HandlerFunc {
+	return func(c *gin.Context) {
+
+		rasRequestQuery := rasRequestQuery{}
+		rasRequestPath := rasRequestPath{}
+
+		if err := c.ShouldBindQuery(&rasRequestQuery); err != nil {
+			l.Error(err, "http - v1 - UseRASEntrypoint")
+			e.ErrorResponse(c, http.StatusBadRequest, "bad request")
+
+			return
+		}
+
+		if err := c.ShouldBindUri(&rasRequestPath); err != nil {
+			l.Error(err, "http - v1 - UseRASEntrypoint")
+			e.ErrorResponse(c, http.StatusBadRequest, "bad request")
+
+			return
+		}
+
+		ep, err := reg.Entrypoint(c.Request.Context(), rasRequestPath.Name)
+		if err != nil {
+			l.Error(err, "http - v1 - UseRASEntrypoint")
+
+			if errors.Is(err, registry.ErrEntrypointNotFound) {
+				e.ErrorResponse(c, http.StatusNotFound, "entrypoint not found")
+
+				return
+			}
+
+			e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+			return
+		}
+
+		c.Set(common.UseCache, rasRequestQuery.Cache)
+		c.Set(common.Entrypoint, ep.Address)
+		c.Set(common.EntrypointName, ep.Name)
+		c.Set(common.DefaultClusterCred, ep.ClusterCred)
+
+		c.Next()
+	}
+}
+
+// UseAllowlist - rejects entrypoint query parameter unknown to registry.
+func UseAllowlist(l logger.Interface, reg usecase.CtrlRegistry) gin.HandlerFunc {
+	return func(c *gin.Context) {
+
+		entrypoint, ok := c.GetQuery("entrypoint")
+		if ok && !reg.Allowed(c.Request.Context(), entrypoint) {
+			l.Warn("http - v1 - UseAllowlist: entrypoint %s is not allowed", entrypoint)
+			e.ErrorResponse(c, http.StatusForbidden, "entrypoint is not allowed")
+
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/codes"
+
+	v1e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+)
+
+type processResponse struct {
+	Processes []entity.Process `json:"processes"`
+}
+
+// @Summary     Show all working processes in cluster
+// @Description Show all working processes with load statistics for current cluster
+// @ID          processes
+// @Tags  	    process list
+// @Produce     json
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param		cache	    query	 bool			false	"Firstly try to find from Cache"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Success     200 {object} processResponse
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/process/list [get]
+func (r *ctrlRoutes) processes(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "processes")
+	defer span.End()
+
+	var request requestWoInfobase
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - processes")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	args := map[string]any{
+		common.UseCache:    c.MustGet(common.UseCache),
+		common.ClusterCred: c.MustGet(common.ClusterCred),
+	}
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("get list of processes")
+
+	processes, err := r.c.Processes(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, args)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - processes")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, processResponse{processes})
+}
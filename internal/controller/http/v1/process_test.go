@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func TestProcessesRoute(t *testing.T) {
+	cases := []struct {
+		name           string
+		method         string
+		uri            string
+		ctrlMockResult []entity.Process
+		ctrlMockError  error
+		code           int
+		retVal         string
+	}{
+		{
+			name:   "Erorr wo entrypoint",
+			method: http.MethodGet,
+			uri:    "/v1/cluster/1234-5678/process/list",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"bad request\"}",
+		},
+		{
+			name:   "Success",
+			method: http.MethodGet,
+			uri:    "/v1/cluster/1234-5678/process/list?entrypoint=1capp01:1545",
+			ctrlMockResult: []entity.Process{
+				{
+					ID:          "123",
+					Host:        "1capp01",
+					Port:        "1560",
+					AvgCallTime: 0.25,
+				},
+			},
+			code:   http.StatusOK,
+			retVal: "{\"processes\":[{\"id\":\"123\",\"host\":\"1capp01\",\"port\":\"1560\",\"pid\":\"\",\"enabled\":\"\",\"running\":\"\",\"started\":\"0001-01-01T00:00:00Z\",\"use\":\"\",\"avperf\":0,\"capacity\":0,\"conns\":0,\"memsize\":0,\"memexc\":0,\"selsize\":0,\"avgback\":0,\"avgcall\":0.25,\"avgdb\":0,\"avglock\":0,\"avgsrv\":0,\"avgthreads\":0,\"reserve\":\"\"}]}",
+		},
+		{
+			name:          "Error entrypoint incorrect",
+			method:        http.MethodGet,
+			uri:           "/v1/cluster/1234-5678/process/list?entrypoint=unknown:1545",
+			ctrlMockError: errors.New("error entrypoint incorrect"),
+			code:          http.StatusInternalServerError,
+			retVal:        "{\"error\":\"internal problems\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			ctrlMock.On("Processes",
+				mock.MatchedBy(func(ctx context.Context) bool { return true }),
+				"1capp01:1545",
+				entity.Cluster{ID: "1234-5678"},
+				mock.Anything,
+				mock.Anything).
+				Return(tc.ctrlMockResult, tc.ctrlMockError).
+				Maybe()
+
+			ctrlMock.On("Processes",
+				mock.MatchedBy(func(ctx context.Context) bool { return true }),
+				"unknown:1545",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Return(tc.ctrlMockResult, tc.ctrlMockError).
+				Maybe()
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, nil)
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,314 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/codes"
+
+	v1e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+)
+
+type profileResponse struct {
+	Profiles []entity.Profile `json:"profiles"`
+}
+
+type profileNameRequest struct {
+	Cluster string `uri:"cluster"  binding:"required"  example:"UUID"`
+	Name    string `uri:"name"     binding:"required"  example:"restricted"`
+}
+
+type profileRequest struct {
+	Desc                            string `json:"desc"                                 example:"comments"`
+	Config                          bool   `json:"config"                               example:"false"`
+	Priv                            bool   `json:"priv"                                 example:"false"`
+	Crypto                          bool   `json:"crypto"                               example:"true"`
+	RightExtension                  bool   `json:"right_extension"                      example:"false"`
+	RightExtensionDefinitionRoles   string `json:"right_extension_definition_roles"     example:""`
+	AllModulesExtension             bool   `json:"all_modules_extension"                example:"true"`
+	ModulesAvailableForExtension    string `json:"modules_available_for_extension"      example:""`
+	ModulesNotAvailableForExtension string `json:"modules_not_available_for_extension"  example:""`
+	PrivilegedModeRoles             string `json:"privileged_mode_roles"                example:""`
+}
+
+type profileCreateRequest struct {
+	Name string `json:"name"  binding:"required"  example:"restricted"`
+	profileRequest
+}
+
+func (p profileRequest) options(name string) entity.ProfileOptions {
+	return entity.ProfileOptions{
+		Name:                            name,
+		Desc:                            p.Desc,
+		Config:                          p.Config,
+		Priv:                            p.Priv,
+		Crypto:                          p.Crypto,
+		RightExtension:                  p.RightExtension,
+		RightExtensionDefinitionRoles:   p.RightExtensionDefinitionRoles,
+		AllModulesExtension:             p.AllModulesExtension,
+		ModulesAvailableForExtension:    p.ModulesAvailableForExtension,
+		ModulesNotAvailableForExtension: p.ModulesNotAvailableForExtension,
+		PrivilegedModeRoles:             p.PrivilegedModeRoles,
+	}
+}
+
+// @Summary     Show security profiles
+// @Description Show all security profiles of cluster
+// @ID          profiles
+// @Tags  	    profile
+// @Produce     json
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Success     200 {object} profileResponse
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/profile/list [get]
+func (r *ctrlRoutes) profiles(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "profiles")
+	defer span.End()
+
+	var request requestWoInfobase
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - profiles")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("get list of profiles")
+
+	profiles, err := r.c.Profiles(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - profiles")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, profileResponse{profiles})
+}
+
+// @Summary     Create security profile
+// @Description Add security profile to cluster
+// @ID          createProfile
+// @Tags  	    profile
+// @Accept      json
+// @Produce     json
+// @Param		cluster	    path	 string					true	"UUID of cluster"
+// @Param       entrypoint  query    string         		true 	"Entrypoint for cluster"
+// @Param       request     body     profileCreateRequest 	true 	"Name and settings of profile"
+// @Success     201 {object} entity.Profile
+// @Failure     400 {object} error.response
+// @Failure     409 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/profile [post]
+func (r *ctrlRoutes) createProfile(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "createProfile")
+	defer span.End()
+
+	var (
+		request requestWoInfobase
+		body    profileCreateRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - createProfile")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - createProfile")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("create profile")
+
+	profile, err := r.c.CreateProfile(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, body.options(body.Name))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - createProfile")
+
+		if errors.Is(err, usecase.ErrProfileExists) {
+			v1e.ErrorResponse(c, http.StatusConflict, "profile already exists")
+
+			return
+		}
+
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusCreated, profile)
+}
+
+// @Summary     Update security profile
+// @Description Change settings of security profile, all settings are written
+// @ID          updateProfile
+// @Tags  	    profile
+// @Accept      json
+// @Produce     json
+// @Param		cluster	    path	 string				true	"UUID of cluster"
+// @Param		name	    path	 string				true	"Name of profile"
+// @Param       entrypoint  query    string         	true 	"Entrypoint for cluster"
+// @Param       request     body     profileRequest 	true 	"Settings of profile"
+// @Success     200 {object} entity.Profile
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/profile/:name [put]
+func (r *ctrlRoutes) updateProfile(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "updateProfile")
+	defer span.End()
+
+	var (
+		request profileNameRequest
+		body    profileRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - updateProfile")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - updateProfile")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("update profile")
+
+	profile, err := r.c.UpdateProfile(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, body.options(request.Name))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - updateProfile")
+
+		if errors.Is(err, usecase.ErrProfileNotFound) {
+			v1e.ErrorResponse(c, http.StatusNotFound, "profile not found")
+
+			return
+		}
+
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, profile)
+}
+
+// @Summary     Remove security profile
+// @Description Delete security profile of cluster by name
+// @ID          removeProfile
+// @Tags  	    profile
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param		name	    path	 string			true	"Name of profile"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/profile/:name [delete]
+func (r *ctrlRoutes) removeProfile(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "removeProfile")
+	defer span.End()
+
+	var request profileNameRequest
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - removeProfile")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("remove profile")
+
+	err := r.c.RemoveProfile(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, request.Name)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - removeProfile")
+
+		if errors.Is(err, usecase.ErrProfileNotFound) {
+			v1e.ErrorResponse(c, http.StatusNotFound, "profile not found")
+
+			return
+		}
+
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,175 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func TestProfileAndLimitRoute(t *testing.T) {
+	cases := []struct {
+		name          string
+		method        string
+		uri           string
+		body          string
+		mockMethod    string
+		mockArg       any
+		mockResult    any
+		ctrlMockError error
+		code          int
+		retVal        string
+	}{
+		{
+			name:       "Success profile list",
+			method:     http.MethodGet,
+			uri:        "/v1/cluster/1234-5678/profile/list?entrypoint=1capp01:1545",
+			mockMethod: "Profiles",
+			mockResult: []entity.Profile{{Name: "restricted", Crypto: "yes"}},
+			code:       http.StatusOK,
+			retVal: "{\"profiles\":[{\"name\":\"restricted\",\"desc\":\"\",\"config\":\"\",\"priv\":\"\",\"crypto\":\"yes\",\"rightext\":\"\"," +
+				"\"rightroles\":\"\",\"allmodext\":\"\",\"modavail\":\"\",\"modnotavail\":\"\",\"privroles\":\"\"}]}",
+		},
+		{
+			name:   "Error create profile wo name",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1234-5678/profile?entrypoint=1capp01:1545",
+			body:   "{\"crypto\":true}",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request body\"}",
+		},
+		{
+			name:          "Error create profile exists",
+			method:        http.MethodPost,
+			uri:           "/v1/cluster/1234-5678/profile?entrypoint=1capp01:1545",
+			body:          "{\"name\":\"restricted\",\"crypto\":true}",
+			mockMethod:    "CreateProfile",
+			mockArg:       entity.ProfileOptions{Name: "restricted", Crypto: true},
+			mockResult:    entity.Profile{},
+			ctrlMockError: fmt.Errorf("restricted: %w", usecase.ErrProfileExists),
+			code:          http.StatusConflict,
+			retVal:        "{\"error\":\"profile already exists\"}",
+		},
+		{
+			name:       "Success update profile",
+			method:     http.MethodPut,
+			uri:        "/v1/cluster/1234-5678/profile/restricted?entrypoint=1capp01:1545",
+			body:       "{\"priv\":true}",
+			mockMethod: "UpdateProfile",
+			mockArg:    entity.ProfileOptions{Name: "restricted", Priv: true},
+			mockResult: entity.Profile{Name: "restricted", Priv: "yes"},
+			code:       http.StatusOK,
+			retVal: "{\"name\":\"restricted\",\"desc\":\"\",\"config\":\"\",\"priv\":\"yes\",\"crypto\":\"\",\"rightext\":\"\"," +
+				"\"rightroles\":\"\",\"allmodext\":\"\",\"modavail\":\"\",\"modnotavail\":\"\",\"privroles\":\"\"}",
+		},
+		{
+			name:          "Error remove profile not found",
+			method:        http.MethodDelete,
+			uri:           "/v1/cluster/1234-5678/profile/restricted?entrypoint=1capp01:1545",
+			mockMethod:    "RemoveProfile",
+			mockArg:       "restricted",
+			ctrlMockError: fmt.Errorf("restricted: %w", usecase.ErrProfileNotFound),
+			code:          http.StatusNotFound,
+			retVal:        "{\"error\":\"profile not found\"}",
+		},
+		{
+			name:   "Error create limit action",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1234-5678/limit?entrypoint=1capp01:1545",
+			body:   "{\"name\":\"heavy_calls\",\"counter\":\"calls\",\"action\":\"kill\"}",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request body\"}",
+		},
+		{
+			name:       "Success create limit",
+			method:     http.MethodPost,
+			uri:        "/v1/cluster/1234-5678/limit?entrypoint=1capp01:1545",
+			body:       "{\"name\":\"heavy_calls\",\"counter\":\"calls\",\"action\":\"interrupt-session\",\"duration\":60}",
+			mockMethod: "CreateLimit",
+			mockArg:    entity.LimitOptions{Name: "heavy_calls", Counter: "calls", Action: "interrupt-session", Duration: 60},
+			mockResult: entity.Limit{Name: "heavy_calls", Counter: "calls", Action: "interrupt-session", Duration: 60},
+			code:       http.StatusCreated,
+			retVal: "{\"name\":\"heavy_calls\",\"counter\":\"calls\",\"action\":\"interrupt-session\",\"duration\":60,\"cpu\":0,\"memory\":0," +
+				"\"read\":0,\"write\":0,\"durdbms\":0,\"dbmsbytes\":0,\"service\":0,\"call\":0,\"actses\":0,\"ses\":0,\"errmsg\":\"\",\"desc\":\"\"}",
+		},
+		{
+			name:       "Success remove limit",
+			method:     http.MethodDelete,
+			uri:        "/v1/cluster/1234-5678/limit/heavy_calls?entrypoint=1capp01:1545",
+			mockMethod: "RemoveLimit",
+			mockArg:    "heavy_calls",
+			code:       http.StatusNoContent,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			anyCtx := mock.MatchedBy(func(ctx context.Context) bool { return true })
+			cluster := entity.Cluster{ID: "1234-5678"}
+
+			switch tc.mockMethod {
+			case "Profiles":
+				ctrlMock.On(tc.mockMethod, anyCtx, "1capp01:1545", cluster, entity.Credentials{}).
+					Return(tc.mockResult, tc.ctrlMockError)
+			case "CreateProfile", "UpdateProfile", "CreateLimit":
+				ctrlMock.On(tc.mockMethod, anyCtx, "1capp01:1545", cluster, entity.Credentials{}, tc.mockArg).
+					Return(tc.mockResult, tc.ctrlMockError)
+			case "RemoveProfile", "RemoveLimit":
+				ctrlMock.On(tc.mockMethod, anyCtx, "1capp01:1545", cluster, entity.Credentials{}, tc.mockArg).
+					Return(tc.ctrlMockError)
+			}
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, strings.NewReader(tc.body))
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	v1e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/agentcredentials"
+	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/auth"
+	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/clustercredentials"
+	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/infobasecredentials"
+	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/rasentrypoint"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/pkg/logger"
+)
+
+type rasRoutes struct {
+	r usecase.CtrlRegistry
+	l logger.Interface
+	t trace.Tracer
+}
+
+func newRASRoutes(handler *gin.RouterGroup, reg usecase.CtrlRegistry, t usecase.Ctrl, l logger.Interface, tr trace.Tracer) {
+	r := &rasRoutes{reg, l, tr}
+	cr := &ctrlRoutes{t, l, tr}
+
+	handler.Use(rasentrypoint.UseAllowlist(l, reg))
+
+	handler.GET("/ras", r.entrypoints)
+
+	e := handler.Group("/ras/:ras")
+	{
+		e.Use(rasentrypoint.UseRASEntrypoint(l, reg))
+
+		h := e.Group("/cluster")
+		{
+			h.Use(clustercredentials.UseClusterCredentials(l))
+			h.Use(infobasecredentials.UseInfobaseCredentials(l))
+			h.Use(agentcredentials.UseAgentCredentials(l))
+			h.Use(auth.UseScope(l, t))
+
+			cr.clusterRoutes(h)
+		}
+
+		a := e.Group("/agent")
+		{
+			a.Use(agentcredentials.UseAgentCredentials(l))
+			a.Use(auth.UseScope(l, t))
+
+			cr.agentRoutes(a)
+		}
+	}
+}
+
+type rasResponse struct {
+	Entrypoints []entity.Entrypoint `json:"entrypoints"`
+}
+
+// @Summary     Show ras entrypoints
+// @Description Show named ras entrypoints of registry, every route of /cluster and /agent
+// @Description is available as /ras/{ras}/cluster and /ras/{ras}/agent without entrypoint parameter
+// @ID          entrypoints
+// @Tags  	    ras
+// @Produce     json
+// @Success     200 {object} rasResponse
+// @Failure     500 {object} error.response
+// @Router      /ras [get]
+func (r *rasRoutes) entrypoints(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "entrypoints")
+	defer span.End()
+
+	span.AddEvent("get entrypoints")
+
+	entrypoints, err := r.r.Entrypoints(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - entrypoints - r.r.Entrypoints")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	entrypoints = inScope(c, entrypoints, func(ep entity.Entrypoint) auth.Target {
+		return auth.Target{Entrypoint: ep.Address, EntrypointName: ep.Name}
+	})
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, rasResponse{entrypoints})
+}
@@ -0,0 +1,209 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	"github.com/antonmisa/1cctl/internal/usecase/registry"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func TestRASRoute(t *testing.T) {
+	main := entity.Entrypoint{
+		Name:        "main",
+		Address:     "1capp01:1545",
+		Tags:        []string{"prod"},
+		ClusterCred: entity.Credentials{Name: "admin", Pwd: "secret"},
+	}
+
+	cases := []struct {
+		name        string
+		method      string
+		uri         string
+		login       string
+		allowed     bool
+		mockMethod  string
+		mockAddress string
+		mockCred    entity.Credentials
+		code        int
+		retVal      string
+	}{
+		{
+			name:   "Success list entrypoints",
+			uri:    "/v1/ras",
+			code:   http.StatusOK,
+			retVal: "{\"entrypoints\":[{\"name\":\"main\",\"address\":\"1capp01:1545\",\"tags\":[\"prod\"]}]}",
+		},
+		{
+			name:        "Success clusters by name",
+			uri:         "/v1/ras/main/cluster/list",
+			mockMethod:  "Clusters",
+			mockAddress: "1capp01:1545",
+			code:        http.StatusOK,
+			retVal:      "{\"clusters\":[]}",
+		},
+		{
+			name:        "Success default cluster credentials",
+			uri:         "/v1/ras/main/cluster/1234-5678/infobase/list",
+			mockMethod:  "Infobases",
+			mockAddress: "1capp01:1545",
+			mockCred:    entity.Credentials{Name: "admin", Pwd: "secret"},
+			code:        http.StatusOK,
+			retVal:      "{\"infobases\":[]}",
+		},
+		{
+			name:        "Success cluster credentials of request",
+			uri:         "/v1/ras/main/cluster/1234-5678/infobase/list",
+			login:       "operator",
+			mockMethod:  "Infobases",
+			mockAddress: "1capp01:1545",
+			mockCred:    entity.Credentials{Name: "operator"},
+			code:        http.StatusOK,
+			retVal:      "{\"infobases\":[]}",
+		},
+		{
+			name:        "Success remove cluster admin",
+			method:      http.MethodDelete,
+			uri:         "/v1/ras/main/cluster/1234-5678/admin/operator",
+			mockMethod:  "RemoveClusterAdmin",
+			mockAddress: "1capp01:1545",
+			mockCred:    entity.Credentials{Name: "admin", Pwd: "secret"},
+			code:        http.StatusNoContent,
+		},
+		{
+			name:   "Error unknown name",
+			uri:    "/v1/ras/reserve/cluster/list",
+			code:   http.StatusNotFound,
+			retVal: "{\"error\":\"entrypoint not found\"}",
+		},
+		{
+			name:        "Success allowed entrypoint",
+			uri:         "/v1/cluster/list?entrypoint=1capp01:1545",
+			allowed:     true,
+			mockMethod:  "Clusters",
+			mockAddress: "1capp01:1545",
+			code:        http.StatusOK,
+			retVal:      "{\"clusters\":[]}",
+		},
+		{
+			name:   "Error entrypoint is not allowed",
+			uri:    "/v1/cluster/list?entrypoint=1capp02:1545",
+			code:   http.StatusForbidden,
+			retVal: "{\"error\":\"entrypoint is not allowed\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Warn",
+				mock.AnythingOfType("string"),
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			regMock := ucm.NewCtrlRegistry(t)
+
+			regMock.On("Entrypoints",
+				mock.MatchedBy(func(ctx context.Context) bool { return true })).
+				Return([]entity.Entrypoint{main}, nil).
+				Maybe()
+
+			regMock.On("Entrypoint",
+				mock.MatchedBy(func(ctx context.Context) bool { return true }),
+				"main").
+				Return(main, nil).
+				Maybe()
+
+			regMock.On("Entrypoint",
+				mock.MatchedBy(func(ctx context.Context) bool { return true }),
+				"reserve").
+				Return(entity.Entrypoint{}, fmt.Errorf("ctrlregistry - entrypoint: reserve: %w", registry.ErrEntrypointNotFound)).
+				Maybe()
+
+			regMock.On("Allowed",
+				mock.MatchedBy(func(ctx context.Context) bool { return true }),
+				mock.AnythingOfType("string")).
+				Return(tc.allowed).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			switch tc.mockMethod {
+			case "Clusters":
+				ctrlMock.On("Clusters",
+					mock.MatchedBy(func(ctx context.Context) bool { return true }),
+					tc.mockAddress,
+					mock.Anything).
+					Return([]entity.Cluster{}, nil)
+			case "Infobases":
+				ctrlMock.On("Infobases",
+					mock.MatchedBy(func(ctx context.Context) bool { return true }),
+					tc.mockAddress,
+					entity.Cluster{ID: "1234-5678"},
+					tc.mockCred,
+					mock.Anything).
+					Return([]entity.Infobase{}, nil)
+			case "RemoveClusterAdmin":
+				ctrlMock.On("RemoveClusterAdmin",
+					mock.MatchedBy(func(ctx context.Context) bool { return true }),
+					tc.mockAddress,
+					entity.Cluster{ID: "1234-5678"},
+					tc.mockCred,
+					"operator").
+					Return(nil)
+			}
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer, Registry(regMock))
+
+			w := httptest.NewRecorder()
+			method := tc.method
+			if method == "" {
+				method = http.MethodGet
+			}
+
+			req, _ := http.NewRequest(method, tc.uri, nil)
+
+			if tc.login != "" {
+				req.Header.Set("login", tc.login)
+			}
+
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
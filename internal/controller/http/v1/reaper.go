@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	v1e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/pkg/logger"
+)
+
+type reaperRoutes struct {
+	r usecase.CtrlReaper
+	l logger.Interface
+	t trace.Tracer
+}
+
+func newReaperRoutes(handler *gin.RouterGroup, rp usecase.CtrlReaper, l logger.Interface, tr trace.Tracer) {
+	r := &reaperRoutes{rp, l, tr}
+
+	h := handler.Group("/reaper")
+	{
+		h.GET("/audit", r.audit)
+	}
+}
+
+type reaperAuditResponse struct {
+	Records []entity.ReapRecord `json:"records"`
+}
+
+// @Summary     Show audit trail of reaper
+// @Description Show the latest sessions terminated by reaper policies and why, newest first;
+// @Description in dry-run mode sessions are only found
+// @ID          reaperAudit
+// @Tags  	    session
+// @Produce     json
+// @Success     200 {object} reaperAuditResponse
+// @Failure     500 {object} error.response
+// @Router      /reaper/audit [get]
+func (r *reaperRoutes) audit(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "reaperAudit")
+	defer span.End()
+
+	span.AddEvent("get audit trail")
+
+	records, err := r.r.Audit(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - audit - r.r.Audit")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, reaperAuditResponse{records})
+}
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"go.opentelemetry.io/otel"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReaperAuditRoute(t *testing.T) {
+	records := []entity.ReapRecord{
+		{
+			Time:       time.Date(2023, 8, 10, 14, 0, 0, 0, time.UTC),
+			Policy:     "buh-idle",
+			Entrypoint: "1capp01:1545",
+			ClusterID:  "1234-5678",
+			Infobase:   "buh",
+			SessionID:  "8765-4321",
+			SID:        12,
+			UserName:   "ivanov",
+			AppID:      "1cv8c",
+			Host:       "pc-101",
+			LastActive: time.Date(2023, 8, 10, 11, 0, 0, 0, time.UTC),
+			Reason:     "idle for 3h0m0s, rule 1: idle 2h0m0s",
+		},
+	}
+
+	cases := []struct {
+		name            string
+		method          string
+		uri             string
+		reaperMockError error
+		code            int
+		retVal          string
+	}{
+		{
+			name:   "Success",
+			method: http.MethodGet,
+			uri:    "/v1/reaper/audit",
+			code:   http.StatusOK,
+			retVal: "{\"records\":[{\"time\":\"2023-08-10T14:00:00Z\",\"policy\":\"buh-idle\",\"ep\":\"1capp01:1545\",\"cluster\":\"1234-5678\",\"ib\":\"buh\",\"session\":\"8765-4321\",\"sid\":12,\"uname\":\"ivanov\",\"appid\":\"1cv8c\",\"host\":\"pc-101\",\"active\":\"2023-08-10T11:00:00Z\",\"reason\":\"idle for 3h0m0s, rule 1: idle 2h0m0s\",\"dry_run\":false,\"error\":\"\"}]}",
+		},
+		{
+			name:            "Error internal",
+			method:          http.MethodGet,
+			uri:             "/v1/reaper/audit",
+			reaperMockError: errors.New("error internal"),
+			code:            500,
+			retVal:          "{\"error\":\"internal problems\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			reaperMock := ucm.NewCtrlReaper(t)
+
+			reaperMock.On("Audit",
+				mock.MatchedBy(func(ctx context.Context) bool { return true })).
+				Return(records, tc.reaperMockError)
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ucm.NewCtrl(t), tracer, Reaper(reaperMock))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, nil)
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
@@ -14,12 +14,72 @@ import (
 
 	// Swagger docs.
 	_ "github.com/antonmisa/1cctl/docs"
-	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/commonqueryparams"
+	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/auth"
 	mwlogger "github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/logger"
 	"github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
 	"github.com/antonmisa/1cctl/pkg/logger"
 )
 
+// Option - registering routes of optional service in /v1 group.
+type Option func(h *gin.RouterGroup, t usecase.Ctrl, l logger.Interface, tr trace.Tracer)
+
+// Schedules - routes of backup scheduler.
+func Schedules(s usecase.CtrlScheduler) Option {
+	return func(h *gin.RouterGroup, t usecase.Ctrl, l logger.Interface, tr trace.Tracer) {
+		newScheduleRoutes(h, s, l, tr)
+	}
+}
+
+// Watch - stream of session changes.
+func Watch(w usecase.CtrlWatcher) Option {
+	return func(h *gin.RouterGroup, t usecase.Ctrl, l logger.Interface, tr trace.Tracer) {
+		newWatchRoutes(h, w, t, l, tr)
+	}
+}
+
+// Reaper - audit trail of idle sessions reaper.
+func Reaper(r usecase.CtrlReaper) Option {
+	return func(h *gin.RouterGroup, t usecase.Ctrl, l logger.Interface, tr trace.Tracer) {
+		newReaperRoutes(h, r, l, tr)
+	}
+}
+
+// Auth - api keys and bearer tokens with roles are required for every route of /v1.
+// Must go first to have all routes protected.
+func Auth(a *auth.Authenticator) Option {
+	return func(h *gin.RouterGroup, t usecase.Ctrl, l logger.Interface, tr trace.Tracer) {
+		h.Use(auth.UseAuth(l, a))
+	}
+}
+
+// Registry - named ras entrypoints under /ras, in allowlist mode requests to unknown entrypoints are rejected.
+// Must go first to have allowlist applied to routes of other options.
+func Registry(reg usecase.CtrlRegistry) Option {
+	return func(h *gin.RouterGroup, t usecase.Ctrl, l logger.Interface, tr trace.Tracer) {
+		newRASRoutes(h, reg, t, l, tr)
+	}
+}
+
+// Fleet - aggregated views of all ras entrypoints in registry.
+func Fleet(f usecase.CtrlFleet) Option {
+	return func(h *gin.RouterGroup, t usecase.Ctrl, l logger.Interface, tr trace.Tracer) {
+		newFleetRoutes(h, f, l, tr)
+	}
+}
+
+// Vault - stored credentials are used when request has none, admin routes of /vault rotate them.
+// Must go before options with routes using credentials.
+func Vault(v usecase.CtrlVault) Option {
+	return func(h *gin.RouterGroup, t usecase.Ctrl, l logger.Interface, tr trace.Tracer) {
+		h.Use(func(c *gin.Context) {
+			c.Set(common.Vault, v)
+		})
+
+		newVaultRoutes(h, v, l, tr)
+	}
+}
+
 // NewRouter -.
 // Swagger spec:
 // @title       1C cluster control service
@@ -27,7 +87,7 @@ import (
 // @version     1.0
 // @host        localhost:8080
 // @BasePath    /v1
-func NewRouter(handler *gin.Engine, l logger.Interface, t usecase.Ctrl, tr trace.Tracer) {
+func NewRouter(handler *gin.Engine, l logger.Interface, t usecase.Ctrl, tr trace.Tracer, opts ...Option) {
 	// Options
 	handler.Use(mwlogger.Logger(l))
 	handler.Use(gin.Recovery())
@@ -49,7 +109,9 @@ func NewRouter(handler *gin.Engine, l logger.Interface, t usecase.Ctrl, tr trace
 	// Routers
 	h := handler.Group("/v1")
 	{
-		h.Use(commonqueryparams.UseCommonQueryParams(l))
+		for _, opt := range opts {
+			opt(h, t, l, tr)
+		}
 
 		newCtrlRoutes(h, t, l, tr)
 	}
@@ -0,0 +1,368 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/codes"
+
+	v1e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+)
+
+type ruleResponse struct {
+	Rules []entity.Rule `json:"rules"`
+}
+
+type serverRequest struct {
+	Cluster string `uri:"cluster"  binding:"required"  example:"UUID"`
+	Server  string `uri:"server"   binding:"required"  example:"UUID"`
+}
+
+type ruleIDRequest struct {
+	serverRequest
+	Rule string `uri:"rule"  binding:"required"  example:"UUID"`
+}
+
+type ruleRequest struct {
+	Position       int    `json:"position"         binding:"min=0"                               example:"0"`
+	ObjectType     string `json:"object_type"                                                    example:""`
+	InfobaseName   string `json:"infobase_name"                                                  example:"zup"`
+	RuleType       string `json:"rule_type"        binding:"required,oneof=auto always never"    example:"always"`
+	ApplicationExt string `json:"application_ext"                                                example:""`
+	Priority       int    `json:"priority"         binding:"min=0"                               example:"0"`
+}
+
+type ruleApplyQuery struct {
+	Full bool `form:"full"  example:"false"`
+}
+
+func (r ruleRequest) options() entity.RuleOptions {
+	return entity.RuleOptions{
+		Position:       r.Position,
+		ObjectType:     r.ObjectType,
+		InfobaseName:   r.InfobaseName,
+		RuleType:       r.RuleType,
+		ApplicationExt: r.ApplicationExt,
+		Priority:       r.Priority,
+	}
+}
+
+// @Summary     Show assignment rules of working server
+// @Description Show all assignment (requirement) rules of working server in order of position
+// @ID          rules
+// @Tags  	    rule
+// @Produce     json
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param		server	    path	 string			true	"UUID of working server"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Success     200 {object} ruleResponse
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/server/:server/rule/list [get]
+func (r *ctrlRoutes) rules(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "rules")
+	defer span.End()
+
+	var request serverRequest
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - rules")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("get list of rules")
+
+	rules, err := r.c.Rules(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, entity.Server{ID: request.Server})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - rules")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, ruleResponse{rules})
+}
+
+// @Summary     Insert assignment rule
+// @Description Add assignment rule to working server at position, rules below it are shifted
+// @ID          insertRule
+// @Tags  	    rule
+// @Accept      json
+// @Produce     json
+// @Param		cluster	    path	 string				true	"UUID of cluster"
+// @Param		server	    path	 string				true	"UUID of working server"
+// @Param       entrypoint  query    string         	true 	"Entrypoint for cluster"
+// @Param       request     body     ruleRequest 		true 	"Settings of rule"
+// @Success     201 {object} entity.Rule
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/server/:server/rule [post]
+func (r *ctrlRoutes) insertRule(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "insertRule")
+	defer span.End()
+
+	var (
+		request serverRequest
+		body    ruleRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - insertRule")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - insertRule")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("insert rule")
+
+	rule, err := r.c.InsertRule(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, entity.Server{ID: request.Server}, body.options())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - insertRule")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusCreated, rule)
+}
+
+// @Summary     Update assignment rule
+// @Description Change assignment rule of working server, all settings are written
+// @ID          updateRule
+// @Tags  	    rule
+// @Accept      json
+// @Produce     json
+// @Param		cluster	    path	 string				true	"UUID of cluster"
+// @Param		server	    path	 string				true	"UUID of working server"
+// @Param		rule	    path	 string				true	"UUID of rule"
+// @Param       entrypoint  query    string         	true 	"Entrypoint for cluster"
+// @Param       request     body     ruleRequest 		true 	"Settings of rule"
+// @Success     200 {object} entity.Rule
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/server/:server/rule/:rule [put]
+func (r *ctrlRoutes) updateRule(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "updateRule")
+	defer span.End()
+
+	var (
+		request ruleIDRequest
+		body    ruleRequest
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - updateRule")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - updateRule")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("update rule")
+
+	rule, err := r.c.UpdateRule(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, entity.Server{ID: request.Server}, request.Rule, body.options())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - updateRule")
+
+		if errors.Is(err, usecase.ErrRuleNotFound) {
+			v1e.ErrorResponse(c, http.StatusNotFound, "rule not found")
+
+			return
+		}
+
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, rule)
+}
+
+// @Summary     Remove assignment rule
+// @Description Delete assignment rule of working server
+// @ID          removeRule
+// @Tags  	    rule
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param		server	    path	 string			true	"UUID of working server"
+// @Param		rule	    path	 string			true	"UUID of rule"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/server/:server/rule/:rule [delete]
+func (r *ctrlRoutes) removeRule(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "removeRule")
+	defer span.End()
+
+	var request ruleIDRequest
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - removeRule")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("remove rule")
+
+	err := r.c.RemoveRule(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, entity.Server{ID: request.Server}, request.Rule)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - removeRule")
+
+		if errors.Is(err, usecase.ErrRuleNotFound) {
+			v1e.ErrorResponse(c, http.StatusNotFound, "rule not found")
+
+			return
+		}
+
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary     Apply assignment rules
+// @Description Apply assignment rules of all working servers of cluster, rac applies rules of the whole cluster at once;
+// @Description full apply moves already running infobases, partial one affects only new connections
+// @ID          applyRules
+// @Tags  	    rule
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Param       full  		query    bool         	false 	"Full apply"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/rule/apply [post]
+func (r *ctrlRoutes) applyRules(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "applyRules")
+	defer span.End()
+
+	var (
+		request requestWoInfobase
+		query   ruleApplyQuery
+	)
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - applyRules")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - applyRules")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("apply rules")
+
+	err := r.c.ApplyRules(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, query.Full)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - applyRules")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
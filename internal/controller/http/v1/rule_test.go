@@ -0,0 +1,153 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func TestRuleRoute(t *testing.T) {
+	cases := []struct {
+		name          string
+		method        string
+		uri           string
+		body          string
+		mockMethod    string
+		ctrlMockError error
+		code          int
+		retVal        string
+	}{
+		{
+			name:       "Success list",
+			method:     http.MethodGet,
+			uri:        "/v1/cluster/1234-5678/server/8765-4321/rule/list?entrypoint=1capp01:1545",
+			mockMethod: "Rules",
+			code:       http.StatusOK,
+			retVal: "{\"rules\":[{\"id\":\"1111-2222\",\"objtype\":\"\",\"ibname\":\"zup\",\"ruletype\":\"always\"," +
+				"\"appext\":\"\",\"priority\":0}]}",
+		},
+		{
+			name:       "Success insert",
+			method:     http.MethodPost,
+			uri:        "/v1/cluster/1234-5678/server/8765-4321/rule?entrypoint=1capp01:1545",
+			body:       "{\"infobase_name\":\"zup\",\"rule_type\":\"always\"}",
+			mockMethod: "InsertRule",
+			code:       http.StatusCreated,
+			retVal: "{\"id\":\"1111-2222\",\"objtype\":\"\",\"ibname\":\"zup\",\"ruletype\":\"always\"," +
+				"\"appext\":\"\",\"priority\":0}",
+		},
+		{
+			name:   "Error insert wrong rule type",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1234-5678/server/8765-4321/rule?entrypoint=1capp01:1545",
+			body:   "{\"infobase_name\":\"zup\",\"rule_type\":\"sometimes\"}",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request body\"}",
+		},
+		{
+			name:          "Error update not found",
+			method:        http.MethodPut,
+			uri:           "/v1/cluster/1234-5678/server/8765-4321/rule/1111-2222?entrypoint=1capp01:1545",
+			body:          "{\"infobase_name\":\"zup\",\"rule_type\":\"always\"}",
+			mockMethod:    "UpdateRule",
+			ctrlMockError: fmt.Errorf("CtrlUseCase - UpdateRule: %w", usecase.ErrRuleNotFound),
+			code:          http.StatusNotFound,
+			retVal:        "{\"error\":\"rule not found\"}",
+		},
+		{
+			name:       "Success remove",
+			method:     http.MethodDelete,
+			uri:        "/v1/cluster/1234-5678/server/8765-4321/rule/1111-2222?entrypoint=1capp01:1545",
+			mockMethod: "RemoveRule",
+			code:       http.StatusNoContent,
+		},
+		{
+			name:       "Success apply full",
+			method:     http.MethodPost,
+			uri:        "/v1/cluster/1234-5678/rule/apply?entrypoint=1capp01:1545&full=true",
+			mockMethod: "ApplyRules",
+			code:       http.StatusNoContent,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			anyCtx := mock.MatchedBy(func(ctx context.Context) bool { return true })
+			cluster := entity.Cluster{ID: "1234-5678"}
+			server := entity.Server{ID: "8765-4321"}
+			rule := entity.Rule{ID: "1111-2222", InfobaseName: "zup", RuleType: "always"}
+			opts := entity.RuleOptions{InfobaseName: "zup", RuleType: "always"}
+
+			switch tc.mockMethod {
+			case "Rules":
+				ctrlMock.On("Rules", anyCtx, "1capp01:1545", cluster, entity.Credentials{}, server).
+					Return([]entity.Rule{rule}, tc.ctrlMockError)
+			case "InsertRule":
+				ctrlMock.On("InsertRule", anyCtx, "1capp01:1545", cluster, entity.Credentials{}, server, opts).
+					Return(rule, tc.ctrlMockError)
+			case "UpdateRule":
+				ctrlMock.On("UpdateRule", anyCtx, "1capp01:1545", cluster, entity.Credentials{}, server, "1111-2222", opts).
+					Return(entity.Rule{}, tc.ctrlMockError)
+			case "RemoveRule":
+				ctrlMock.On("RemoveRule", anyCtx, "1capp01:1545", cluster, entity.Credentials{}, server, "1111-2222").
+					Return(tc.ctrlMockError)
+			case "ApplyRules":
+				ctrlMock.On("ApplyRules", anyCtx, "1capp01:1545", cluster, entity.Credentials{}, true).
+					Return(tc.ctrlMockError)
+			}
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, strings.NewReader(tc.body))
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	v1e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/pkg/logger"
+)
+
+type scheduleRoutes struct {
+	s usecase.CtrlScheduler
+	l logger.Interface
+	t trace.Tracer
+}
+
+func newScheduleRoutes(handler *gin.RouterGroup, s usecase.CtrlScheduler, l logger.Interface, tr trace.Tracer) {
+	r := &scheduleRoutes{s, l, tr}
+
+	h := handler.Group("/schedules")
+	{
+		h.GET("", r.schedules)
+	}
+}
+
+type schedulesResponse struct {
+	Schedules []entity.Schedule `json:"schedules"`
+}
+
+// @Summary     Show schedules
+// @Description Show scheduled backups with time of next run and outcome of last one
+// @ID          schedules
+// @Tags  	    infobase backup
+// @Produce     json
+// @Success     200 {object} schedulesResponse
+// @Failure     500 {object} error.response
+// @Router      /schedules [get]
+func (r *scheduleRoutes) schedules(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "schedules")
+	defer span.End()
+
+	span.AddEvent("get list of schedules")
+
+	schedules, err := r.s.Schedules(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - schedules - r.s.Schedules")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, schedulesResponse{schedules})
+}
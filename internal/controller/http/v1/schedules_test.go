@@ -0,0 +1,111 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"go.opentelemetry.io/otel"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedulesRoute(t *testing.T) {
+	schedules := []entity.Schedule{
+		{
+			Name:        "buh",
+			Cron:        "0 2 * * *",
+			Entrypoint:  "1capp01:1545",
+			ClusterID:   "1234-5678",
+			InfobaseID:  "8765-4321",
+			Credentials: "buh",
+			Safe:        true,
+			Next:        time.Date(2023, 8, 11, 2, 0, 0, 0, time.UTC),
+			ScheduleState: entity.ScheduleState{
+				LastRun:    time.Date(2023, 8, 10, 2, 0, 0, 0, time.UTC),
+				LastJob:    "1",
+				LastStatus: entity.JobSucceeded,
+			},
+		},
+	}
+
+	cases := []struct {
+		name           string
+		method         string
+		uri            string
+		schedMockError error
+		code           int
+		retVal         string
+	}{
+		{
+			name:   "Success",
+			method: http.MethodGet,
+			uri:    "/v1/schedules",
+			code:   http.StatusOK,
+			retVal: "{\"schedules\":[{\"name\":\"buh\",\"cron\":\"0 2 * * *\",\"ep\":\"1capp01:1545\",\"cluster\":\"1234-5678\",\"ib\":\"8765-4321\",\"credentials\":\"buh\",\"safe\":true,\"next\":\"2023-08-11T02:00:00Z\",\"last_run\":\"2023-08-10T02:00:00Z\",\"last_job\":\"1\",\"last_status\":\"succeeded\",\"last_error\":\"\"}]}",
+		},
+		{
+			name:           "Error internal",
+			method:         http.MethodGet,
+			uri:            "/v1/schedules",
+			schedMockError: errors.New("error internal"),
+			code:           500,
+			retVal:         "{\"error\":\"internal problems\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			schedMock := ucm.NewCtrlScheduler(t)
+
+			schedMock.On("Schedules",
+				mock.MatchedBy(func(ctx context.Context) bool { return true })).
+				Return(schedules, tc.schedMockError)
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ucm.NewCtrl(t), tracer, Schedules(schedMock))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, nil)
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
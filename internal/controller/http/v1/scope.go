@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/auth"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+)
+
+// inScope - items of list the principal of request is granted, all of them for principal which is not scoped.
+func inScope[T any](c *gin.Context, items []T, target func(T) auth.Target) []T {
+	scope := auth.ScopeOf(c)
+	if scope == nil {
+		return items
+	}
+
+	result := make([]T, 0, len(items))
+
+	for _, item := range items {
+		if scope.Allows(target(item), true) {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// onlyGranted - items of granted infobases (by id), all of them when granted is nil.
+func onlyGranted[T any](items []T, granted map[string]struct{}, infobaseID func(T) string) []T {
+	if granted == nil {
+		return items
+	}
+
+	result := make([]T, 0, len(items))
+
+	for _, item := range items {
+		if _, ok := granted[infobaseID(item)]; ok {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// requestTarget - entrypoint of request with cluster and infobase (name).
+func requestTarget(c *gin.Context, cluster, infobase string) auth.Target {
+	return auth.Target{
+		Entrypoint:     c.GetString(common.Entrypoint),
+		EntrypointName: c.GetString(common.EntrypointName),
+		Cluster:        cluster,
+		Infobase:       infobase,
+	}
+}
+
+// grantedInfobases - ids of infobases of cluster the principal of request is granted, nil for principal which is not scoped.
+func (r *ctrlRoutes) grantedInfobases(ctx context.Context, c *gin.Context, cluster entity.Cluster, clusterCred entity.Credentials) (map[string]struct{}, error) {
+	if auth.ScopeOf(c) == nil {
+		return nil, nil
+	}
+
+	infobases, err := r.c.Infobases(ctx, c.GetString(common.Entrypoint), cluster, clusterCred, map[string]any{common.UseCache: true})
+	if err != nil {
+		return nil, fmt.Errorf("r.c.Infobases: %w", err)
+	}
+
+	infobases = inScope(c, infobases, func(ib entity.Infobase) auth.Target {
+		return requestTarget(c, cluster.ID, ib.Name)
+	})
+
+	granted := make(map[string]struct{}, len(infobases))
+	for _, ib := range infobases {
+		granted[ib.ID] = struct{}{}
+	}
+
+	return granted, nil
+}
@@ -0,0 +1,176 @@
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/auth"
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func TestScopeRoute(t *testing.T) {
+	cluster := entity.Cluster{ID: "1234-5678"}
+
+	cases := []struct {
+		name   string
+		method string
+		uri    string
+		code   int
+		retVal string
+	}{
+		{
+			name:   "Clusters of granted entrypoint",
+			method: http.MethodGet,
+			uri:    "/v1/cluster/list?entrypoint=1capp01:1545",
+			code:   http.StatusOK,
+			retVal: "{\"clusters\":[{\"id\":\"1234-5678\"",
+		},
+		{
+			name:   "Clusters of other entrypoint",
+			method: http.MethodGet,
+			uri:    "/v1/cluster/list?entrypoint=1capp02:1545",
+			code:   http.StatusForbidden,
+			retVal: "{\"error\":\"forbidden\"}",
+		},
+		{
+			name:   "Only granted infobases",
+			method: http.MethodGet,
+			uri:    "/v1/cluster/1234-5678/infobase/list?entrypoint=1capp01:1545",
+			code:   http.StatusOK,
+			retVal: "{\"infobases\":[{\"id\":\"ib-1\",\"name\":\"\\\"buh\\\"\",\"desc\":\"\"}]}",
+		},
+		{
+			name:   "Only sessions of granted infobases",
+			method: http.MethodGet,
+			uri:    "/v1/cluster/1234-5678/session/list?entrypoint=1capp01:1545",
+			code:   http.StatusOK,
+			retVal: "{\"sessions\":[{\"id\":\"s-1\"",
+		},
+		{
+			name:   "Lock of other infobase",
+			method: http.MethodPost,
+			uri:    "/v1/cluster/1234-5678/infobase/ib-2/lock?entrypoint=1capp01:1545",
+			code:   http.StatusForbidden,
+			retVal: "{\"error\":\"forbidden\"}",
+		},
+		{
+			name:   "Backups",
+			method: http.MethodGet,
+			uri:    "/v1/backups",
+			code:   http.StatusForbidden,
+			retVal: "{\"error\":\"forbidden\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Warn",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			ctrlMock.On("Clusters", mock.Anything, mock.AnythingOfType("string"), mock.Anything).
+				Return([]entity.Cluster{cluster}, nil).
+				Maybe()
+
+			ctrlMock.On("Infobases", mock.Anything, "1capp01:1545", cluster, mock.Anything, mock.Anything).
+				Return([]entity.Infobase{{ID: "ib-1", Name: "\"buh\""}, {ID: "ib-2", Name: "\"zup\""}}, nil).
+				Maybe()
+
+			ctrlMock.On("Sessions", mock.Anything, "1capp01:1545", cluster, mock.Anything, entity.Infobase{}, mock.Anything).
+				Return([]entity.Session{{ID: "s-1", InfobaseID: "ib-1"}, {ID: "s-2", InfobaseID: "ib-2"}}, nil).
+				Maybe()
+
+			a := auth.New([]auth.APIKey{{Name: "buh-lead", Hash: auth.HashAPIKey("key"), Role: auth.RoleOperator}},
+				nil, "", "", "role",
+				map[string]auth.Scope{"buh-lead": {{Entrypoints: []string{"1capp01:*"}, Infobases: []string{"buh"}}}})
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer, Auth(a))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, strings.NewReader(""))
+			req.Header.Set("X-API-Key", "key")
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Contains(t, w.Body.String(), tc.retVal)
+		})
+	}
+}
+
+func TestScopeFleetRoute(t *testing.T) {
+	logMock := lm.NewInterface(t)
+
+	logMock.On("Info",
+		mock.AnythingOfType("string"),
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything).
+		Maybe()
+
+	fleetMock := ucm.NewCtrlFleet(t)
+
+	fleetMock.On("Sessions", mock.Anything, entity.FleetFilter{}, mock.Anything).
+		Return([]entity.FleetSession{
+			{Entrypoint: "main", Address: "1capp01:1545", ClusterID: "1234-5678", InfobaseName: "buh", Session: entity.Session{ID: "s-1"}},
+			{Entrypoint: "main", Address: "1capp01:1545", ClusterID: "1234-5678", InfobaseName: "zup", Session: entity.Session{ID: "s-2"}},
+			{Entrypoint: "reserve", Address: "1capp02:1545", ClusterID: "1234-5678", InfobaseName: "buh", Session: entity.Session{ID: "s-3"}},
+		}, []entity.FleetError{
+			{Entrypoint: "reserve", Address: "1capp02:1545", Error: "f.ctrl.Clusters: connection refused"},
+		}, nil)
+
+	a := auth.New([]auth.APIKey{{Name: "buh-lead", Hash: auth.HashAPIKey("key"), Role: auth.RoleOperator}},
+		nil, "", "", "role",
+		map[string]auth.Scope{"buh-lead": {{Entrypoints: []string{"1capp01:*"}, Infobases: []string{"buh"}}}})
+
+	tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+	handler := gin.New()
+	NewRouter(handler, logMock, ucm.NewCtrl(t), tracer, Auth(a), Fleet(fleetMock))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/v1/fleet/sessions", nil)
+	req.Header.Set("X-API-Key", "key")
+	handler.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), "{\"sessions\":[{\"ep\":\"main\",\"addr\":\"1capp01:1545\",\"cluster\":\"1234-5678\",\"ibname\":\"buh\",\"id\":\"s-1\"")
+	require.NotContains(t, w.Body.String(), "\"id\":\"s-2\"")
+	require.NotContains(t, w.Body.String(), "\"id\":\"s-3\"")
+	require.Contains(t, w.Body.String(), "\"errors\":[]")
+}
@@ -0,0 +1,136 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/codes"
+
+	v1e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+)
+
+type serverResponse struct {
+	Servers []entity.Server `json:"servers"`
+}
+
+// @Summary     Show all working servers in cluster
+// @Description Show all working servers with their limits for current cluster
+// @ID          servers
+// @Tags  	    server list
+// @Produce     json
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param		cache	    query	 bool			false	"Firstly try to find from Cache"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Success     200 {object} serverResponse
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/server/list [get]
+func (r *ctrlRoutes) servers(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "servers")
+	defer span.End()
+
+	var request requestWoInfobase
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - servers")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	args := map[string]any{
+		common.UseCache:    c.MustGet(common.UseCache),
+		common.ClusterCred: c.MustGet(common.ClusterCred),
+	}
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("get list of servers")
+
+	servers, err := r.c.Servers(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, args)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - servers")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, serverResponse{servers})
+}
+
+type managerResponse struct {
+	Managers []entity.Manager `json:"managers"`
+}
+
+// @Summary     Show all managers in cluster
+// @Description Show all cluster managers with their processes for current cluster
+// @ID          managers
+// @Tags  	    manager list
+// @Produce     json
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param		cache	    query	 bool			false	"Firstly try to find from Cache"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Success     200 {object} managerResponse
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/manager/list [get]
+func (r *ctrlRoutes) managers(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "managers")
+	defer span.End()
+
+	var request requestWoInfobase
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - managers")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	args := map[string]any{
+		common.UseCache:    c.MustGet(common.UseCache),
+		common.ClusterCred: c.MustGet(common.ClusterCred),
+	}
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("get list of managers")
+
+	managers, err := r.c.Managers(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred, args)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - managers")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, managerResponse{managers})
+}
@@ -0,0 +1,125 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func TestServersAndManagersRoute(t *testing.T) {
+	cases := []struct {
+		name           string
+		uri            string
+		method         string
+		ctrlMockResult any
+		ctrlMockError  error
+		code           int
+		retVal         string
+	}{
+		{
+			name:   "Erorr servers wo entrypoint",
+			uri:    "/v1/cluster/1234-5678/server/list",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"bad request\"}",
+		},
+		{
+			name:           "Success servers",
+			uri:            "/v1/cluster/1234-5678/server/list?entrypoint=1capp01:1545",
+			method:         "Servers",
+			ctrlMockResult: []entity.Server{{ID: "123", AgentHost: "1capp01", InfobasesLimit: 8}},
+			code:           http.StatusOK,
+			retVal:         "{\"servers\":[{\"id\":\"123\",\"ahost\":\"1capp01\",\"aport\":\"\",\"ports\":\"\",\"name\":\"\",\"using\":\"\",\"dedicate\":\"\",\"iblim\":8,\"memlim\":0,\"connlim\":0,\"safewpmem\":0,\"safecall\":0,\"cport\":\"\",\"critmem\":0,\"tmpmem\":0,\"tmpmemlim\":0,\"spn\":\"\",\"restart\":\"\"}]}",
+		},
+		{
+			name:           "Error servers",
+			uri:            "/v1/cluster/1234-5678/server/list?entrypoint=1capp01:1545",
+			method:         "Servers",
+			ctrlMockResult: []entity.Server(nil),
+			ctrlMockError:  errors.New("rac error"),
+			code:           http.StatusInternalServerError,
+			retVal:         "{\"error\":\"internal problems\"}",
+		},
+		{
+			name:           "Success managers",
+			uri:            "/v1/cluster/1234-5678/manager/list?entrypoint=1capp01:1545",
+			method:         "Managers",
+			ctrlMockResult: []entity.Manager{{ID: "123", PID: "3820", Host: "1capp01", Port: "1541"}},
+			code:           http.StatusOK,
+			retVal:         "{\"managers\":[{\"id\":\"123\",\"pid\":\"3820\",\"using\":\"\",\"host\":\"1capp01\",\"port\":\"1541\",\"desc\":\"\"}]}",
+		},
+		{
+			name:           "Error managers",
+			uri:            "/v1/cluster/1234-5678/manager/list?entrypoint=1capp01:1545",
+			method:         "Managers",
+			ctrlMockResult: []entity.Manager(nil),
+			ctrlMockError:  errors.New("rac error"),
+			code:           http.StatusInternalServerError,
+			retVal:         "{\"error\":\"internal problems\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			if tc.method != "" {
+				ctrlMock.On(tc.method,
+					mock.MatchedBy(func(ctx context.Context) bool { return true }),
+					"1capp01:1545",
+					entity.Cluster{ID: "1234-5678"},
+					mock.Anything,
+					mock.Anything).
+					Return(tc.ctrlMockResult, tc.ctrlMockError)
+			}
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tc.uri, nil)
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
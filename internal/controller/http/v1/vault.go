@@ -0,0 +1,181 @@
+package v1
+
+import (
+	"errors"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	v1e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/internal/usecase/vault"
+	"github.com/antonmisa/1cctl/pkg/logger"
+)
+
+type vaultRoutes struct {
+	v usecase.CtrlVault
+	l logger.Interface
+	t trace.Tracer
+}
+
+func newVaultRoutes(handler *gin.RouterGroup, v usecase.CtrlVault, l logger.Interface, tr trace.Tracer) {
+	r := &vaultRoutes{v, l, tr}
+
+	h := handler.Group("/vault")
+	{
+		h.GET("", r.keys)
+		h.PUT("", r.set)
+		h.DELETE("", r.remove)
+	}
+}
+
+type vaultKeyQuery struct {
+	Entrypoint string `form:"entrypoint"  binding:"required"                example:"1capp01:1545"`
+	Cluster    string `form:"cluster"     binding:"required_with=Infobase"  example:"UUID"`
+	Infobase   string `form:"infobase"                                      example:"UUID"`
+}
+
+type vaultRequest struct {
+	Entrypoint string `json:"entrypoint"  binding:"required"                example:"1capp01:1545"`
+	Cluster    string `json:"cluster"     binding:"required_with=Infobase"  example:"UUID"`
+	Infobase   string `json:"infobase"                                      example:"UUID"`
+	Login      string `json:"login"       binding:"required"                example:"admin"`
+	Password   string `json:"password"                                      example:"secret"`
+}
+
+type vaultResponse struct {
+	Keys []entity.VaultKey `json:"keys"`
+}
+
+// @Summary     Show keys of vault
+// @Description Show entrypoints, clusters and infobases credentials are stored for, credentials themselves are never shown
+// @ID          vaultKeys
+// @Tags  	    vault
+// @Produce     json
+// @Success     200 {object} vaultResponse
+// @Failure     500 {object} error.response
+// @Router      /vault [get]
+func (r *vaultRoutes) keys(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "vaultKeys")
+	defer span.End()
+
+	span.AddEvent("get keys of vault")
+
+	keys, err := r.v.Keys(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - vaultKeys - r.v.Keys")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("generate json response")
+
+	c.JSON(http.StatusOK, vaultResponse{keys})
+}
+
+// @Summary     Store credentials
+// @Description Store new or rotate existing credentials of entrypoint (for all its clusters), cluster or infobase,
+// @Description they are used when request has no credentials in headers; changes take effect at once
+// @ID          vaultSet
+// @Tags  	    vault
+// @Accept      json
+// @Param		request	body	vaultRequest	true	"Key and credentials"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /vault [put]
+func (r *vaultRoutes) set(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "vaultSet")
+	defer span.End()
+
+	var request vaultRequest
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - vaultSet")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request body")
+
+		return
+	}
+
+	key := entity.VaultKey{Entrypoint: request.Entrypoint, Cluster: request.Cluster, Infobase: request.Infobase}
+
+	span.AddEvent("store credentials")
+
+	if err := r.v.Set(ctx, key, entity.Credentials{Name: request.Login, Pwd: request.Password}); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - vaultSet - r.v.Set")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary     Remove credentials
+// @Description Remove stored credentials of entrypoint, cluster or infobase
+// @ID          vaultRemove
+// @Tags  	    vault
+// @Param		entrypoint	query	string	true	"Entrypoint"
+// @Param		cluster		query	string	false	"UUID of cluster"
+// @Param		infobase	query	string	false	"UUID of infobase"
+// @Success     204
+// @Failure     400 {object} error.response
+// @Failure     404 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /vault [delete]
+func (r *vaultRoutes) remove(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "vaultRemove")
+	defer span.End()
+
+	var query vaultKeyQuery
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - vaultRemove")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	key := entity.VaultKey{Entrypoint: query.Entrypoint, Cluster: query.Cluster, Infobase: query.Infobase}
+
+	span.AddEvent("remove credentials")
+
+	if err := r.v.Remove(ctx, key); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - vaultRemove - r.v.Remove")
+
+		if errors.Is(err, vault.ErrCredentialsNotFound) {
+			v1e.ErrorResponse(c, http.StatusNotFound, "credentials not found")
+
+			return
+		}
+
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,211 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	"github.com/antonmisa/1cctl/internal/usecase/vault"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func TestVaultRoute(t *testing.T) {
+	key := entity.VaultKey{Entrypoint: "1capp01:1545", Cluster: "1234-5678"}
+
+	cases := []struct {
+		name           string
+		method         string
+		uri            string
+		body           string
+		mockMethod     string
+		vaultMockError error
+		code           int
+		retVal         string
+	}{
+		{
+			name:       "Success keys",
+			method:     http.MethodGet,
+			uri:        "/v1/vault",
+			mockMethod: "Keys",
+			code:       http.StatusOK,
+			retVal:     "{\"keys\":[{\"entrypoint\":\"1capp01:1545\",\"cluster\":\"1234-5678\"}]}",
+		},
+		{
+			name:       "Success set",
+			method:     http.MethodPut,
+			uri:        "/v1/vault",
+			body:       "{\"entrypoint\":\"1capp01:1545\",\"cluster\":\"1234-5678\",\"login\":\"admin\",\"password\":\"secret\"}",
+			mockMethod: "Set",
+			code:       http.StatusNoContent,
+		},
+		{
+			name:   "Error set infobase wo cluster",
+			method: http.MethodPut,
+			uri:    "/v1/vault",
+			body:   "{\"entrypoint\":\"1capp01:1545\",\"infobase\":\"ib-1\",\"login\":\"admin\"}",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"invalid request body\"}",
+		},
+		{
+			name:           "Error set internal",
+			method:         http.MethodPut,
+			uri:            "/v1/vault",
+			body:           "{\"entrypoint\":\"1capp01:1545\",\"cluster\":\"1234-5678\",\"login\":\"admin\",\"password\":\"secret\"}",
+			mockMethod:     "Set",
+			vaultMockError: errors.New("read-only file system"),
+			code:           http.StatusInternalServerError,
+			retVal:         "{\"error\":\"internal problems\"}",
+		},
+		{
+			name:       "Success remove",
+			method:     http.MethodDelete,
+			uri:        "/v1/vault?entrypoint=1capp01:1545&cluster=1234-5678",
+			mockMethod: "Remove",
+			code:       http.StatusNoContent,
+		},
+		{
+			name:           "Error remove not found",
+			method:         http.MethodDelete,
+			uri:            "/v1/vault?entrypoint=1capp01:1545&cluster=1234-5678",
+			mockMethod:     "Remove",
+			vaultMockError: fmt.Errorf("ctrlvault - remove: 1capp01:1545: %w", vault.ErrCredentialsNotFound),
+			code:           http.StatusNotFound,
+			retVal:         "{\"error\":\"credentials not found\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			vaultMock := ucm.NewCtrlVault(t)
+
+			switch tc.mockMethod {
+			case "Keys":
+				vaultMock.On("Keys", mock.MatchedBy(func(ctx context.Context) bool { return true })).
+					Return([]entity.VaultKey{key}, tc.vaultMockError)
+			case "Set":
+				vaultMock.On("Set", mock.MatchedBy(func(ctx context.Context) bool { return true }),
+					key, entity.Credentials{Name: "admin", Pwd: "secret"}).
+					Return(tc.vaultMockError)
+			case "Remove":
+				vaultMock.On("Remove", mock.MatchedBy(func(ctx context.Context) bool { return true }), key).
+					Return(tc.vaultMockError)
+			}
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ucm.NewCtrl(t), tracer, Vault(vaultMock))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.uri, strings.NewReader(tc.body))
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+			require.Equal(t, tc.retVal, w.Body.String())
+		})
+	}
+}
+
+func TestVaultCredentials(t *testing.T) {
+	cluster := entity.Cluster{ID: "1234-5678"}
+	stored := entity.Credentials{Name: "admin", Pwd: "secret"}
+
+	cases := []struct {
+		name  string
+		login string
+		cred  entity.Credentials
+	}{
+		{
+			name: "Stored credentials wo headers",
+			cred: stored,
+		},
+		{
+			name:  "Credentials of headers",
+			login: "operator",
+			cred:  entity.Credentials{Name: "operator"},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			vaultMock := ucm.NewCtrlVault(t)
+
+			vaultMock.On("ClusterCredentials", mock.Anything, "1capp01:1545", "1234-5678").
+				Return(stored, true).
+				Maybe()
+
+			vaultMock.On("InfobaseCredentials", mock.Anything, "1capp01:1545", "1234-5678", "").
+				Return(entity.Credentials{}, false).
+				Maybe()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			ctrlMock.On("Infobases", mock.Anything, "1capp01:1545", cluster, tc.cred, mock.Anything).
+				Return([]entity.Infobase{}, nil)
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ctrlMock, tracer, Vault(vaultMock))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/v1/cluster/1234-5678/infobase/list?entrypoint=1capp01:1545", nil)
+
+			if tc.login != "" {
+				req.Header.Set("login", tc.login)
+			}
+
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	v1e "github.com/antonmisa/1cctl/internal/controller/http/v1/error"
+	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/auth"
+	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/clustercredentials"
+	"github.com/antonmisa/1cctl/internal/controller/http/v1/middleware/commonqueryparams"
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+	"github.com/antonmisa/1cctl/pkg/logger"
+)
+
+type watchRoutes struct {
+	w usecase.CtrlWatcher
+	l logger.Interface
+	t trace.Tracer
+}
+
+func newWatchRoutes(handler *gin.RouterGroup, w usecase.CtrlWatcher, t usecase.Ctrl, l logger.Interface, tr trace.Tracer) {
+	r := &watchRoutes{w, l, tr}
+
+	h := handler.Group("/cluster")
+	{
+		h.Use(commonqueryparams.UseCommonQueryParams(l))
+		h.Use(clustercredentials.UseClusterCredentials(l))
+		h.Use(auth.UseScope(l, t))
+
+		h.GET("/:cluster/session/watch", r.watchSessions)
+	}
+}
+
+// @Summary     Watch sessions of cluster
+// @Description Stream of server-sent events with sessions added, removed or changed significantly,
+// @Description all known sessions are sent as added ones first; sessions are polled once for all watchers of cluster
+// @ID          watchSessions
+// @Tags  	    session
+// @Produce     text/event-stream
+// @Param		cluster	    path	 string			true	"UUID of cluster"
+// @Param       entrypoint  query    string         true 	"Entrypoint for cluster"
+// @Success     200 {object} entity.SessionEvent
+// @Failure     400 {object} error.response
+// @Failure     500 {object} error.response
+// @Router      /cluster/:cluster/session/watch [get]
+func (r *watchRoutes) watchSessions(c *gin.Context) {
+	ctx, span := r.t.Start(c.Request.Context(), "watchSessions")
+	defer span.End()
+
+	var request requestWoInfobase
+
+	span.AddEvent("binding incoming params")
+
+	if err := c.ShouldBindUri(&request); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - watchSessions")
+		v1e.ErrorResponse(c, http.StatusBadRequest, "invalid request parameters")
+
+		return
+	}
+
+	entrypoint := c.GetString(common.Entrypoint)
+
+	clusterCred, _ := c.MustGet(common.ClusterCred).(entity.Credentials)
+
+	span.AddEvent("subscribe to sessions")
+
+	events, err := r.w.Watch(ctx, entrypoint, entity.Cluster{ID: request.Cluster}, clusterCred)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.l.Error(err, "http - v1 - watchSessions")
+		v1e.ErrorResponse(c, http.StatusInternalServerError, "internal problems")
+
+		return
+	}
+
+	span.AddEvent("stream events")
+
+	c.Header("Cache-Control", "no-cache")
+
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+
+			c.SSEvent(string(ev.Type), ev)
+			c.Writer.Flush()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func TestWatchSessionsRoute(t *testing.T) {
+	cases := []struct {
+		name           string
+		uri            string
+		events         []entity.SessionEvent
+		watchMockError error
+		code           int
+		retVal         string
+	}{
+		{
+			name: "Success",
+			uri:  "/v1/cluster/1234-5678/session/watch?entrypoint=1capp01:1545",
+			events: []entity.SessionEvent{
+				{Type: entity.SessionAdded, Session: entity.Session{ID: "a"}},
+				{Type: entity.SessionRemoved, Session: entity.Session{ID: "b"}},
+			},
+			code: http.StatusOK,
+		},
+		{
+			name:   "Error wo entrypoint",
+			uri:    "/v1/cluster/1234-5678/session/watch",
+			code:   http.StatusBadRequest,
+			retVal: "{\"error\":\"bad request\"}",
+		},
+		{
+			name:           "Error watch",
+			uri:            "/v1/cluster/1234-5678/session/watch?entrypoint=1capp01:1545",
+			watchMockError: errors.New("watcher is stopped"),
+			code:           http.StatusInternalServerError,
+			retVal:         "{\"error\":\"internal problems\"}",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logMock := lm.NewInterface(t)
+
+			logMock.On("Info",
+				mock.AnythingOfType("string"),
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			logMock.On("Error",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything).
+				Maybe()
+
+			watchMock := ucm.NewCtrlWatcher(t)
+
+			if tc.code != http.StatusBadRequest {
+				ch := make(chan entity.SessionEvent, len(tc.events))
+				for _, ev := range tc.events {
+					ch <- ev
+				}
+
+				close(ch)
+
+				var events <-chan entity.SessionEvent
+				if tc.watchMockError == nil {
+					events = ch
+				}
+
+				anyCtx := mock.MatchedBy(func(ctx context.Context) bool { return true })
+
+				watchMock.On("Watch", anyCtx, "1capp01:1545", entity.Cluster{ID: "1234-5678"}, entity.Credentials{}).
+					Return(events, tc.watchMockError)
+			}
+
+			tracer := otel.GetTracerProvider().Tracer("1ctrl-service")
+
+			handler := gin.New()
+			NewRouter(handler, logMock, ucm.NewCtrl(t), tracer, Watch(watchMock))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tc.uri, nil)
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tc.code, w.Code)
+
+			if tc.code != http.StatusOK {
+				require.Equal(t, tc.retVal, w.Body.String())
+
+				return
+			}
+
+			require.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+			require.Contains(t, w.Body.String(), "event:added\ndata:{\"type\":\"added\",\"session\":{\"id\":\"a\"")
+			require.Contains(t, w.Body.String(), "event:removed\ndata:{\"type\":\"removed\",\"session\":{\"id\":\"b\"")
+		})
+	}
+}
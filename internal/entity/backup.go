@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+// Backup - dump of infobase registered in backup catalog.
+type Backup struct {
+	Path         string    `json:"path"      example:"1capp01_1541/ib/20230810_140443.dt"`
+	Entrypoint   string    `json:"ep"        example:"1capp01:1545"`
+	ClusterID    string    `json:"cluster"   example:"UUID"`
+	InfobaseID   string    `json:"ib"        example:"UUID"`
+	InfobaseName string    `json:"ibname"    example:"ib"`
+	Created      time.Time `json:"created"   example:"2023-08-10T14:04:43Z"`
+	Duration     float64   `json:"duration"  example:"1800.5"`
+	Size         int64     `json:"size"      example:"1073741824"`
+	Checksum     string    `json:"sha256"    example:"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"`
+}
+
+// RetentionPolicy - which dumps of infobase are kept by pruning, zero policy keeps everything.
+type RetentionPolicy struct {
+	KeepLast   int `json:"last"    example:"7"`
+	KeepDaily  int `json:"daily"   example:"14"`
+	KeepWeekly int `json:"weekly"  example:"8"`
+}
@@ -92,3 +92,187 @@ type Connection struct {
 	SID        int       `json:"sid"         rac:"session-number"  example:"12345"`
 	Blocked    int       `json:"blocked"     rac:"blocked-by-ls"  example:"0"`
 }
+
+// Process - working process of cluster.
+type Process struct {
+	ID                string    `json:"id"          rac:"process"               example:"UUID"`
+	Host              string    `json:"host"        rac:"host"                  example:"localhost"`
+	Port              string    `json:"port"        rac:"port"                  example:"1560"`
+	PID               string    `json:"pid"         rac:"pid"                   example:"5176"`
+	Enabled           string    `json:"enabled"     rac:"turned-on"             example:"yes/no"`
+	Running           string    `json:"running"     rac:"running"               example:"yes/no"`
+	Started           time.Time `json:"started"     rac:"started-at"            example:"2023-08-10T14:04:43"`
+	Use               string    `json:"use"         rac:"use"                   example:"used"`
+	AvailPerf         int       `json:"avperf"      rac:"available-perfomance"  example:"184"`
+	Capacity          int       `json:"capacity"    rac:"capacity"              example:"1000"`
+	Connections       int       `json:"conns"       rac:"connections"           example:"6"`
+	MemorySize        int       `json:"memsize"     rac:"memory-size"           example:"512000"`
+	MemoryExcessTime  int       `json:"memexc"      rac:"memory-excess-time"    example:"0"`
+	SelectionSize     int       `json:"selsize"     rac:"selection-size"        example:"8437"`
+	AvgBackCallTime   float64   `json:"avgback"     rac:"avg-back-call-time"    example:"0.001"`
+	AvgCallTime       float64   `json:"avgcall"     rac:"avg-call-time"         example:"0.25"`
+	AvgDBCallTime     float64   `json:"avgdb"       rac:"avg-db-call-time"      example:"0.009"`
+	AvgLockCallTime   float64   `json:"avglock"     rac:"avg-lock-call-time"    example:"0.001"`
+	AvgServerCallTime float64   `json:"avgsrv"      rac:"avg-server-call-time"  example:"0.2"`
+	AvgThreads        float64   `json:"avgthreads"  rac:"avg-threads"           example:"0.08"`
+	Reserve           string    `json:"reserve"     rac:"reserve"               example:"yes/no"`
+}
+
+// Server - working server of cluster.
+type Server struct {
+	ID                   string `json:"id"         rac:"server"                                    example:"UUID"`
+	AgentHost            string `json:"ahost"      rac:"agent-host"                                example:"localhost"`
+	AgentPort            string `json:"aport"      rac:"agent-port"                                example:"1540"`
+	PortRange            string `json:"ports"      rac:"port-range"                                example:"1560:1591"`
+	Name                 string `json:"name"       rac:"name"                                      example:"Central server"`
+	Using                string `json:"using"      rac:"using"                                     example:"main"`
+	DedicateManagers     string `json:"dedicate"   rac:"dedicate-managers"                         example:"none"`
+	InfobasesLimit       int    `json:"iblim"      rac:"infobases-limit"                           example:"8"`
+	MemoryLimit          int    `json:"memlim"     rac:"memory-limit"                              example:"0"`
+	ConnectionsLimit     int    `json:"connlim"    rac:"connections-limit"                         example:"128"`
+	SafeWPMemoryLimit    int    `json:"safewpmem"  rac:"safe-working-processes-memory-limit"      example:"0"`
+	SafeCallMemoryLimit  int    `json:"safecall"   rac:"safe-call-memory-limit"                    example:"0"`
+	ClusterPort          string `json:"cport"      rac:"cluster-port"                              example:"1541"`
+	CriticalMemory       int    `json:"critmem"    rac:"critical-total-memory"                     example:"0"`
+	TempAllowedMemory    int    `json:"tmpmem"     rac:"temporary-allowed-total-memory"            example:"0"`
+	TempAllowedMemoryLim int    `json:"tmpmemlim"  rac:"temporary-allowed-total-memory-time-limit" example:"300"`
+	SPN                  string `json:"spn"        rac:"service-principal-name"                    example:""`
+	RestartSchedule      string `json:"restart"    rac:"restart-schedule"                          example:""`
+}
+
+// Manager - cluster manager.
+type Manager struct {
+	ID    string `json:"id"     rac:"manager"    example:"UUID"`
+	PID   string `json:"pid"    rac:"pid"        example:"3820"`
+	Using string `json:"using"  rac:"using"      example:"normal"`
+	Host  string `json:"host"   rac:"host"       example:"localhost"`
+	Port  string `json:"port"   rac:"main-port"  example:"1541"`
+	Desc  string `json:"desc"   rac:"descr"      example:"Main manager"`
+}
+
+// Lock - managed lock of infobase object, Owner is session holding it if it is found.
+type Lock struct {
+	ConnectionID string    `json:"conn"     rac:"connection"  example:"UUID"`
+	SessionID    string    `json:"session"  rac:"session"     example:"UUID"`
+	Object       string    `json:"object"   rac:"object"      example:"UUID"`
+	Locked       time.Time `json:"locked"   rac:"locked"      example:"2023-08-10T14:04:43"`
+	Desc         string    `json:"desc"     rac:"descr"       example:"БД(сеанс ,УППБД,разделяемая)"`
+	Owner        *Session  `json:"owner,omitempty"`
+}
+
+// InfobaseDetails - settings of infobase, reading them requires infobase credentials.
+type InfobaseDetails struct {
+	ID                      string    `json:"id"              rac:"infobase"                          example:"UUID"`
+	Name                    string    `json:"name"            rac:"name"                              example:"name"`
+	Desc                    string    `json:"desc"            rac:"descr"                             example:"comments"`
+	DBMS                    string    `json:"dbms"            rac:"dbms"                              example:"MSSQLServer"`
+	DBServer                string    `json:"dbsrv"           rac:"db-server"                         example:"sql01"`
+	DBName                  string    `json:"dbname"          rac:"db-name"                           example:"buh"`
+	DBUser                  string    `json:"dbuser"          rac:"db-user"                           example:"sa"`
+	Locale                  string    `json:"loc"             rac:"locale"                            example:"ru_RU"`
+	DateOffset              int       `json:"dateoff"         rac:"date-offset"                       example:"2000"`
+	SecurityLevel           int       `json:"sl"              rac:"security-level"                    example:"0"`
+	LicenseDistribution     string    `json:"licdist"         rac:"license-distribution"              example:"allow"`
+	SessionsDeny            string    `json:"sesdeny"         rac:"sessions-deny"                     example:"on/off"`
+	DeniedFrom              time.Time `json:"deniedfrom"      rac:"denied-from"                       example:"2023-08-10T22:00:00"`
+	DeniedTo                time.Time `json:"deniedto"        rac:"denied-to"                         example:"2023-08-10T23:00:00"`
+	DeniedMessage           string    `json:"deniedmsg"       rac:"denied-message"                    example:"Infobase is locked"`
+	DeniedParameter         string    `json:"deniedparam"     rac:"denied-parameter"                  example:""`
+	PermissionCode          string    `json:"permcode"        rac:"permission-code"                   example:"12345"`
+	ScheduledJobsDeny       string    `json:"sjdeny"          rac:"scheduled-jobs-deny"               example:"on/off"`
+	SecurityProfile         string    `json:"secprof"         rac:"security-profile-name"             example:""`
+	SafeModeSecurityProfile string    `json:"safeprof"        rac:"safe-mode-security-profile-name"   example:""`
+	ReserveWorkingProcesses string    `json:"reservewp"       rac:"reserve-working-processes"         example:"yes/no"`
+	ESMConnection           string    `json:"esm"             rac:"external-session-manager-connection-string"  example:""`
+	ESMRequired             string    `json:"esmreq"          rac:"external-session-manager-required" example:"yes/no"`
+}
+
+// Admin - administrator of cluster or of central server.
+type Admin struct {
+	Name   string `json:"name"    rac:"name"     example:"admin"`
+	Auth   string `json:"auth"    rac:"auth"     example:"pwd"`
+	OSUser string `json:"osuser"  rac:"os-user"  example:"DOMAIN\\user"`
+	Desc   string `json:"desc"    rac:"descr"    example:"comments"`
+}
+
+// Profile - security profile of cluster.
+type Profile struct {
+	Name                            string `json:"name"        rac:"name"                                 example:"restricted"`
+	Desc                            string `json:"desc"        rac:"descr"                                example:"comments"`
+	Config                          string `json:"config"      rac:"config"                               example:"no"`
+	Priv                            string `json:"priv"        rac:"priv"                                 example:"no"`
+	Crypto                          string `json:"crypto"      rac:"crypto"                               example:"yes"`
+	RightExtension                  string `json:"rightext"    rac:"right-extension"                      example:"no"`
+	RightExtensionDefinitionRoles   string `json:"rightroles"  rac:"right-extension-definition-roles"     example:""`
+	AllModulesExtension             string `json:"allmodext"   rac:"all-modules-extension"                example:"yes"`
+	ModulesAvailableForExtension    string `json:"modavail"    rac:"modules-available-for-extension"      example:""`
+	ModulesNotAvailableForExtension string `json:"modnotavail" rac:"modules-not-available-for-extension"  example:""`
+	PrivilegedModeRoles             string `json:"privroles"   rac:"privileged-mode-roles"                example:""`
+}
+
+// Limit - rule of resource consumption limit of cluster.
+type Limit struct {
+	Name                   string `json:"name"      rac:"name"                       example:"heavy_calls"`
+	Counter                string `json:"counter"   rac:"counter"                    example:"calls"`
+	Action                 string `json:"action"    rac:"action"                     example:"interrupt-current-call"`
+	Duration               int    `json:"duration"  rac:"duration"                   example:"60"`
+	CPUTime                int    `json:"cpu"       rac:"cpu-time"                   example:"0"`
+	Memory                 int    `json:"memory"    rac:"memory"                     example:"0"`
+	Read                   int    `json:"read"      rac:"read"                       example:"0"`
+	Write                  int    `json:"write"     rac:"write"                      example:"0"`
+	DurationDBMS           int    `json:"durdbms"   rac:"duration-dbms"              example:"0"`
+	DBMSBytes              int    `json:"dbmsbytes" rac:"dbms-bytes"                 example:"0"`
+	Service                int    `json:"service"   rac:"service"                    example:"0"`
+	Call                   int    `json:"call"      rac:"call"                       example:"0"`
+	NumberOfActiveSessions int    `json:"actses"    rac:"number-of-active-sessions"  example:"0"`
+	NumberOfSessions       int    `json:"ses"       rac:"number-of-sessions"         example:"0"`
+	ErrorMessage           string `json:"errmsg"    rac:"error-message"              example:"call is too long"`
+	Desc                   string `json:"desc"      rac:"descr"                      example:"comments"`
+}
+
+// Counter - resource consumption counter of cluster, resources are analyze or not-analyze.
+type Counter struct {
+	Name                   string `json:"name"      rac:"name"                       example:"users_cpu"`
+	CollectionTime         string `json:"colltime"  rac:"collection-time"            example:"current-call"`
+	Group                  string `json:"group"     rac:"group"                      example:"users"`
+	FilterType             string `json:"ftype"     rac:"filter-type"                example:"all"`
+	Filter                 string `json:"filter"    rac:"filter"                     example:""`
+	Duration               string `json:"duration"  rac:"duration"                   example:"analyze"`
+	CPUTime                string `json:"cpu"       rac:"cpu-time"                   example:"analyze"`
+	Memory                 string `json:"memory"    rac:"memory"                     example:"not-analyze"`
+	Read                   string `json:"read"      rac:"read"                       example:"not-analyze"`
+	Write                  string `json:"write"     rac:"write"                      example:"not-analyze"`
+	DurationDBMS           string `json:"durdbms"   rac:"duration-dbms"              example:"not-analyze"`
+	DBMSBytes              string `json:"dbmsbytes" rac:"dbms-bytes"                 example:"not-analyze"`
+	Service                string `json:"service"   rac:"service"                    example:"not-analyze"`
+	Call                   string `json:"call"      rac:"call"                       example:"analyze"`
+	NumberOfActiveSessions string `json:"actses"    rac:"number-of-active-sessions"  example:"not-analyze"`
+	NumberOfSessions       string `json:"ses"       rac:"number-of-sessions"         example:"not-analyze"`
+	Desc                   string `json:"desc"      rac:"descr"                      example:"comments"`
+}
+
+// CounterValue - accumulated values of counter for object (user, infobase, etc.).
+type CounterValue struct {
+	Object                 string `json:"object"    rac:"object"                     example:"ivanov"`
+	Duration               int    `json:"duration"  rac:"duration"                   example:"1200"`
+	CPUTime                int    `json:"cpu"       rac:"cpu-time"                   example:"800"`
+	Memory                 int    `json:"memory"    rac:"memory"                     example:"1048576"`
+	Read                   int    `json:"read"      rac:"read"                       example:"0"`
+	Write                  int    `json:"write"     rac:"write"                      example:"0"`
+	DurationDBMS           int    `json:"durdbms"   rac:"duration-dbms"              example:"300"`
+	DBMSBytes              int    `json:"dbmsbytes" rac:"dbms-bytes"                 example:"0"`
+	Service                int    `json:"service"   rac:"service"                    example:"0"`
+	Call                   int    `json:"call"      rac:"call"                       example:"15"`
+	NumberOfActiveSessions int    `json:"actses"    rac:"number-of-active-sessions"  example:"1"`
+	NumberOfSessions       int    `json:"ses"       rac:"number-of-sessions"         example:"2"`
+}
+
+// Rule - assignment (requirement) rule of working server, rules are checked in order of position.
+type Rule struct {
+	ID             string `json:"id"       rac:"rule"             example:"UUID"`
+	ObjectType     string `json:"objtype"  rac:"object-type"      example:""`
+	InfobaseName   string `json:"ibname"   rac:"infobase-name"    example:"zup"`
+	RuleType       string `json:"ruletype" rac:"rule-type"        example:"always"`
+	ApplicationExt string `json:"appext"   rac:"application-ext"  example:""`
+	Priority       int    `json:"priority" rac:"priority"         example:"0"`
+}
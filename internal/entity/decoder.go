@@ -36,6 +36,16 @@ func GetKeyValue(line string, delimeter rune) (k, v string, err error) {
 	return "", "", ErrNotFound
 }
 
+// TrimName - rac keeps quotes around names with spaces, trimming them before comparing with user input.
+func TrimName(name string) string {
+	return strings.Trim(name, "\"")
+}
+
+// SameName - decoder lowers values so names are compared ignoring case and quotes.
+func SameName(a, b string) bool {
+	return strings.EqualFold(TrimName(a), TrimName(b))
+}
+
 // Converting lines of strings to object
 // TODO: use codegen, not reflect - it's slow
 func Unmarshal(lines []string, v any) error {
@@ -73,6 +83,12 @@ func Unmarshal(lines []string, v any) error {
 					if err == nil {
 						fv.SetInt(int64(vi))
 					}
+				case float64:
+					vf, err := strconv.ParseFloat(value, 64)
+
+					if err == nil {
+						fv.SetFloat(vf)
+					}
 				default:
 					fv.Set(reflect.ValueOf(value))
 				}
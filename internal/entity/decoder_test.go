@@ -197,6 +197,24 @@ func TestUnmarshal(t *testing.T) {
 			res: Connection{},
 			err: ErrNotFound,
 		},
+		{
+			name: "OK process",
+			args: args{
+				lines: []string{
+					"process:    test",
+					"pid: 1234",
+					"avg-call-time: 0.25",
+					"started-at: 2023-08-08T10:48:43",
+				},
+				v: Process{},
+			},
+			res: Process{
+				ID:          "test",
+				PID:         "1234",
+				AvgCallTime: 0.25,
+				Started:     time.Date(2023, time.August, 8, 10, 48, 43, 0, time.UTC),
+			},
+		},
 	}
 	for _, tc := range cases {
 		tc := tc
@@ -226,6 +244,11 @@ func TestUnmarshal(t *testing.T) {
 				var v Connection
 				err = Unmarshal(tc.args.lines, &v)
 
+				require.Equal(t, v, tc.res)
+			case Process:
+				var v Process
+				err = Unmarshal(tc.args.lines, &v)
+
 				require.Equal(t, v, tc.res)
 			default:
 			}
@@ -238,3 +261,38 @@ func TestUnmarshal(t *testing.T) {
 		})
 	}
 }
+
+func TestSameName(t *testing.T) {
+	cases := []struct {
+		name string
+		a    string
+		b    string
+		same bool
+	}{
+		{
+			name: "Same",
+			a:    "ib",
+			b:    "ib",
+			same: true,
+		},
+		{
+			name: "Same ignoring case and quotes",
+			a:    `"my ib"`,
+			b:    "My IB",
+			same: true,
+		},
+		{
+			name: "Different",
+			a:    `"my ib"`,
+			b:    "ib",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.same, SameName(tc.a, tc.b))
+		})
+	}
+}
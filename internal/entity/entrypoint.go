@@ -0,0 +1,9 @@
+package entity
+
+// Entrypoint - named ras server, credentials of cluster administrator are used when request has none.
+type Entrypoint struct {
+	Name        string      `json:"name"     example:"main"`
+	Address     string      `json:"address"  example:"1capp01:1545"`
+	Tags        []string    `json:"tags"     example:"prod"`
+	ClusterCred Credentials `json:"-"`
+}
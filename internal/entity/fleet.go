@@ -0,0 +1,33 @@
+package entity
+
+// FleetFilter - narrowing of fleet-wide views, empty fields match any.
+type FleetFilter struct {
+	Tag      string
+	Infobase string
+	UserName string
+}
+
+// FleetSession - session annotated with entrypoint (name and address) and cluster it was found in.
+type FleetSession struct {
+	Entrypoint   string `json:"ep"       example:"main"`
+	Address      string `json:"addr"     example:"1capp01:1545"`
+	ClusterID    string `json:"cluster"  example:"UUID"`
+	InfobaseName string `json:"ibname"   example:"buh"`
+	Session
+}
+
+// FleetInfobase - infobase annotated with entrypoint (name and address) and cluster it was found in.
+type FleetInfobase struct {
+	Entrypoint string `json:"ep"       example:"main"`
+	Address    string `json:"addr"     example:"1capp01:1545"`
+	ClusterID  string `json:"cluster"  example:"UUID"`
+	Infobase
+}
+
+// FleetError - entrypoint or its cluster which was not read, cluster is empty when entrypoint failed at all.
+type FleetError struct {
+	Entrypoint string `json:"ep"                 example:"main"`
+	Address    string `json:"addr"               example:"1capp01:1545"`
+	ClusterID  string `json:"cluster,omitempty"  example:"UUID"`
+	Error      string `json:"error"              example:"context deadline exceeded"`
+}
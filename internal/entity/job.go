@@ -0,0 +1,46 @@
+package entity
+
+import "time"
+
+// JobStatus -.
+type JobStatus string
+
+const (
+	JobQueued    JobStatus = "queued"
+	JobRunning   JobStatus = "running"
+	JobSucceeded JobStatus = "succeeded"
+	JobFailed    JobStatus = "failed"
+	JobSkipped   JobStatus = "skipped"
+)
+
+// Job - background task like infobase backup.
+type Job struct {
+	ID         string    `json:"id"        example:"UUID"`
+	Kind       string    `json:"kind"      example:"backup"`
+	Status     JobStatus `json:"status"    example:"running"`
+	Created    time.Time `json:"created"   example:"2023-08-10T14:04:43Z"`
+	Started    time.Time `json:"started"   example:"2023-08-10T14:04:43Z"`
+	Finished   time.Time `json:"finished"  example:"2023-08-10T14:34:43Z"`
+	InputPath  string    `json:"input,omitempty" example:"/backups/ib_20230810_140443.dt"`
+	OutputPath string    `json:"output"    example:"/backups/ib_20230810_140443.dt"`
+	ExitCode   int       `json:"exitcode"  example:"0"`
+	Log        string    `json:"log"       example:"Выгрузка информационной базы успешно завершена"`
+	Error      string    `json:"error"     example:""`
+	Steps      []JobStep `json:"steps,omitempty"`
+}
+
+// JobStep - stage of multistep job like safe backup.
+type JobStep struct {
+	Name     string    `json:"name"      example:"kick"`
+	Status   JobStatus `json:"status"    example:"succeeded"`
+	Started  time.Time `json:"started"   example:"2023-08-10T14:04:43Z"`
+	Finished time.Time `json:"finished"  example:"2023-08-10T14:05:13Z"`
+	Attempts int       `json:"attempts"  example:"1"`
+	Error    string    `json:"error"     example:""`
+}
+
+// DesignerResult - outcome of 1cv8 designer batch run.
+type DesignerResult struct {
+	ExitCode int
+	Log      string
+}
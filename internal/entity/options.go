@@ -0,0 +1,160 @@
+package entity
+
+import "time"
+
+// LockOptions - parameters of denying sessions to infobase.
+type LockOptions struct {
+	DeniedFrom        time.Time
+	DeniedTo          time.Time
+	Message           string
+	PermissionCode    string
+	ScheduledJobsDeny bool
+}
+
+// BackupOptions - parameters of dumping infobase, nil Retention means default policy.
+type BackupOptions struct {
+	LockCode  string
+	Retention *RetentionPolicy
+}
+
+// SafeBackupOptions - parameters of backup with locking infobase and terminating sessions.
+type SafeBackupOptions struct {
+	LockCode    string
+	Message     string
+	GracePeriod time.Duration
+	Retention   *RetentionPolicy
+}
+
+// RestoreOptions - parameters of loading infobase from dump, Confirm must be equal to name of infobase.
+type RestoreOptions struct {
+	File        string
+	Confirm     string
+	LockCode    string
+	Message     string
+	GracePeriod time.Duration
+}
+
+// LockFilter - narrowing list of locks to connection or session, empty fields mean any.
+type LockFilter struct {
+	Connection string
+	Session    string
+}
+
+// InfobaseUpdateOptions - settings of infobase to change, nil fields are left as is.
+type InfobaseUpdateOptions struct {
+	Desc                    *string
+	DBMS                    *string
+	DBServer                *string
+	DBName                  *string
+	DBUser                  *string
+	DBPwd                   *string
+	DeniedFrom              *time.Time
+	DeniedTo                *time.Time
+	DeniedMessage           *string
+	DeniedParameter         *string
+	PermissionCode          *string
+	SessionsDeny            *bool
+	ScheduledJobsDeny       *bool
+	LicenseDistribution     *bool
+	SecurityProfile         *string
+	SafeModeSecurityProfile *string
+	ReserveWorkingProcesses *bool
+}
+
+// InfobaseCreateOptions - parameters of new infobase, CreateDatabase asks DBMS to create database if it is absent.
+type InfobaseCreateOptions struct {
+	Name              string
+	Desc              string
+	DBMS              string
+	DBServer          string
+	DBName            string
+	DBUser            string
+	DBPwd             string
+	Locale            string
+	DateOffset        int
+	CreateDatabase    bool
+	ScheduledJobsDeny bool
+}
+
+// InfobaseDropOptions - parameters of infobase removal, Confirm must be equal to name of infobase.
+// Database of infobase is kept unless DropDatabase or ClearDatabase is set.
+type InfobaseDropOptions struct {
+	Confirm       string
+	DropDatabase  bool
+	ClearDatabase bool
+}
+
+// ClusterUpdateOptions - settings of cluster to change, nil fields are left as is.
+type ClusterUpdateOptions struct {
+	Name                       *string
+	ExpirationTimeout          *int
+	LifetimeLimit              *int
+	MaxMemorySize              *int
+	MaxMemoryTimeLimit         *int
+	SecurityLevel              *int
+	SessionFaultToleranceLevel *int
+	LoadBalancingMode          *string
+	ErrorsCountThreshold       *int
+	KillProblemProcesses       *bool
+}
+
+// ClusterInsertOptions - parameters of cluster registration on agent, settings are applied if set.
+type ClusterInsertOptions struct {
+	Host string
+	Port int
+	ClusterUpdateOptions
+}
+
+// AdminOptions - parameters of administrator registration, Auth is pwd, os or both separated by comma.
+type AdminOptions struct {
+	Name   string
+	Pwd    string
+	Auth   string
+	OSUser string
+	Desc   string
+}
+
+// ProfileOptions - settings of security profile, all of them are written on create and update.
+type ProfileOptions struct {
+	Name                            string
+	Desc                            string
+	Config                          bool
+	Priv                            bool
+	Crypto                          bool
+	RightExtension                  bool
+	RightExtensionDefinitionRoles   string
+	AllModulesExtension             bool
+	ModulesAvailableForExtension    string
+	ModulesNotAvailableForExtension string
+	PrivilegedModeRoles             string
+}
+
+// LimitOptions - settings of resource consumption limit, all of them are written on create and update.
+type LimitOptions struct {
+	Name                   string
+	Counter                string
+	Action                 string
+	Duration               int
+	CPUTime                int
+	Memory                 int
+	Read                   int
+	Write                  int
+	DurationDBMS           int
+	DBMSBytes              int
+	Service                int
+	Call                   int
+	NumberOfActiveSessions int
+	NumberOfSessions       int
+	ErrorMessage           string
+	Desc                   string
+}
+
+// RuleOptions - settings of assignment rule of working server, all of them are written on insert and update.
+type RuleOptions struct {
+	Position       int
+	ObjectType     string
+	InfobaseName   string
+	RuleType       string
+	ApplicationExt string
+	Priority       int
+}
@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// ReapRecord - session terminated by reaper policy, in dry-run mode session is only found.
+type ReapRecord struct {
+	Time       time.Time `json:"time"     example:"2023-08-10T14:04:43Z"`
+	Policy     string    `json:"policy"   example:"buh-idle"`
+	Entrypoint string    `json:"ep"       example:"1capp01:1545"`
+	ClusterID  string    `json:"cluster"  example:"UUID"`
+	Infobase   string    `json:"ib"       example:"buh"`
+	SessionID  string    `json:"session"  example:"UUID"`
+	SID        int       `json:"sid"      example:"12345"`
+	UserName   string    `json:"uname"    example:"ivanov"`
+	AppID      string    `json:"appid"    example:"1cv8c"`
+	Host       string    `json:"host"     example:"pc-101"`
+	LastActive time.Time `json:"active"   example:"2023-08-10T11:04:43Z"`
+	Reason     string    `json:"reason"   example:"idle for 3h0m0s, rule 1: idle 2h0m0s"`
+	DryRun     bool      `json:"dry_run"  example:"false"`
+	Error      string    `json:"error"    example:""`
+}
@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+// Schedule - backup of infobase run by cron expression.
+type Schedule struct {
+	Name        string           `json:"name"         example:"buh-nightly"`
+	Cron        string           `json:"cron"         example:"0 2 * * *"`
+	Entrypoint  string           `json:"ep"           example:"1capp01:1545"`
+	ClusterID   string           `json:"cluster"      example:"UUID"`
+	InfobaseID  string           `json:"ib"           example:"UUID"`
+	Credentials string           `json:"credentials"  example:"buh"`
+	Safe        bool             `json:"safe"         example:"true"`
+	Retention   *RetentionPolicy `json:"retention,omitempty"`
+	Next        time.Time        `json:"next"         example:"2023-08-11T02:00:00Z"`
+
+	ScheduleState
+}
+
+// ScheduleState - outcome of last run of schedule, it is kept across restarts.
+type ScheduleState struct {
+	LastRun    time.Time `json:"last_run"     example:"2023-08-10T02:00:00Z"`
+	LastJob    string    `json:"last_job"     example:"UUID"`
+	LastStatus JobStatus `json:"last_status"  example:"succeeded"`
+	LastError  string    `json:"last_error"   example:""`
+}
@@ -0,0 +1,9 @@
+package entity
+
+// VaultKey - credentials are stored for cluster (infobase is empty), for infobase (id) of cluster
+// or for all clusters of entrypoint (cluster and infobase are empty).
+type VaultKey struct {
+	Entrypoint string `json:"entrypoint"          example:"1capp01:1545"`
+	Cluster    string `json:"cluster,omitempty"   example:"UUID"`
+	Infobase   string `json:"infobase,omitempty"  example:"UUID"`
+}
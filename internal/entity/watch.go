@@ -0,0 +1,16 @@
+package entity
+
+// SessionEventType -.
+type SessionEventType string
+
+const (
+	SessionAdded   SessionEventType = "added"
+	SessionRemoved SessionEventType = "removed"
+	SessionChanged SessionEventType = "changed"
+)
+
+// SessionEvent - change of session found by comparing two successive lists of sessions of cluster.
+type SessionEvent struct {
+	Type    SessionEventType `json:"type"  example:"added"`
+	Session Session          `json:"session"`
+}
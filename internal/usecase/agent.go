@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+// AgentAdmins - getting administrators of central server agent.
+func (c *CtrlUseCase) AgentAdmins(ctx context.Context, entrypoint string, agentCred entity.Credentials) ([]entity.Admin, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("GetAgentAdmins from 1C")
+
+	admins, err := c.pipe.GetAgentAdmins(ctx, entrypoint, agentCred)
+	if err != nil {
+		return nil, fmt.Errorf("CtrlUseCase - AgentAdmins - c.pipe.GetAgentAdmins: %w", err)
+	}
+
+	return admins, nil
+}
+
+// RegisterAgentAdmin - adding administrator to central server agent.
+func (c *CtrlUseCase) RegisterAgentAdmin(ctx context.Context, entrypoint string, agentCred entity.Credentials, opts entity.AdminOptions) error {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("RegisterAgentAdmin in 1C")
+
+	err := c.pipe.RegisterAgentAdmin(ctx, entrypoint, agentCred, opts)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - RegisterAgentAdmin - c.pipe.RegisterAgentAdmin: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveAgentAdmin - deleting administrator of central server agent.
+func (c *CtrlUseCase) RemoveAgentAdmin(ctx context.Context, entrypoint string, agentCred entity.Credentials, name string) error {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("RemoveAgentAdmin in 1C")
+
+	err := c.pipe.RemoveAgentAdmin(ctx, entrypoint, agentCred, name)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - RemoveAgentAdmin - c.pipe.RemoveAgentAdmin: %w", err)
+	}
+
+	return nil
+}
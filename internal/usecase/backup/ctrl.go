@@ -1,14 +1,21 @@
 package backup
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/antonmisa/1cctl/internal/entity"
 )
 
+const (
+	_byteOrderMark string = "\uFEFF"
+)
+
 // CtrlBackup -.
 type CtrlBackup struct {
 	pathTo1C string
@@ -28,23 +35,84 @@ func New(path string) (*CtrlBackup, error) {
 	return ctrl, nil
 }
 
-// RunBackup -.
+// RunBackup - dumping infobase to outputPath, designer log and exit code are returned even on error.
 func (r *CtrlBackup) RunBackup(ctx context.Context,
 	cl entity.Cluster, ib entity.Infobase,
 	ibCred entity.Credentials,
 	lockCode string,
-	outputPath string) error {
+	outputPath string) (entity.DesignerResult, error) {
+	result, err := r.run(ctx, cl, ib, ibCred, lockCode, "/DumpIB", outputPath)
+	if err != nil {
+		return result, fmt.Errorf("ctrlbackup - runbackup - %w", err)
+	}
 
-	cmd := exec.CommandContext(ctx, r.pathTo1C, "CONFIG", "/S", fmt.Sprintf("%s:%s\\%s", cl.Host, cl.Port, ib.Name),
-		"/N", ibCred.Name, "/P", ibCred.Pwd,
-		"/UC", lockCode, "/DisableStartupMessages",
-		"/DumpIB", outputPath) //nolint:gosec // it is normal
+	return result, nil
+}
+
+// RunRestore - loading infobase from dump at inputPath, designer log and exit code are returned even on error.
+func (r *CtrlBackup) RunRestore(ctx context.Context,
+	cl entity.Cluster, ib entity.Infobase,
+	ibCred entity.Credentials,
+	lockCode string,
+	inputPath string) (entity.DesignerResult, error) {
+	result, err := r.run(ctx, cl, ib, ibCred, lockCode, "/RestoreIB", inputPath)
+	if err != nil {
+		return result, fmt.Errorf("ctrlbackup - runrestore - %w", err)
+	}
+
+	return result, nil
+}
+
+func (r *CtrlBackup) run(ctx context.Context,
+	cl entity.Cluster, ib entity.Infobase,
+	ibCred entity.Credentials,
+	lockCode string,
+	command string, path string) (entity.DesignerResult, error) {
+	var result entity.DesignerResult
 
-	err := cmd.Run()
+	logFile, err := os.CreateTemp("", "1cv8_*.log")
 	if err != nil {
-		return fmt.Errorf("ctrlbackup - runbackup - cmd.Run: %w", err)
+		return result, fmt.Errorf("os.CreateTemp: %w", err)
+	}
+
+	logPath := logFile.Name()
+
+	logFile.Close()
+	defer os.Remove(logPath)
+
+	args := []string{"CONFIG", "/S", fmt.Sprintf("%s:%s\\%s", cl.Host, cl.Port, ib.Name)}
+
+	if ibCred != (entity.Credentials{}) {
+		args = append(args, "/N", ibCred.Name, "/P", ibCred.Pwd)
+	}
+
+	args = append(args,
+		"/UC", lockCode, "/DisableStartupMessages", "/DisableStartupDialogs",
+		"/Out", logPath,
+		command, path)
+
+	var output bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, r.pathTo1C, args...) //nolint:gosec // it is normal
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	errr := cmd.Run()
+
+	if cmd.ProcessState != nil {
+		result.ExitCode = cmd.ProcessState.ExitCode()
+	}
+
+	designerLog, err := os.ReadFile(logPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return result, fmt.Errorf("os.ReadFile: %w", err)
+	}
+
+	result.Log = strings.TrimSpace(output.String() + strings.TrimPrefix(string(designerLog), _byteOrderMark))
+
+	if errr != nil {
+		return result, fmt.Errorf("cmd.Run: %w", errr)
 	}
-	defer cmd.Cancel()
 
-	return nil
+	return result, nil
 }
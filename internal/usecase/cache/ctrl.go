@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/antonmisa/1cctl/internal/entity"
@@ -16,9 +17,16 @@ const (
 	_keyInfobases   string = "%s:clusters:%s:ibs"
 	_keySessions    string = "%s:clusters:%s:ibs:%s:ses"
 	_keyConnections string = "%s:clusters:%s:ibs:%s:conns"
+	_keyProcesses   string = "%s:clusters:%s:procs"
+	_keyServers     string = "%s:clusters:%s:srvs"
+	_keyManagers    string = "%s:clusters:%s:mgrs"
+
+	_suffixSessions    string = ":ses"
+	_suffixConnections string = ":conns"
 
 	_defaultSessionTTL    time.Duration = 5 * time.Second
 	_defaultConnectionTTL time.Duration = 5 * time.Second
+	_defaultProcessTTL    time.Duration = 5 * time.Second
 )
 
 var (
@@ -57,6 +65,13 @@ func (cc *CtrlCache) PutClusters(ctx context.Context, entrypoint string, entitie
 	return nil
 }
 
+// DeleteClusters - dropping cached cluster list of entrypoint.
+func (cc *CtrlCache) DeleteClusters(ctx context.Context, entrypoint string) error {
+	cc.cache.Delete(fmt.Sprintf(_keyClusters, entrypoint))
+
+	return nil
+}
+
 // GetInfobases -.
 func (cc *CtrlCache) GetInfobases(ctx context.Context, entrypoint string, cluster entity.Cluster) ([]entity.Infobase, error) {
 	key := fmt.Sprintf(_keyInfobases, entrypoint, cluster.ID)
@@ -79,6 +94,13 @@ func (cc *CtrlCache) PutInfobases(ctx context.Context, entrypoint string, cluste
 	return nil
 }
 
+// DeleteInfobases - dropping cached infobase list of cluster.
+func (cc *CtrlCache) DeleteInfobases(ctx context.Context, entrypoint string, cluster entity.Cluster) error {
+	cc.cache.Delete(fmt.Sprintf(_keyInfobases, entrypoint, cluster.ID))
+
+	return nil
+}
+
 // GetSessions -.
 func (cc *CtrlCache) GetSessions(ctx context.Context, entrypoint string, cluster entity.Cluster, ib entity.Infobase) ([]entity.Session, error) {
 	key := fmt.Sprintf(_keySessions, entrypoint, cluster.ID, ib.ID)
@@ -122,3 +144,93 @@ func (cc *CtrlCache) PutConnections(ctx context.Context, entrypoint string, clus
 
 	return nil
 }
+
+// GetProcesses -.
+func (cc *CtrlCache) GetProcesses(ctx context.Context, entrypoint string, cluster entity.Cluster) ([]entity.Process, error) {
+	key := fmt.Sprintf(_keyProcesses, entrypoint, cluster.ID)
+
+	v, ok := cc.cache.Get(key)
+
+	if !ok {
+		return []entity.Process{}, ErrNotFound
+	}
+
+	return v.([]entity.Process), nil
+}
+
+// PutProcesses -.
+func (cc *CtrlCache) PutProcesses(ctx context.Context, entrypoint string, cluster entity.Cluster, entities []entity.Process) error {
+	key := fmt.Sprintf(_keyProcesses, entrypoint, cluster.ID)
+
+	cc.cache.Set(key, entities, _defaultProcessTTL)
+
+	return nil
+}
+
+// GetServers -.
+func (cc *CtrlCache) GetServers(ctx context.Context, entrypoint string, cluster entity.Cluster) ([]entity.Server, error) {
+	key := fmt.Sprintf(_keyServers, entrypoint, cluster.ID)
+
+	v, ok := cc.cache.Get(key)
+
+	if !ok {
+		return []entity.Server{}, ErrNotFound
+	}
+
+	return v.([]entity.Server), nil
+}
+
+// PutServers -.
+func (cc *CtrlCache) PutServers(ctx context.Context, entrypoint string, cluster entity.Cluster, entities []entity.Server) error {
+	key := fmt.Sprintf(_keyServers, entrypoint, cluster.ID)
+
+	cc.cache.Set(key, entities, 0)
+
+	return nil
+}
+
+// GetManagers -.
+func (cc *CtrlCache) GetManagers(ctx context.Context, entrypoint string, cluster entity.Cluster) ([]entity.Manager, error) {
+	key := fmt.Sprintf(_keyManagers, entrypoint, cluster.ID)
+
+	v, ok := cc.cache.Get(key)
+
+	if !ok {
+		return []entity.Manager{}, ErrNotFound
+	}
+
+	return v.([]entity.Manager), nil
+}
+
+// PutManagers -.
+func (cc *CtrlCache) PutManagers(ctx context.Context, entrypoint string, cluster entity.Cluster, entities []entity.Manager) error {
+	key := fmt.Sprintf(_keyManagers, entrypoint, cluster.ID)
+
+	cc.cache.Set(key, entities, 0)
+
+	return nil
+}
+
+// DeleteSessions - dropping all cached session lists of cluster.
+func (cc *CtrlCache) DeleteSessions(ctx context.Context, entrypoint string, cluster entity.Cluster) error {
+	cc.deleteByInfobases(entrypoint, cluster, _suffixSessions)
+
+	return nil
+}
+
+// DeleteConnections - dropping all cached connection lists of cluster.
+func (cc *CtrlCache) DeleteConnections(ctx context.Context, entrypoint string, cluster entity.Cluster) error {
+	cc.deleteByInfobases(entrypoint, cluster, _suffixConnections)
+
+	return nil
+}
+
+// deleteByInfobases - lists are cached per infobase and for the whole cluster (empty infobase),
+// so all of them are dropped at once.
+func (cc *CtrlCache) deleteByInfobases(entrypoint string, cluster entity.Cluster, suffix string) {
+	prefix := fmt.Sprintf(_keyInfobases, entrypoint, cluster.ID) + ":"
+
+	cc.cache.DeleteFunc(func(key string) bool {
+		return strings.HasPrefix(key, prefix) && strings.HasSuffix(key, suffix)
+	})
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+// Backups - listing dumps registered in catalog, empty identifiers of cluster and infobase mean any.
+func (c *CtrlUseCase) Backups(ctx context.Context, cluster entity.Cluster, infobase entity.Infobase) ([]entity.Backup, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("List from catalog")
+
+	backups, err := c.catalog.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("CtrlUseCase - Backups - c.catalog.List: %w", err)
+	}
+
+	rv := make([]entity.Backup, 0, len(backups))
+
+	for _, b := range backups {
+		if (cluster.ID == "" || b.ClusterID == cluster.ID) && (infobase.ID == "" || b.InfobaseID == infobase.ID) {
+			rv = append(rv, b)
+		}
+	}
+
+	return rv, nil
+}
+
+// dump - dumping infobase to outputPath, registering dump in catalog and pruning old dumps of infobase,
+// nil retention means default policy.
+func (c *CtrlUseCase) dump(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, infobaseCred entity.Credentials, lockCode string, outputPath string, retention *entity.RetentionPolicy, update func(change func(job *entity.Job))) error {
+	started := time.Now()
+
+	result, err := c.backup.RunBackup(ctx, cluster, infobase, infobaseCred, lockCode, outputPath)
+
+	update(func(job *entity.Job) {
+		job.ExitCode = result.ExitCode
+		job.Log = result.Log
+	})
+
+	if err != nil {
+		return fmt.Errorf("c.backup.RunBackup: %w", err)
+	}
+
+	_, err = c.catalog.Add(ctx, entity.Backup{
+		Path:         outputPath,
+		Entrypoint:   entrypoint,
+		ClusterID:    cluster.ID,
+		InfobaseID:   infobase.ID,
+		InfobaseName: infobase.Name,
+		Created:      started,
+		Duration:     time.Since(started).Seconds(),
+	})
+	if err != nil {
+		return fmt.Errorf("c.catalog.Add: %w", err)
+	}
+
+	policy := c.retention
+	if retention != nil {
+		policy = *retention
+	}
+
+	_, err = c.catalog.Prune(ctx, cluster, infobase, policy)
+	if err != nil {
+		return fmt.Errorf("c.catalog.Prune: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,363 @@
+package catalog
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	uc "github.com/antonmisa/1cctl/internal/usecase"
+)
+
+const (
+	_indexFile  string = "index.json"
+	_formatTime string = "20060102_150405"
+	_extension  string = ".dt"
+
+	_formatDay string = "2006-01-02"
+)
+
+var (
+	ErrOutsideRoot = errors.New("path is outside of catalog root")
+)
+
+type index struct {
+	Backups []entity.Backup `json:"backups"`
+}
+
+// CtrlCatalog - dumps of infobases under root directory with index file keeping their size, duration and checksum.
+type CtrlCatalog struct {
+	sync.Mutex
+	root     string
+	backups  []entity.Backup
+	reserved map[string]struct{}
+}
+
+var _ uc.CtrlCatalog = (*CtrlCatalog)(nil)
+
+// New - loading index of catalog, root is created if needed.
+func New(root string) (*CtrlCatalog, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("ctrlcatalog - new - filepath.Abs: %w", err)
+	}
+
+	err = os.MkdirAll(root, 0755)
+	if err != nil {
+		return nil, fmt.Errorf("ctrlcatalog - new - os.MkdirAll: %w", err)
+	}
+
+	k := &CtrlCatalog{
+		root:     root,
+		backups:  make([]entity.Backup, 0),
+		reserved: make(map[string]struct{}),
+	}
+
+	data, err := os.ReadFile(filepath.Join(root, _indexFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return k, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("ctrlcatalog - new - os.ReadFile: %w", err)
+	}
+
+	var idx index
+
+	err = json.Unmarshal(data, &idx)
+	if err != nil {
+		return nil, fmt.Errorf("ctrlcatalog - new - json.Unmarshal: %w", err)
+	}
+
+	if idx.Backups != nil {
+		k.backups = idx.Backups
+	}
+
+	return k, nil
+}
+
+// Path - naming dump as root/cluster/infobase/timestamp.dt, directory of dump is created.
+// Dumps of infobase started in the same second get suffix _1, _2 and so on, so name given out once
+// is not given again until the dump is added to catalog.
+func (k *CtrlCatalog) Path(ctx context.Context, cluster entity.Cluster, infobase entity.Infobase, created time.Time) (string, error) {
+	dir := filepath.Join(k.root, sanitize(cluster.Host+"_"+cluster.Port), sanitize(infobase.Name))
+
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return "", fmt.Errorf("ctrlcatalog - path - os.MkdirAll: %w", err)
+	}
+
+	k.Lock()
+	defer k.Unlock()
+
+	name := created.Format(_formatTime)
+
+	for i := 1; ; i++ {
+		path := filepath.Join(dir, name+_extension)
+
+		taken, err := k.taken(path)
+		if err != nil {
+			return "", fmt.Errorf("ctrlcatalog - path - k.taken: %w", err)
+		}
+
+		if !taken {
+			k.reserved[path] = struct{}{}
+
+			return path, nil
+		}
+
+		name = fmt.Sprintf("%s_%d", created.Format(_formatTime), i)
+	}
+}
+
+// taken - path is given out already, registered or exists on disk, lock must be held.
+func (k *CtrlCatalog) taken(path string) (bool, error) {
+	if _, ok := k.reserved[path]; ok {
+		return true, nil
+	}
+
+	rel, err := k.rel(path)
+	if err != nil {
+		return false, err
+	}
+
+	for _, b := range k.backups {
+		if b.Path == rel {
+			return true, nil
+		}
+	}
+
+	_, err = os.Lstat(path)
+	if err == nil {
+		return true, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return false, fmt.Errorf("os.Lstat: %w", err)
+	}
+
+	return false, nil
+}
+
+// Add - registering dump written to backup.Path, size and checksum are computed from file.
+func (k *CtrlCatalog) Add(ctx context.Context, backup entity.Backup) (entity.Backup, error) {
+	rel, err := k.rel(backup.Path)
+	if err != nil {
+		return entity.Backup{}, fmt.Errorf("ctrlcatalog - add - k.rel: %w", err)
+	}
+
+	backup.Path = rel
+
+	backup.Size, backup.Checksum, err = checksum(filepath.Join(k.root, filepath.FromSlash(rel)))
+	if err != nil {
+		return entity.Backup{}, fmt.Errorf("ctrlcatalog - add - checksum: %w", err)
+	}
+
+	k.Lock()
+	defer k.Unlock()
+
+	backups := make([]entity.Backup, 0, len(k.backups)+1)
+
+	for _, b := range k.backups {
+		if b.Path != backup.Path {
+			backups = append(backups, b)
+		}
+	}
+
+	backups = append(backups, backup)
+
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i].Created.Before(backups[j].Created)
+	})
+
+	err = k.save(backups)
+	if err != nil {
+		return entity.Backup{}, fmt.Errorf("ctrlcatalog - add - k.save: %w", err)
+	}
+
+	k.backups = backups
+
+	delete(k.reserved, filepath.Join(k.root, filepath.FromSlash(rel)))
+
+	return backup, nil
+}
+
+// List - all registered dumps from oldest to newest.
+func (k *CtrlCatalog) List(ctx context.Context) ([]entity.Backup, error) {
+	k.Lock()
+	defer k.Unlock()
+
+	rv := make([]entity.Backup, len(k.backups))
+	copy(rv, k.backups)
+
+	return rv, nil
+}
+
+// Prune - removing dumps of infobase not kept by policy, removed ones are returned.
+func (k *CtrlCatalog) Prune(ctx context.Context, cluster entity.Cluster, infobase entity.Infobase, policy entity.RetentionPolicy) ([]entity.Backup, error) {
+	if policy == (entity.RetentionPolicy{}) {
+		return []entity.Backup{}, nil
+	}
+
+	k.Lock()
+	defer k.Unlock()
+
+	// Newest dumps go first for choosing which ones to keep
+	candidates := make([]entity.Backup, 0)
+
+	for i := len(k.backups) - 1; i >= 0; i-- {
+		b := k.backups[i]
+
+		if b.ClusterID == cluster.ID && b.InfobaseID == infobase.ID {
+			candidates = append(candidates, b)
+		}
+	}
+
+	keep := retain(candidates, policy, time.Now())
+
+	var errs []error
+
+	removed := make([]entity.Backup, 0)
+	backups := make([]entity.Backup, 0, len(k.backups))
+
+	for _, b := range k.backups {
+		if b.ClusterID != cluster.ID || b.InfobaseID != infobase.ID || keep[b.Path] {
+			backups = append(backups, b)
+
+			continue
+		}
+
+		err := os.Remove(filepath.Join(k.root, filepath.FromSlash(b.Path)))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, err)
+			backups = append(backups, b)
+
+			continue
+		}
+
+		removed = append(removed, b)
+	}
+
+	err := k.save(backups)
+	if err != nil {
+		return nil, fmt.Errorf("ctrlcatalog - prune - k.save: %w", err)
+	}
+
+	k.backups = backups
+
+	if len(errs) > 0 {
+		return removed, fmt.Errorf("ctrlcatalog - prune - os.Remove: %w", errors.Join(errs...))
+	}
+
+	return removed, nil
+}
+
+// rel - path of dump relative to root in slash form.
+func (k *CtrlCatalog) rel(path string) (string, error) {
+	rel, err := filepath.Rel(k.root, filepath.Clean(path))
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s: %w", path, ErrOutsideRoot)
+	}
+
+	return filepath.ToSlash(rel), nil
+}
+
+// save - writing index through temporary file so it is never left half written.
+func (k *CtrlCatalog) save(backups []entity.Backup) error {
+	data, err := json.MarshalIndent(index{Backups: backups}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("json.MarshalIndent: %w", err)
+	}
+
+	path := filepath.Join(k.root, _indexFile)
+
+	err = os.WriteFile(path+".tmp", data, 0644)
+	if err != nil {
+		return fmt.Errorf("os.WriteFile: %w", err)
+	}
+
+	err = os.Rename(path+".tmp", path)
+	if err != nil {
+		return fmt.Errorf("os.Rename: %w", err)
+	}
+
+	return nil
+}
+
+// retain - choosing dumps kept by policy, backups are sorted from newest to oldest.
+// Last KeepLast dumps are kept, then newest dump of every day for KeepDaily days
+// and newest dump of every week for KeepWeekly weeks.
+func retain(backups []entity.Backup, policy entity.RetentionPolicy, now time.Time) map[string]bool {
+	keep := make(map[string]bool, len(backups))
+
+	days := make(map[string]bool)
+	weeks := make(map[string]bool)
+
+	dailyFrom := now.AddDate(0, 0, -policy.KeepDaily)
+	weeklyFrom := now.AddDate(0, 0, -7*policy.KeepWeekly)
+
+	for i, b := range backups {
+		if i < policy.KeepLast {
+			keep[b.Path] = true
+		}
+
+		if policy.KeepDaily > 0 && b.Created.After(dailyFrom) {
+			day := b.Created.Format(_formatDay)
+
+			if !days[day] {
+				days[day], keep[b.Path] = true, true
+			}
+		}
+
+		if policy.KeepWeekly > 0 && b.Created.After(weeklyFrom) {
+			year, week := b.Created.ISOWeek()
+			key := fmt.Sprintf("%d-%d", year, week)
+
+			if !weeks[key] {
+				weeks[key], keep[b.Path] = true, true
+			}
+		}
+	}
+
+	return keep
+}
+
+func checksum(path string) (int64, string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, "", fmt.Errorf("os.Open: %w", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return 0, "", fmt.Errorf("io.Copy: %w", err)
+	}
+
+	return size, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// sanitize - making name usable as directory on Windows and Linux.
+func sanitize(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`<>:"/\|?*`, r) || r < ' ' {
+			return '_'
+		}
+
+		return r
+	}, strings.TrimSpace(name))
+
+	if name == "" || name == "." || name == ".." {
+		return "_"
+	}
+
+	return name
+}
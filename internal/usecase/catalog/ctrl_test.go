@@ -0,0 +1,203 @@
+package catalog
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+func TestPath(t *testing.T) {
+	t.Parallel()
+
+	k, err := New(t.TempDir())
+	require.NoError(t, err)
+
+	created := time.Date(2023, 8, 10, 14, 4, 43, 0, time.Local)
+
+	path, err := k.Path(context.Background(), entity.Cluster{Host: "1capp01", Port: "1541"}, entity.Infobase{Name: "buh:3.0/main"}, created)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(k.root, "1capp01_1541", "buh_3.0_main", "20230810_140443.dt"), path)
+
+	info, err := os.Stat(filepath.Dir(path))
+	require.NoError(t, err)
+	require.True(t, info.IsDir())
+}
+
+func TestPathSameSecond(t *testing.T) {
+	t.Parallel()
+
+	k, err := New(t.TempDir())
+	require.NoError(t, err)
+
+	cluster := entity.Cluster{Host: "1capp01", Port: "1541"}
+	infobase := entity.Infobase{Name: "buh"}
+	created := time.Date(2023, 8, 10, 14, 4, 43, 0, time.Local)
+	dir := filepath.Join(k.root, "1capp01_1541", "buh")
+
+	scheduled, err := k.Path(context.Background(), cluster, infobase, created)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "20230810_140443.dt"), scheduled)
+
+	manual, err := k.Path(context.Background(), cluster, infobase, created)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "20230810_140443_1.dt"), manual)
+
+	require.NoError(t, os.WriteFile(scheduled, []byte("dump"), 0o600))
+
+	_, err = k.Add(context.Background(), entity.Backup{Path: scheduled, Created: created})
+	require.NoError(t, err)
+
+	next, err := k.Path(context.Background(), cluster, infobase, created)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "20230810_140443_2.dt"), next)
+}
+
+func TestAdd(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+
+	k, err := New(root)
+	require.NoError(t, err)
+
+	cluster := entity.Cluster{ID: "1234-5678", Host: "1capp01", Port: "1541"}
+	infobase := entity.Infobase{ID: "8765-4321", Name: "ib"}
+	created := time.Date(2023, 8, 10, 14, 4, 43, 0, time.UTC)
+
+	path, err := k.Path(context.Background(), cluster, infobase, created)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, []byte("dump"), 0o600))
+
+	backup, err := k.Add(context.Background(), entity.Backup{
+		Path:       path,
+		ClusterID:  cluster.ID,
+		InfobaseID: infobase.ID,
+		Created:    created,
+		Duration:   1.5,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "1capp01_1541/ib/20230810_140443.dt", backup.Path)
+	require.Equal(t, int64(4), backup.Size)
+	require.Equal(t, "b6ca0868bca6a2926b70aa1a71592038d9030fe26d4214edcfbd6cf41f2f4654", backup.Checksum)
+
+	_, err = k.Add(context.Background(), entity.Backup{Path: filepath.Join(filepath.Dir(root), "outside.dt")})
+	require.ErrorIs(t, err, ErrOutsideRoot)
+
+	// Index is read again by new catalog
+	k, err = New(root)
+	require.NoError(t, err)
+
+	backups, err := k.List(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []entity.Backup{backup}, backups)
+}
+
+func TestPrune(t *testing.T) {
+	t.Parallel()
+
+	k, err := New(t.TempDir())
+	require.NoError(t, err)
+
+	cluster := entity.Cluster{ID: "1234-5678", Host: "1capp01", Port: "1541"}
+	infobase := entity.Infobase{ID: "8765-4321", Name: "ib"}
+	other := entity.Infobase{ID: "1111-2222", Name: "other"}
+
+	now := time.Now()
+
+	for i := 0; i < 5; i++ {
+		for _, ib := range []entity.Infobase{infobase, other} {
+			created := now.Add(-time.Duration(i) * time.Minute)
+
+			path, err := k.Path(context.Background(), cluster, ib, created)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(path, []byte("dump"), 0o600))
+
+			_, err = k.Add(context.Background(), entity.Backup{Path: path, ClusterID: cluster.ID, InfobaseID: ib.ID, Created: created})
+			require.NoError(t, err)
+		}
+	}
+
+	removed, err := k.Prune(context.Background(), cluster, infobase, entity.RetentionPolicy{})
+	require.NoError(t, err)
+	require.Empty(t, removed)
+
+	removed, err = k.Prune(context.Background(), cluster, infobase, entity.RetentionPolicy{KeepLast: 2})
+	require.NoError(t, err)
+	require.Len(t, removed, 3)
+
+	for _, b := range removed {
+		require.Equal(t, infobase.ID, b.InfobaseID)
+
+		_, err = os.Stat(filepath.Join(k.root, filepath.FromSlash(b.Path)))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	}
+
+	backups, err := k.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, backups, 7)
+}
+
+func TestRetain(t *testing.T) {
+	now := time.Date(2023, 8, 10, 14, 0, 0, 0, time.UTC)
+
+	// Two dumps a day for 60 days from newest to oldest
+	backups := make([]entity.Backup, 0, 120)
+
+	for i := 0; i < 120; i++ {
+		created := now.Add(-time.Duration(i) * 12 * time.Hour)
+		backups = append(backups, entity.Backup{Path: created.Format(_formatTime), Created: created})
+	}
+
+	cases := []struct {
+		name   string
+		policy entity.RetentionPolicy
+		kept   []string
+	}{
+		{
+			name:   "Keep last",
+			policy: entity.RetentionPolicy{KeepLast: 3},
+			kept:   []string{"20230810_140000", "20230810_020000", "20230809_140000"},
+		},
+		{
+			name:   "Keep daily",
+			policy: entity.RetentionPolicy{KeepDaily: 3},
+			kept:   []string{"20230810_140000", "20230809_140000", "20230808_140000"},
+		},
+		{
+			name:   "Keep weekly",
+			policy: entity.RetentionPolicy{KeepWeekly: 2},
+			kept:   []string{"20230810_140000", "20230806_140000", "20230730_140000"},
+		},
+		{
+			name:   "Keep last and daily",
+			policy: entity.RetentionPolicy{KeepLast: 2, KeepDaily: 2},
+			kept:   []string{"20230810_140000", "20230810_020000", "20230809_140000"},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			keep := retain(backups, tc.policy, now)
+
+			kept := make([]string, 0, len(keep))
+
+			for _, b := range backups {
+				if keep[b.Path] {
+					kept = append(kept, b.Path)
+				}
+			}
+
+			require.Equal(t, tc.kept, kept)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+)
+
+// UpdateCluster - changing settings of cluster, cluster list is read again from 1C for returning new settings.
+func (c *CtrlUseCase) UpdateCluster(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.ClusterUpdateOptions) (entity.Cluster, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("UpdateCluster in 1C")
+
+	err := c.pipe.UpdateCluster(ctx, entrypoint, cluster, clusterCred, opts)
+	if err != nil {
+		return entity.Cluster{}, fmt.Errorf("CtrlUseCase - UpdateCluster - c.pipe.UpdateCluster: %w", err)
+	}
+
+	clusters, err := c.Clusters(ctx, entrypoint, map[string]any{common.UseCache: false})
+	if err != nil {
+		return entity.Cluster{}, fmt.Errorf("CtrlUseCase - UpdateCluster - c.Clusters: %w", err)
+	}
+
+	for _, cl := range clusters {
+		if cl.ID == cluster.ID {
+			return cl, nil
+		}
+	}
+
+	return entity.Cluster{}, fmt.Errorf("CtrlUseCase - UpdateCluster: %s: %w", cluster.ID, ErrClusterNotFound)
+}
+
+// InsertCluster - registering cluster on agent, cluster list is read again from 1C for returning settings of new cluster.
+func (c *CtrlUseCase) InsertCluster(ctx context.Context, entrypoint string, agentCred entity.Credentials, opts entity.ClusterInsertOptions) (entity.Cluster, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("InsertCluster in 1C")
+
+	cluster, err := c.pipe.InsertCluster(ctx, entrypoint, agentCred, opts)
+	if err != nil {
+		return entity.Cluster{}, fmt.Errorf("CtrlUseCase - InsertCluster - c.pipe.InsertCluster: %w", err)
+	}
+
+	clusters, err := c.Clusters(ctx, entrypoint, map[string]any{common.UseCache: false})
+	if err != nil {
+		return entity.Cluster{}, fmt.Errorf("CtrlUseCase - InsertCluster - c.Clusters: %w", err)
+	}
+
+	for _, cl := range clusters {
+		if cl.ID == cluster.ID {
+			return cl, nil
+		}
+	}
+
+	return entity.Cluster{}, fmt.Errorf("CtrlUseCase - InsertCluster: %s: %w", cluster.ID, ErrClusterNotFound)
+}
+
+// RemoveCluster - unregistering cluster from agent.
+func (c *CtrlUseCase) RemoveCluster(ctx context.Context, entrypoint string, agentCred entity.Credentials, cluster entity.Cluster, clusterCred entity.Credentials) error {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("RemoveCluster in 1C")
+
+	err := c.pipe.RemoveCluster(ctx, entrypoint, agentCred, cluster, clusterCred)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - RemoveCluster - c.pipe.RemoveCluster: %w", err)
+	}
+
+	span.AddEvent("DeleteClusters from cache")
+
+	err = c.cache.DeleteClusters(ctx, entrypoint)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - RemoveCluster - c.cache.DeleteClusters: %w", err)
+	}
+
+	return nil
+}
+
+// ClusterAdmins - getting administrators of cluster.
+func (c *CtrlUseCase) ClusterAdmins(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Admin, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("GetClusterAdmins from 1C")
+
+	admins, err := c.pipe.GetClusterAdmins(ctx, entrypoint, cluster, clusterCred)
+	if err != nil {
+		return nil, fmt.Errorf("CtrlUseCase - ClusterAdmins - c.pipe.GetClusterAdmins: %w", err)
+	}
+
+	return admins, nil
+}
+
+// RegisterClusterAdmin - adding administrator to cluster.
+func (c *CtrlUseCase) RegisterClusterAdmin(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.AdminOptions) error {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("RegisterClusterAdmin in 1C")
+
+	err := c.pipe.RegisterClusterAdmin(ctx, entrypoint, cluster, clusterCred, opts)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - RegisterClusterAdmin - c.pipe.RegisterClusterAdmin: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveClusterAdmin - deleting administrator of cluster.
+func (c *CtrlUseCase) RemoveClusterAdmin(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) error {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("RemoveClusterAdmin in 1C")
+
+	err := c.pipe.RemoveClusterAdmin(ctx, entrypoint, cluster, clusterCred, name)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - RemoveClusterAdmin - c.pipe.RemoveClusterAdmin: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+)
+
+func TestUpdateCluster(t *testing.T) {
+	name := "Main cluster"
+	opts := entity.ClusterUpdateOptions{Name: &name}
+
+	cases := []struct {
+		name      string
+		updateErr error
+		clusters  []entity.Cluster
+		res       entity.Cluster
+		errIs     error
+		isErr     bool
+	}{
+		{
+			name:     "Success",
+			clusters: []entity.Cluster{{ID: "1111"}, {ID: "1234-5678", Name: "main cluster"}},
+			res:      entity.Cluster{ID: "1234-5678", Name: "main cluster"},
+		},
+		{
+			name:      "Error update",
+			updateErr: errors.New("rac error"),
+			isErr:     true,
+		},
+		{
+			name:     "Error cluster not found",
+			clusters: []entity.Cluster{{ID: "1111"}},
+			errIs:    usecase.ErrClusterNotFound,
+			isErr:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cacheMock := ucm.NewCtrlCache(t)
+			pipeMock := ucm.NewCtrlPipe(t)
+
+			pipeMock.On("UpdateCluster", mock.Anything, "1capp01:1545", entity.Cluster{ID: "1234-5678"}, mock.Anything, opts).
+				Return(tc.updateErr)
+
+			if tc.updateErr == nil {
+				pipeMock.On("GetClusters", mock.Anything, "1capp01:1545").
+					Return(tc.clusters, nil)
+
+				cacheMock.On("PutClusters", mock.Anything, "1capp01:1545", tc.clusters).
+					Return(nil)
+			}
+
+			c := usecase.New(cacheMock, pipeMock, ucm.NewCtrlBackup(t), ucm.NewCtrlJobs(t), ucm.NewCtrlCatalog(t))
+
+			cluster, err := c.UpdateCluster(context.Background(), "1capp01:1545", entity.Cluster{ID: "1234-5678"}, entity.Credentials{}, opts)
+
+			if tc.isErr {
+				require.Error(t, err)
+
+				if tc.errIs != nil {
+					require.ErrorIs(t, err, tc.errIs)
+				}
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.res, cluster)
+		})
+	}
+}
+
+func TestInsertCluster(t *testing.T) {
+	t.Parallel()
+
+	opts := entity.ClusterInsertOptions{Host: "1capp01", Port: 1541}
+	clusters := []entity.Cluster{{ID: "1111"}, {ID: "3333-4444", Host: "1capp01", Port: "1541"}}
+
+	cacheMock := ucm.NewCtrlCache(t)
+	pipeMock := ucm.NewCtrlPipe(t)
+
+	pipeMock.On("InsertCluster", mock.Anything, "1capp01:1545", entity.Credentials{Name: "agent"}, opts).
+		Return(entity.Cluster{ID: "3333-4444"}, nil)
+
+	pipeMock.On("GetClusters", mock.Anything, "1capp01:1545").
+		Return(clusters, nil)
+
+	cacheMock.On("PutClusters", mock.Anything, "1capp01:1545", clusters).
+		Return(nil)
+
+	c := usecase.New(cacheMock, pipeMock, ucm.NewCtrlBackup(t), ucm.NewCtrlJobs(t), ucm.NewCtrlCatalog(t))
+
+	cluster, err := c.InsertCluster(context.Background(), "1capp01:1545", entity.Credentials{Name: "agent"}, opts)
+
+	require.NoError(t, err)
+	require.Equal(t, clusters[1], cluster)
+}
+
+func TestRemoveCluster(t *testing.T) {
+	cases := []struct {
+		name      string
+		removeErr error
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name:      "Error remove",
+			removeErr: errors.New("rac error"),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cacheMock := ucm.NewCtrlCache(t)
+			pipeMock := ucm.NewCtrlPipe(t)
+
+			pipeMock.On("RemoveCluster", mock.Anything, "1capp01:1545", entity.Credentials{}, entity.Cluster{ID: "1234-5678"}, entity.Credentials{}).
+				Return(tc.removeErr)
+
+			if tc.removeErr == nil {
+				cacheMock.On("DeleteClusters", mock.Anything, "1capp01:1545").
+					Return(nil)
+			}
+
+			c := usecase.New(cacheMock, pipeMock, ucm.NewCtrlBackup(t), ucm.NewCtrlJobs(t), ucm.NewCtrlCatalog(t))
+
+			err := c.RemoveCluster(context.Background(), "1capp01:1545", entity.Credentials{}, entity.Cluster{ID: "1234-5678"}, entity.Credentials{})
+
+			if tc.removeErr != nil {
+				require.ErrorIs(t, err, tc.removeErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,7 +1,23 @@
 package common
 
 const (
-	UseCache    string = "usecache"
-	Entrypoint  string = "entrypoint"
-	ClusterCred string = "clustercred"
+	UseCache     string = "usecache"
+	Entrypoint   string = "entrypoint"
+	ClusterCred  string = "clustercred"
+	InfobaseCred string = "infobasecred"
+	AgentCred    string = "agentcred"
+
+	// DefaultClusterCred - credentials of entrypoint used when request has no cluster credentials.
+	DefaultClusterCred string = "defaultclustercred"
+
+	// Subject and Role - name of api key or subject of token and its role.
+	Subject string = "subject"
+	Role    string = "role"
+
+	// Scope - grants of scoped principal, EntrypointName - name of entrypoint in registry.
+	Scope          string = "scope"
+	EntrypointName string = "entrypointname"
+
+	// Vault - store of credentials used when request has none.
+	Vault string = "vault"
 )
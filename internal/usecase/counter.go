@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+// Counters - getting resource consumption counters of cluster.
+func (c *CtrlUseCase) Counters(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Counter, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("GetCounters from 1C")
+
+	counters, err := c.pipe.GetCounters(ctx, entrypoint, cluster, clusterCred)
+	if err != nil {
+		return nil, fmt.Errorf("CtrlUseCase - Counters - c.pipe.GetCounters: %w", err)
+	}
+
+	return counters, nil
+}
+
+// CounterValues - getting accumulated values of counter, they are never cached.
+func (c *CtrlUseCase) CounterValues(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, counter string, object string) ([]entity.CounterValue, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("GetCounterValues from 1C")
+
+	values, err := c.pipe.GetCounterValues(ctx, entrypoint, cluster, clusterCred, counter, object)
+	if err != nil {
+		return nil, fmt.Errorf("CtrlUseCase - CounterValues - c.pipe.GetCounterValues: %w", err)
+	}
+
+	return values, nil
+}
+
+// ClearCounter - resetting accumulated values of counter.
+func (c *CtrlUseCase) ClearCounter(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, counter string, object string) error {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("ClearCounter in 1C")
+
+	err := c.pipe.ClearCounter(ctx, entrypoint, cluster, clusterCred, counter, object)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - ClearCounter - c.pipe.ClearCounter: %w", err)
+	}
+
+	return nil
+}
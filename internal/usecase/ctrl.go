@@ -6,28 +6,70 @@ import (
 	"fmt"
 	"go.opentelemetry.io/otel/trace"
 	"reflect"
+	"time"
 
 	"github.com/antonmisa/1cctl/internal/entity"
 	"github.com/antonmisa/1cctl/internal/usecase/cache"
 	"github.com/antonmisa/1cctl/internal/usecase/common"
 )
 
+var (
+	ErrConnectionNotFound = errors.New("connection not found")
+	ErrLockWindowInvalid  = errors.New("denied-to is before denied-from")
+	ErrClusterNotFound    = errors.New("cluster not found")
+	ErrInfobaseNotFound   = errors.New("infobase not found")
+	ErrJobNotFound        = errors.New("job not found")
+	ErrJobQueueFull       = errors.New("job queue is full")
+)
+
+const (
+	_jobBackup string = "backup"
+
+	_defaultKickRetries  int           = 3
+	_defaultKickInterval time.Duration = 10 * time.Second
+	_defaultLockWindow   time.Duration = 12 * time.Hour
+)
+
 // CtrlUseCase -.
 type CtrlUseCase struct {
-	cache  CtrlCache
-	pipe   CtrlPipe
-	backup CtrlBackup
+	cache   CtrlCache
+	pipe    CtrlPipe
+	backup  CtrlBackup
+	jobs    CtrlJobs
+	catalog CtrlCatalog
+
+	backupDir string
+	retention entity.RetentionPolicy
+	lockCode  string
+
+	gracePeriod  time.Duration
+	kickRetries  int
+	kickInterval time.Duration
+	lockWindow   time.Duration
 }
 
 var _ Ctrl = (*CtrlUseCase)(nil)
 
 // New -.
-func New(c CtrlCache, p CtrlPipe, b CtrlBackup) *CtrlUseCase {
-	return &CtrlUseCase{
-		cache:  c,
-		pipe:   p,
-		backup: b,
+func New(c CtrlCache, p CtrlPipe, b CtrlBackup, j CtrlJobs, k CtrlCatalog, opts ...Option) *CtrlUseCase {
+	uc := &CtrlUseCase{
+		cache:   c,
+		pipe:    p,
+		backup:  b,
+		jobs:    j,
+		catalog: k,
+
+		kickRetries:  _defaultKickRetries,
+		kickInterval: _defaultKickInterval,
+		lockWindow:   _defaultLockWindow,
+	}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(uc)
 	}
+
+	return uc
 }
 
 // Clusters - getting clusters list in cache.
@@ -161,3 +203,231 @@ func (c *CtrlUseCase) Connections(ctx context.Context, entrypoint string, cluste
 
 	return connections, nil
 }
+
+// DeleteSession - terminating session in cluster.
+func (c *CtrlUseCase) DeleteSession(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, session entity.Session) error {
+	return c.DeleteSessions(ctx, entrypoint, cluster, clusterCred, []entity.Session{session})
+}
+
+// DeleteSessions - terminating sessions in cluster, cached sessions are dropped even on partial failure.
+func (c *CtrlUseCase) DeleteSessions(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, sessions []entity.Session) error {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("DeleteSessions in 1C")
+
+	errp := c.pipe.DeleteSessions(ctx, entrypoint, cluster, sessions, clusterCred)
+
+	span.AddEvent("DeleteSessions from cache")
+
+	err := c.cache.DeleteSessions(ctx, entrypoint, cluster)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - DeleteSessions - c.cache.DeleteSessions: %w", err)
+	}
+
+	if errp != nil {
+		return fmt.Errorf("CtrlUseCase - DeleteSessions - c.pipe.DeleteSessions: %w", errp)
+	}
+
+	return nil
+}
+
+// DeleteConnection - disconnecting connection in cluster.
+func (c *CtrlUseCase) DeleteConnection(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, connection entity.Connection) error {
+	return c.DeleteConnections(ctx, entrypoint, cluster, clusterCred, []entity.Connection{connection})
+}
+
+// DeleteConnections - disconnecting connections in cluster, cached connections are dropped even on partial failure.
+func (c *CtrlUseCase) DeleteConnections(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, connections []entity.Connection) error {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("resolve processes of connections")
+
+	connections, err := c.resolveConnections(ctx, entrypoint, cluster, clusterCred, connections)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - DeleteConnections - c.resolveConnections: %w", err)
+	}
+
+	span.AddEvent("DeleteConnections in 1C")
+
+	errp := c.pipe.DeleteConnections(ctx, entrypoint, cluster, connections, clusterCred)
+
+	span.AddEvent("DeleteConnections from cache")
+
+	err = c.cache.DeleteConnections(ctx, entrypoint, cluster)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - DeleteConnections - c.cache.DeleteConnections: %w", err)
+	}
+
+	if errp != nil {
+		return fmt.Errorf("CtrlUseCase - DeleteConnections - c.pipe.DeleteConnections: %w", errp)
+	}
+
+	return nil
+}
+
+// resolveConnections - rac needs process of connection to disconnect it, so it is taken from 1C if unknown.
+func (c *CtrlUseCase) resolveConnections(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, connections []entity.Connection) ([]entity.Connection, error) {
+	resolved := true
+
+	for i := range connections {
+		if connections[i].ProcessID == "" {
+			resolved = false
+
+			break
+		}
+	}
+
+	if resolved {
+		return connections, nil
+	}
+
+	existing, err := c.pipe.GetConnections(ctx, entrypoint, cluster, entity.Infobase{}, clusterCred)
+	if err != nil {
+		return nil, fmt.Errorf("c.pipe.GetConnections: %w", err)
+	}
+
+	byID := make(map[string]entity.Connection, len(existing))
+	for _, conn := range existing {
+		byID[conn.ID] = conn
+	}
+
+	rv := make([]entity.Connection, 0, len(connections))
+
+	for _, conn := range connections {
+		if conn.ProcessID == "" {
+			found, ok := byID[conn.ID]
+			if !ok {
+				return nil, fmt.Errorf("%s: %w", conn.ID, ErrConnectionNotFound)
+			}
+
+			conn = found
+		}
+
+		rv = append(rv, conn)
+	}
+
+	return rv, nil
+}
+
+// LockInfobase - denying new sessions to infobase.
+func (c *CtrlUseCase) LockInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, opts entity.LockOptions) error {
+	span := trace.SpanFromContext(ctx)
+
+	if opts.DeniedFrom.IsZero() {
+		opts.DeniedFrom = time.Now()
+	}
+
+	if !opts.DeniedTo.IsZero() && opts.DeniedTo.Before(opts.DeniedFrom) {
+		return fmt.Errorf("CtrlUseCase - LockInfobase: %w", ErrLockWindowInvalid)
+	}
+
+	span.AddEvent("DisableSessions in 1C")
+
+	err := c.pipe.DisableSessions(ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred, opts)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - LockInfobase - c.pipe.DisableSessions: %w", err)
+	}
+
+	return nil
+}
+
+// UnlockInfobase - allowing sessions to infobase again.
+func (c *CtrlUseCase) UnlockInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, code string) error {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("EnableSessions in 1C")
+
+	err := c.pipe.EnableSessions(ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred, code)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - UnlockInfobase - c.pipe.EnableSessions: %w", err)
+	}
+
+	return nil
+}
+
+// Backup - enqueueing dump of infobase, empty lock code means default one.
+func (c *CtrlUseCase) Backup(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, opts entity.BackupOptions) (entity.Job, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("resolve cluster and infobase")
+
+	cluster, infobase, err := c.resolveInfobase(ctx, entrypoint, cluster, clusterCred, infobase)
+	if err != nil {
+		return entity.Job{}, fmt.Errorf("CtrlUseCase - Backup - c.resolveInfobase: %w", err)
+	}
+
+	if opts.LockCode == "" {
+		opts.LockCode = c.lockCode
+	}
+
+	outputPath, err := c.catalog.Path(ctx, cluster, infobase, time.Now())
+	if err != nil {
+		return entity.Job{}, fmt.Errorf("CtrlUseCase - Backup - c.catalog.Path: %w", err)
+	}
+
+	span.AddEvent("enqueue backup job")
+
+	job, err := c.jobs.Enqueue(ctx, entity.Job{Kind: _jobBackup, OutputPath: outputPath},
+		func(ctx context.Context, update func(change func(job *entity.Job))) error {
+			err := c.dump(ctx, entrypoint, cluster, infobase, infobaseCred, opts.LockCode, outputPath, opts.Retention, update)
+			if err != nil {
+				return fmt.Errorf("CtrlUseCase - Backup - c.dump: %w", err)
+			}
+
+			return nil
+		})
+	if err != nil {
+		return entity.Job{}, fmt.Errorf("CtrlUseCase - Backup - c.jobs.Enqueue: %w", err)
+	}
+
+	return job, nil
+}
+
+// Job - getting state of background job.
+func (c *CtrlUseCase) Job(ctx context.Context, id string) (entity.Job, error) {
+	job, err := c.jobs.Get(ctx, id)
+	if err != nil {
+		return entity.Job{}, fmt.Errorf("CtrlUseCase - Job - c.jobs.Get: %w", err)
+	}
+
+	return job, nil
+}
+
+// resolveInfobase - filling host and port of cluster and name of infobase known by identifiers only.
+func (c *CtrlUseCase) resolveInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase) (entity.Cluster, entity.Infobase, error) {
+	args := map[string]any{
+		common.UseCache: true,
+	}
+
+	clusters, err := c.Clusters(ctx, entrypoint, args)
+	if err != nil {
+		return cluster, infobase, fmt.Errorf("c.Clusters: %w", err)
+	}
+
+	found := false
+
+	for _, cl := range clusters {
+		if cl.ID == cluster.ID {
+			cluster, found = cl, true
+
+			break
+		}
+	}
+
+	if !found {
+		return cluster, infobase, fmt.Errorf("%s: %w", cluster.ID, ErrClusterNotFound)
+	}
+
+	infobases, err := c.Infobases(ctx, entrypoint, cluster, clusterCred, args)
+	if err != nil {
+		return cluster, infobase, fmt.Errorf("c.Infobases: %w", err)
+	}
+
+	for _, ib := range infobases {
+		if ib.ID == infobase.ID {
+			return cluster, ib, nil
+		}
+	}
+
+	return cluster, infobase, fmt.Errorf("%s: %w", infobase.ID, ErrInfobaseNotFound)
+}
@@ -0,0 +1,207 @@
+// Package fleet aggregates views of all ras entrypoints of registry.
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	uc "github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+)
+
+// CtrlFleet - fan out of requests to all entrypoints concurrently,
+// entrypoints and clusters which failed are reported along with data of other ones.
+type CtrlFleet struct {
+	ctrl    uc.Ctrl
+	reg     uc.CtrlRegistry
+	vault   uc.CtrlVault
+	timeout time.Duration
+}
+
+var _ uc.CtrlFleet = (*CtrlFleet)(nil)
+
+// New - timeout is applied to each entrypoint, without vault only credentials of registry are used.
+func New(ctrl uc.Ctrl, reg uc.CtrlRegistry, vault uc.CtrlVault, timeout time.Duration) *CtrlFleet {
+	return &CtrlFleet{
+		ctrl:    ctrl,
+		reg:     reg,
+		vault:   vault,
+		timeout: timeout,
+	}
+}
+
+// Sessions - sessions of all clusters of entrypoints.
+func (f *CtrlFleet) Sessions(ctx context.Context, filter entity.FleetFilter, args map[string]any) ([]entity.FleetSession, []entity.FleetError, error) {
+	return fanOut(ctx, f, filter, args, func(ctx context.Context, ep entity.Entrypoint, cluster entity.Cluster, cred entity.Credentials) ([]entity.FleetSession, error) {
+		infobases, err := f.ctrl.Infobases(ctx, ep.Address, cluster, cred, map[string]any{common.UseCache: true})
+		if err != nil {
+			return nil, fmt.Errorf("f.ctrl.Infobases: %w", err)
+		}
+
+		names := make(map[string]string, len(infobases))
+		for _, ib := range infobases {
+			names[ib.ID] = entity.TrimName(ib.Name)
+		}
+
+		sessions, err := f.ctrl.Sessions(ctx, ep.Address, cluster, cred, entity.Infobase{}, args)
+		if err != nil {
+			return nil, fmt.Errorf("f.ctrl.Sessions: %w", err)
+		}
+
+		result := make([]entity.FleetSession, 0, len(sessions))
+
+		for _, s := range sessions {
+			name := names[s.InfobaseID]
+
+			if !match(filter.Infobase, name) || !match(filter.UserName, s.UserName) {
+				continue
+			}
+
+			result = append(result, entity.FleetSession{
+				Entrypoint:   ep.Name,
+				Address:      ep.Address,
+				ClusterID:    cluster.ID,
+				InfobaseName: name,
+				Session:      s,
+			})
+		}
+
+		return result, nil
+	})
+}
+
+// Infobases - infobases of all clusters of entrypoints.
+func (f *CtrlFleet) Infobases(ctx context.Context, filter entity.FleetFilter, args map[string]any) ([]entity.FleetInfobase, []entity.FleetError, error) {
+	return fanOut(ctx, f, filter, args, func(ctx context.Context, ep entity.Entrypoint, cluster entity.Cluster, cred entity.Credentials) ([]entity.FleetInfobase, error) {
+		infobases, err := f.ctrl.Infobases(ctx, ep.Address, cluster, cred, args)
+		if err != nil {
+			return nil, fmt.Errorf("f.ctrl.Infobases: %w", err)
+		}
+
+		result := make([]entity.FleetInfobase, 0, len(infobases))
+
+		for _, ib := range infobases {
+			if !match(filter.Infobase, ib.Name) {
+				continue
+			}
+
+			result = append(result, entity.FleetInfobase{
+				Entrypoint: ep.Name,
+				Address:    ep.Address,
+				ClusterID:  cluster.ID,
+				Infobase:   ib,
+			})
+		}
+
+		return result, nil
+	})
+}
+
+type entrypointResult[T any] struct {
+	items []T
+	errs  []entity.FleetError
+}
+
+// fanOut - reading clusters of each entrypoint matching filter concurrently and calling fn for every cluster
+// with its credentials, results keep order of entrypoints in registry.
+func fanOut[T any](ctx context.Context, f *CtrlFleet, filter entity.FleetFilter, args map[string]any,
+	fn func(ctx context.Context, ep entity.Entrypoint, cluster entity.Cluster, cred entity.Credentials) ([]T, error)) ([]T, []entity.FleetError, error) {
+	entrypoints, err := f.reg.Entrypoints(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("ctrlfleet - fanOut - f.reg.Entrypoints: %w", err)
+	}
+
+	results := make([]entrypointResult[T], len(entrypoints))
+
+	var wg sync.WaitGroup
+
+	for i, ep := range entrypoints {
+		if !hasTag(ep, filter.Tag) {
+			continue
+		}
+
+		wg.Add(1)
+
+		go func(i int, ep entity.Entrypoint) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, f.timeout)
+			defer cancel()
+
+			clusters, err := f.ctrl.Clusters(ctx, ep.Address, args)
+			if err != nil {
+				results[i].errs = append(results[i].errs, entity.FleetError{
+					Entrypoint: ep.Name,
+					Address:    ep.Address,
+					Error:      fmt.Sprintf("f.ctrl.Clusters: %s", err),
+				})
+
+				return
+			}
+
+			for _, cluster := range clusters {
+				items, err := fn(ctx, ep, cluster, f.clusterCred(ctx, ep, cluster))
+				if err != nil {
+					results[i].errs = append(results[i].errs, entity.FleetError{
+						Entrypoint: ep.Name,
+						Address:    ep.Address,
+						ClusterID:  cluster.ID,
+						Error:      err.Error(),
+					})
+
+					continue
+				}
+
+				results[i].items = append(results[i].items, items...)
+			}
+		}(i, ep)
+	}
+
+	wg.Wait()
+
+	items := make([]T, 0)
+	errs := make([]entity.FleetError, 0)
+
+	for _, r := range results {
+		items = append(items, r.items...)
+		errs = append(errs, r.errs...)
+	}
+
+	return items, errs, nil
+}
+
+// clusterCred - credentials of cluster or entrypoint from vault come first, then default ones of registry,
+// as for requests to single entrypoint.
+func (f *CtrlFleet) clusterCred(ctx context.Context, ep entity.Entrypoint, cluster entity.Cluster) entity.Credentials {
+	if f.vault != nil {
+		if cred, ok := f.vault.ClusterCredentials(ctx, ep.Address, cluster.ID); ok && cred.Name != "" {
+			return cred
+		}
+	}
+
+	return ep.ClusterCred
+}
+
+// hasTag - empty tag matches any entrypoint.
+func hasTag(ep entity.Entrypoint, tag string) bool {
+	if tag == "" {
+		return true
+	}
+
+	for _, t := range ep.Tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// match - empty pattern matches any value, values of rac keep quotes.
+func match(pattern, value string) bool {
+	return pattern == "" || entity.SameName(value, pattern)
+}
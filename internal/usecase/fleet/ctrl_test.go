@@ -0,0 +1,186 @@
+package fleet
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+)
+
+var (
+	main = entity.Entrypoint{
+		Name:        "main",
+		Address:     "1capp01:1545",
+		Tags:        []string{"prod"},
+		ClusterCred: entity.Credentials{Name: "admin"},
+	}
+	reserve = entity.Entrypoint{
+		Name:    "reserve",
+		Address: "1capp02:1545",
+		Tags:    []string{"test"},
+	}
+)
+
+func newRegistryMock(t *testing.T) *ucm.CtrlRegistry {
+	regMock := ucm.NewCtrlRegistry(t)
+
+	regMock.On("Entrypoints", mock.Anything).
+		Return([]entity.Entrypoint{main, reserve}, nil)
+
+	return regMock
+}
+
+func TestSessions(t *testing.T) {
+	t.Parallel()
+
+	cluster := entity.Cluster{ID: "1234-5678"}
+	broken := entity.Cluster{ID: "8765-4321"}
+
+	ctrlMock := ucm.NewCtrl(t)
+
+	ctrlMock.On("Clusters", mock.Anything, main.Address, mock.Anything).
+		Return([]entity.Cluster{cluster, broken}, nil)
+
+	ctrlMock.On("Clusters", mock.Anything, reserve.Address, mock.Anything).
+		Return([]entity.Cluster(nil), errors.New("connection refused"))
+
+	ctrlMock.On("Infobases", mock.Anything, main.Address, cluster, main.ClusterCred, mock.Anything).
+		Return([]entity.Infobase{{ID: "ib-1", Name: "\"buh\""}, {ID: "ib-2", Name: "\"zup\""}}, nil)
+
+	ctrlMock.On("Infobases", mock.Anything, main.Address, broken, main.ClusterCred, mock.Anything).
+		Return([]entity.Infobase(nil), errors.New("access denied"))
+
+	ctrlMock.On("Sessions", mock.Anything, main.Address, cluster, main.ClusterCred, entity.Infobase{}, mock.Anything).
+		Return([]entity.Session{
+			{ID: "s-1", InfobaseID: "ib-1", UserName: "\"ivanov\""},
+			{ID: "s-2", InfobaseID: "ib-2", UserName: "\"ivanov\""},
+			{ID: "s-3", InfobaseID: "ib-1", UserName: "\"petrov\""},
+		}, nil)
+
+	f := New(ctrlMock, newRegistryMock(t), nil, time.Second)
+
+	sessions, errs, err := f.Sessions(context.Background(), entity.FleetFilter{UserName: "Ivanov"}, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []entity.FleetSession{
+		{Entrypoint: "main", Address: "1capp01:1545", ClusterID: "1234-5678", InfobaseName: "buh", Session: entity.Session{ID: "s-1", InfobaseID: "ib-1", UserName: "\"ivanov\""}},
+		{Entrypoint: "main", Address: "1capp01:1545", ClusterID: "1234-5678", InfobaseName: "zup", Session: entity.Session{ID: "s-2", InfobaseID: "ib-2", UserName: "\"ivanov\""}},
+	}, sessions)
+
+	require.Equal(t, []entity.FleetError{
+		{Entrypoint: "main", Address: "1capp01:1545", ClusterID: "8765-4321", Error: "f.ctrl.Infobases: access denied"},
+		{Entrypoint: "reserve", Address: "1capp02:1545", Error: "f.ctrl.Clusters: connection refused"},
+	}, errs)
+}
+
+func TestInfobases(t *testing.T) {
+	t.Parallel()
+
+	cluster := entity.Cluster{ID: "1234-5678"}
+
+	ctrlMock := ucm.NewCtrl(t)
+
+	ctrlMock.On("Clusters", mock.Anything, main.Address, mock.Anything).
+		Return([]entity.Cluster{cluster}, nil)
+
+	ctrlMock.On("Infobases", mock.Anything, main.Address, cluster, main.ClusterCred, mock.Anything).
+		Return([]entity.Infobase{{ID: "ib-1", Name: "\"buh\""}, {ID: "ib-2", Name: "\"zup\""}}, nil)
+
+	f := New(ctrlMock, newRegistryMock(t), nil, time.Second)
+
+	infobases, errs, err := f.Infobases(context.Background(), entity.FleetFilter{Tag: "PROD", Infobase: "zup"}, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []entity.FleetInfobase{
+		{Entrypoint: "main", Address: "1capp01:1545", ClusterID: "1234-5678", Infobase: entity.Infobase{ID: "ib-2", Name: "\"zup\""}},
+	}, infobases)
+	require.Empty(t, errs)
+}
+
+func TestVaultCredentials(t *testing.T) {
+	t.Parallel()
+
+	cluster := entity.Cluster{ID: "1234-5678"}
+	other := entity.Cluster{ID: "8765-4321"}
+	cred := entity.Credentials{Name: "vault", Pwd: "secret"}
+
+	ctrlMock := ucm.NewCtrl(t)
+
+	ctrlMock.On("Clusters", mock.Anything, main.Address, mock.Anything).
+		Return([]entity.Cluster{cluster, other}, nil)
+
+	ctrlMock.On("Clusters", mock.Anything, reserve.Address, mock.Anything).
+		Return([]entity.Cluster{}, nil)
+
+	ctrlMock.On("Infobases", mock.Anything, main.Address, cluster, cred, mock.Anything).
+		Return([]entity.Infobase{{ID: "ib-1", Name: "\"buh\""}}, nil)
+
+	// Cluster missing in vault falls back to credentials of registry.
+	ctrlMock.On("Infobases", mock.Anything, main.Address, other, main.ClusterCred, mock.Anything).
+		Return([]entity.Infobase{{ID: "ib-2", Name: "\"zup\""}}, nil)
+
+	vaultMock := ucm.NewCtrlVault(t)
+
+	vaultMock.On("ClusterCredentials", mock.Anything, main.Address, cluster.ID).
+		Return(cred, true)
+
+	vaultMock.On("ClusterCredentials", mock.Anything, main.Address, other.ID).
+		Return(entity.Credentials{}, false)
+
+	f := New(ctrlMock, newRegistryMock(t), vaultMock, time.Second)
+
+	infobases, errs, err := f.Infobases(context.Background(), entity.FleetFilter{}, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []entity.FleetInfobase{
+		{Entrypoint: "main", Address: "1capp01:1545", ClusterID: "1234-5678", Infobase: entity.Infobase{ID: "ib-1", Name: "\"buh\""}},
+		{Entrypoint: "main", Address: "1capp01:1545", ClusterID: "8765-4321", Infobase: entity.Infobase{ID: "ib-2", Name: "\"zup\""}},
+	}, infobases)
+	require.Empty(t, errs)
+}
+
+func TestTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctrlMock := ucm.NewCtrl(t)
+
+	ctrlMock.On("Clusters", mock.Anything, main.Address, mock.Anything).
+		Return(func(ctx context.Context, entrypoint string, args map[string]any) ([]entity.Cluster, error) {
+			<-ctx.Done()
+
+			return nil, ctx.Err()
+		})
+
+	ctrlMock.On("Clusters", mock.Anything, reserve.Address, mock.Anything).
+		Return([]entity.Cluster{}, nil)
+
+	f := New(ctrlMock, newRegistryMock(t), nil, 10*time.Millisecond)
+
+	infobases, errs, err := f.Infobases(context.Background(), entity.FleetFilter{}, nil)
+	require.NoError(t, err)
+
+	require.Empty(t, infobases)
+	require.Equal(t, []entity.FleetError{
+		{Entrypoint: "main", Address: "1capp01:1545", Error: "f.ctrl.Clusters: context deadline exceeded"},
+	}, errs)
+}
+
+func TestRegistryError(t *testing.T) {
+	t.Parallel()
+
+	regMock := ucm.NewCtrlRegistry(t)
+
+	regMock.On("Entrypoints", mock.Anything).
+		Return([]entity.Entrypoint(nil), errors.New("registry is broken"))
+
+	f := New(ucm.NewCtrl(t), regMock, nil, time.Second)
+
+	_, _, err := f.Sessions(context.Background(), entity.FleetFilter{}, nil)
+	require.Error(t, err)
+}
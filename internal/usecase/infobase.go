@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+var (
+	ErrDropNotConfirmed = errors.New("drop is not confirmed by name of infobase")
+	ErrDropModeInvalid  = errors.New("drop-database and clear-database are exclusive")
+)
+
+// InfobaseDetails - getting settings of infobase, they are never cached.
+func (c *CtrlUseCase) InfobaseDetails(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials) (entity.InfobaseDetails, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("GetInfobaseDetails from 1C")
+
+	details, err := c.infobaseDetails(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred)
+	if err != nil {
+		return details, fmt.Errorf("CtrlUseCase - InfobaseDetails - %w", err)
+	}
+
+	return details, nil
+}
+
+// UpdateInfobase - changing settings of infobase, cached infobase list is dropped as name and description may change.
+func (c *CtrlUseCase) UpdateInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, opts entity.InfobaseUpdateOptions) (entity.InfobaseDetails, error) {
+	span := trace.SpanFromContext(ctx)
+
+	if opts.DeniedFrom != nil && opts.DeniedTo != nil && opts.DeniedTo.Before(*opts.DeniedFrom) {
+		return entity.InfobaseDetails{}, fmt.Errorf("CtrlUseCase - UpdateInfobase: %w", ErrLockWindowInvalid)
+	}
+
+	span.AddEvent("UpdateInfobase in 1C")
+
+	err := c.pipe.UpdateInfobase(ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred, opts)
+	if err != nil {
+		return entity.InfobaseDetails{}, fmt.Errorf("CtrlUseCase - UpdateInfobase - c.pipe.UpdateInfobase: %w", err)
+	}
+
+	span.AddEvent("DeleteInfobases from cache")
+
+	err = c.cache.DeleteInfobases(ctx, entrypoint, cluster)
+	if err != nil {
+		return entity.InfobaseDetails{}, fmt.Errorf("CtrlUseCase - UpdateInfobase - c.cache.DeleteInfobases: %w", err)
+	}
+
+	span.AddEvent("GetInfobaseDetails from 1C")
+
+	details, err := c.infobaseDetails(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred)
+	if err != nil {
+		return details, fmt.Errorf("CtrlUseCase - UpdateInfobase - %w", err)
+	}
+
+	return details, nil
+}
+
+// CreateInfobase - registering new infobase in cluster.
+func (c *CtrlUseCase) CreateInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.InfobaseCreateOptions) (entity.Infobase, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("CreateInfobase in 1C")
+
+	infobase, err := c.pipe.CreateInfobase(ctx, entrypoint, cluster, clusterCred, opts)
+	if err != nil {
+		return entity.Infobase{}, fmt.Errorf("CtrlUseCase - CreateInfobase - c.pipe.CreateInfobase: %w", err)
+	}
+
+	span.AddEvent("DeleteInfobases from cache")
+
+	err = c.cache.DeleteInfobases(ctx, entrypoint, cluster)
+	if err != nil {
+		return entity.Infobase{}, fmt.Errorf("CtrlUseCase - CreateInfobase - c.cache.DeleteInfobases: %w", err)
+	}
+
+	return infobase, nil
+}
+
+// DropInfobase - removing infobase from cluster, it must be confirmed by name of infobase.
+func (c *CtrlUseCase) DropInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, opts entity.InfobaseDropOptions) error {
+	span := trace.SpanFromContext(ctx)
+
+	if opts.DropDatabase && opts.ClearDatabase {
+		return fmt.Errorf("CtrlUseCase - DropInfobase: %w", ErrDropModeInvalid)
+	}
+
+	span.AddEvent("resolve cluster and infobase")
+
+	cluster, infobase, err := c.resolveInfobase(ctx, entrypoint, cluster, clusterCred, infobase)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - DropInfobase - c.resolveInfobase: %w", err)
+	}
+
+	if !entity.SameName(opts.Confirm, infobase.Name) {
+		return fmt.Errorf("CtrlUseCase - DropInfobase: %s: %w", infobase.ID, ErrDropNotConfirmed)
+	}
+
+	span.AddEvent("DropInfobase in 1C")
+
+	err = c.pipe.DropInfobase(ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred, opts)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - DropInfobase - c.pipe.DropInfobase: %w", err)
+	}
+
+	span.AddEvent("DeleteInfobases from cache")
+
+	err = c.cache.DeleteInfobases(ctx, entrypoint, cluster)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - DropInfobase - c.cache.DeleteInfobases: %w", err)
+	}
+
+	return nil
+}
+
+func (c *CtrlUseCase) infobaseDetails(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials) (entity.InfobaseDetails, error) {
+	details, err := c.pipe.GetInfobaseDetails(ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred)
+	if err != nil {
+		return details, fmt.Errorf("c.pipe.GetInfobaseDetails: %w", err)
+	}
+
+	if details == (entity.InfobaseDetails{}) {
+		return details, fmt.Errorf("%s: %w", infobase.ID, ErrInfobaseNotFound)
+	}
+
+	return details, nil
+}
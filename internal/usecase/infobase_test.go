@@ -0,0 +1,176 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+)
+
+func TestUpdateInfobase(t *testing.T) {
+	cluster := entity.Cluster{ID: "1234-5678"}
+	infobase := entity.Infobase{ID: "8765-4321"}
+
+	desc := "new desc"
+	from := time.Date(2023, 8, 10, 22, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+
+	cases := []struct {
+		name      string
+		opts      entity.InfobaseUpdateOptions
+		updateErr error
+		details   entity.InfobaseDetails
+		errIs     error
+		isErr     bool
+	}{
+		{
+			name:    "Success",
+			opts:    entity.InfobaseUpdateOptions{Desc: &desc},
+			details: entity.InfobaseDetails{ID: infobase.ID, Desc: desc},
+		},
+		{
+			name:  "Error lock window",
+			opts:  entity.InfobaseUpdateOptions{DeniedFrom: &from, DeniedTo: &to},
+			errIs: usecase.ErrLockWindowInvalid,
+			isErr: true,
+		},
+		{
+			name:      "Error update",
+			opts:      entity.InfobaseUpdateOptions{Desc: &desc},
+			updateErr: errors.New("rac error"),
+			isErr:     true,
+		},
+		{
+			name:  "Error infobase not found",
+			opts:  entity.InfobaseUpdateOptions{Desc: &desc},
+			errIs: usecase.ErrInfobaseNotFound,
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cacheMock := ucm.NewCtrlCache(t)
+			pipeMock := ucm.NewCtrlPipe(t)
+
+			invalid := errors.Is(tc.errIs, usecase.ErrLockWindowInvalid)
+
+			if !invalid {
+				pipeMock.On("UpdateInfobase", mock.Anything, "1capp01:1545", cluster, infobase, mock.Anything, mock.Anything, tc.opts).
+					Return(tc.updateErr)
+			}
+
+			if !invalid && tc.updateErr == nil {
+				cacheMock.On("DeleteInfobases", mock.Anything, "1capp01:1545", cluster).
+					Return(nil)
+
+				pipeMock.On("GetInfobaseDetails", mock.Anything, "1capp01:1545", cluster, infobase, mock.Anything, mock.Anything).
+					Return(tc.details, nil)
+			}
+
+			c := usecase.New(cacheMock, pipeMock, ucm.NewCtrlBackup(t), ucm.NewCtrlJobs(t), ucm.NewCtrlCatalog(t))
+
+			details, err := c.UpdateInfobase(context.Background(), "1capp01:1545", cluster, entity.Credentials{}, infobase, entity.Credentials{}, tc.opts)
+
+			if tc.isErr {
+				require.Error(t, err)
+
+				if tc.errIs != nil {
+					require.ErrorIs(t, err, tc.errIs)
+				}
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.details, details)
+		})
+	}
+}
+
+func TestDropInfobase(t *testing.T) {
+	cluster := entity.Cluster{ID: "1234-5678"}
+
+	cases := []struct {
+		name   string
+		ibName string
+		opts   entity.InfobaseDropOptions
+		errIs  error
+	}{
+		{
+			name: "Success",
+			opts: entity.InfobaseDropOptions{Confirm: "buh_test", DropDatabase: true},
+		},
+		{
+			name:   "Success w quoted name",
+			ibName: `"buh test"`,
+			opts:   entity.InfobaseDropOptions{Confirm: "Buh Test", DropDatabase: true},
+		},
+		{
+			name:  "Error not confirmed",
+			opts:  entity.InfobaseDropOptions{Confirm: "buh"},
+			errIs: usecase.ErrDropNotConfirmed,
+		},
+		{
+			name:  "Error drop mode",
+			opts:  entity.InfobaseDropOptions{Confirm: "buh_test", DropDatabase: true, ClearDatabase: true},
+			errIs: usecase.ErrDropModeInvalid,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			infobase := entity.Infobase{ID: "8765-4321", Name: "buh_test"}
+			if tc.ibName != "" {
+				infobase.Name = tc.ibName
+			}
+
+			cacheMock := ucm.NewCtrlCache(t)
+
+			cacheMock.On("GetClusters", mock.Anything, "1capp01:1545").
+				Return([]entity.Cluster{cluster}, nil).
+				Maybe()
+
+			cacheMock.On("GetInfobases", mock.Anything, "1capp01:1545", cluster).
+				Return([]entity.Infobase{infobase}, nil).
+				Maybe()
+
+			pipeMock := ucm.NewCtrlPipe(t)
+
+			if tc.errIs == nil {
+				pipeMock.On("DropInfobase", mock.Anything, "1capp01:1545", cluster, infobase, mock.Anything, mock.Anything, tc.opts).
+					Return(nil)
+
+				cacheMock.On("DeleteInfobases", mock.Anything, "1capp01:1545", cluster).
+					Return(nil)
+			}
+
+			c := usecase.New(cacheMock, pipeMock, ucm.NewCtrlBackup(t), ucm.NewCtrlJobs(t), ucm.NewCtrlCatalog(t))
+
+			err := c.DropInfobase(context.Background(), "1capp01:1545", cluster, entity.Credentials{}, entity.Infobase{ID: infobase.ID}, entity.Credentials{}, tc.opts)
+
+			if tc.errIs != nil {
+				require.ErrorIs(t, err, tc.errIs)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/antonmisa/1cctl/internal/entity"
 )
@@ -13,27 +14,98 @@ type (
 	// Ctrl -.
 	Ctrl interface {
 		Clusters(ctx context.Context, entrypoint string, args map[string]any) ([]entity.Cluster, error)
+		UpdateCluster(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.ClusterUpdateOptions) (entity.Cluster, error)
+
+		ClusterAdmins(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Admin, error)
+		RegisterClusterAdmin(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.AdminOptions) error
+		RemoveClusterAdmin(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) error
+
+		InsertCluster(ctx context.Context, entrypoint string, agentCred entity.Credentials, opts entity.ClusterInsertOptions) (entity.Cluster, error)
+		RemoveCluster(ctx context.Context, entrypoint string, agentCred entity.Credentials, cluster entity.Cluster, clusterCred entity.Credentials) error
+
+		AgentAdmins(ctx context.Context, entrypoint string, agentCred entity.Credentials) ([]entity.Admin, error)
+		RegisterAgentAdmin(ctx context.Context, entrypoint string, agentCred entity.Credentials, opts entity.AdminOptions) error
+		RemoveAgentAdmin(ctx context.Context, entrypoint string, agentCred entity.Credentials, name string) error
+
+		Profiles(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Profile, error)
+		CreateProfile(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.ProfileOptions) (entity.Profile, error)
+		UpdateProfile(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.ProfileOptions) (entity.Profile, error)
+		RemoveProfile(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) error
+
+		Limits(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Limit, error)
+		CreateLimit(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.LimitOptions) (entity.Limit, error)
+		UpdateLimit(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.LimitOptions) (entity.Limit, error)
+		RemoveLimit(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) error
+
+		Counters(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Counter, error)
+		CounterValues(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, counter string, object string) ([]entity.CounterValue, error)
+		ClearCounter(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, counter string, object string) error
+
+		Rules(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server) ([]entity.Rule, error)
+		InsertRule(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, opts entity.RuleOptions) (entity.Rule, error)
+		UpdateRule(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, rule string, opts entity.RuleOptions) (entity.Rule, error)
+		RemoveRule(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, rule string) error
+		ApplyRules(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, full bool) error
 
 		Infobases(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, args map[string]any) ([]entity.Infobase, error)
+		InfobaseDetails(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials) (entity.InfobaseDetails, error)
+		UpdateInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, opts entity.InfobaseUpdateOptions) (entity.InfobaseDetails, error)
+		CreateInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.InfobaseCreateOptions) (entity.Infobase, error)
+		DropInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, opts entity.InfobaseDropOptions) error
 
 		Sessions(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, args map[string]any) ([]entity.Session, error)
 
 		Connections(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, args map[string]any) ([]entity.Connection, error)
+
+		Processes(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, args map[string]any) ([]entity.Process, error)
+		Servers(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, args map[string]any) ([]entity.Server, error)
+		Managers(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, args map[string]any) ([]entity.Manager, error)
+
+		Locks(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, filter entity.LockFilter, args map[string]any) ([]entity.Lock, error)
+
+		DeleteSession(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, session entity.Session) error
+		DeleteSessions(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, sessions []entity.Session) error
+
+		DeleteConnection(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, connection entity.Connection) error
+		DeleteConnections(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, connections []entity.Connection) error
+
+		LockInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, opts entity.LockOptions) error
+		UnlockInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, code string) error
+
+		Backup(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, opts entity.BackupOptions) (entity.Job, error)
+		SafeBackup(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, opts entity.SafeBackupOptions) (entity.Job, error)
+		Restore(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, opts entity.RestoreOptions) (entity.Job, error)
+		Job(ctx context.Context, id string) (entity.Job, error)
+
+		Backups(ctx context.Context, cluster entity.Cluster, infobase entity.Infobase) ([]entity.Backup, error)
 	}
 
 	// CtrlCache -.
 	CtrlCache interface {
 		GetClusters(ctx context.Context, entrypoint string) ([]entity.Cluster, error)
 		PutClusters(ctx context.Context, entrypoint string, clusters []entity.Cluster) error
+		DeleteClusters(ctx context.Context, entrypoint string) error
 
 		GetInfobases(ctx context.Context, entrypoint string, cluster entity.Cluster) ([]entity.Infobase, error)
 		PutInfobases(ctx context.Context, entrypoint string, cluster entity.Cluster, infobases []entity.Infobase) error
+		DeleteInfobases(ctx context.Context, entrypoint string, cluster entity.Cluster) error
 
 		GetSessions(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase) ([]entity.Session, error)
 		PutSessions(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, sessions []entity.Session) error
+		DeleteSessions(ctx context.Context, entrypoint string, cluster entity.Cluster) error
 
 		GetConnections(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase) ([]entity.Connection, error)
 		PutConnections(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, connections []entity.Connection) error
+		DeleteConnections(ctx context.Context, entrypoint string, cluster entity.Cluster) error
+
+		GetProcesses(ctx context.Context, entrypoint string, cluster entity.Cluster) ([]entity.Process, error)
+		PutProcesses(ctx context.Context, entrypoint string, cluster entity.Cluster, processes []entity.Process) error
+
+		GetServers(ctx context.Context, entrypoint string, cluster entity.Cluster) ([]entity.Server, error)
+		PutServers(ctx context.Context, entrypoint string, cluster entity.Cluster, servers []entity.Server) error
+
+		GetManagers(ctx context.Context, entrypoint string, cluster entity.Cluster) ([]entity.Manager, error)
+		PutManagers(ctx context.Context, entrypoint string, cluster entity.Cluster, managers []entity.Manager) error
 	}
 
 	// CtrlPipe -.
@@ -42,8 +114,46 @@ type (
 		GetInfobases(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Infobase, error)
 		GetSessions(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials) ([]entity.Session, error)
 		GetConnections(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials) ([]entity.Connection, error)
-
-		DisableSessions(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, infobaseCred entity.Credentials, code string) error
+		GetProcesses(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Process, error)
+		GetServers(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Server, error)
+		GetManagers(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Manager, error)
+		GetLocks(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, filter entity.LockFilter) ([]entity.Lock, error)
+
+		GetInfobaseDetails(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, infobaseCred entity.Credentials) (entity.InfobaseDetails, error)
+		UpdateInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, infobaseCred entity.Credentials, opts entity.InfobaseUpdateOptions) error
+		CreateInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.InfobaseCreateOptions) (entity.Infobase, error)
+		DropInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, infobaseCred entity.Credentials, opts entity.InfobaseDropOptions) error
+
+		UpdateCluster(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.ClusterUpdateOptions) error
+		GetClusterAdmins(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Admin, error)
+		RegisterClusterAdmin(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.AdminOptions) error
+		RemoveClusterAdmin(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) error
+		InsertCluster(ctx context.Context, entrypoint string, agentCred entity.Credentials, opts entity.ClusterInsertOptions) (entity.Cluster, error)
+		RemoveCluster(ctx context.Context, entrypoint string, agentCred entity.Credentials, cluster entity.Cluster, clusterCred entity.Credentials) error
+
+		GetAgentAdmins(ctx context.Context, entrypoint string, agentCred entity.Credentials) ([]entity.Admin, error)
+		RegisterAgentAdmin(ctx context.Context, entrypoint string, agentCred entity.Credentials, opts entity.AdminOptions) error
+		RemoveAgentAdmin(ctx context.Context, entrypoint string, agentCred entity.Credentials, name string) error
+
+		GetProfiles(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Profile, error)
+		UpdateProfile(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.ProfileOptions) error
+		RemoveProfile(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) error
+
+		GetLimits(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Limit, error)
+		UpdateLimit(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.LimitOptions) error
+		RemoveLimit(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) error
+
+		GetCounters(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Counter, error)
+		GetCounterValues(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, counter string, object string) ([]entity.CounterValue, error)
+		ClearCounter(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, counter string, object string) error
+
+		GetRules(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server) ([]entity.Rule, error)
+		InsertRule(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, opts entity.RuleOptions) (entity.Rule, error)
+		UpdateRule(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, rule string, opts entity.RuleOptions) error
+		RemoveRule(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, rule string) error
+		ApplyRules(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, full bool) error
+
+		DisableSessions(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, infobaseCred entity.Credentials, opts entity.LockOptions) error
 		EnableSessions(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, infobaseCred entity.Credentials, code string) error
 
 		DeleteSession(ctx context.Context, entrypoint string, cluster entity.Cluster, session entity.Session, clusterCred entity.Credentials) error
@@ -55,6 +165,61 @@ type (
 
 	// CtrlBackup -.
 	CtrlBackup interface {
-		RunBackup(ctx context.Context, cluster entity.Cluster, infobase entity.Infobase, infobaseCred entity.Credentials, lockCode string, outputPath string) error
+		RunBackup(ctx context.Context, cluster entity.Cluster, infobase entity.Infobase, infobaseCred entity.Credentials, lockCode string, outputPath string) (entity.DesignerResult, error)
+		RunRestore(ctx context.Context, cluster entity.Cluster, infobase entity.Infobase, infobaseCred entity.Credentials, lockCode string, inputPath string) (entity.DesignerResult, error)
 	}
+
+	// CtrlCatalog -.
+	CtrlCatalog interface {
+		Path(ctx context.Context, cluster entity.Cluster, infobase entity.Infobase, created time.Time) (string, error)
+		Add(ctx context.Context, backup entity.Backup) (entity.Backup, error)
+		List(ctx context.Context) ([]entity.Backup, error)
+		Prune(ctx context.Context, cluster entity.Cluster, infobase entity.Infobase, policy entity.RetentionPolicy) ([]entity.Backup, error)
+	}
+
+	// CtrlScheduler -.
+	CtrlScheduler interface {
+		Schedules(ctx context.Context) ([]entity.Schedule, error)
+	}
+
+	// CtrlWatcher -.
+	CtrlWatcher interface {
+		Watch(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) (<-chan entity.SessionEvent, error)
+	}
+
+	// CtrlReaper -.
+	CtrlReaper interface {
+		Audit(ctx context.Context) ([]entity.ReapRecord, error)
+	}
+
+	// CtrlRegistry -.
+	CtrlRegistry interface {
+		Entrypoints(ctx context.Context) ([]entity.Entrypoint, error)
+		Entrypoint(ctx context.Context, name string) (entity.Entrypoint, error)
+		Allowed(ctx context.Context, address string) bool
+	}
+
+	// CtrlFleet -.
+	CtrlFleet interface {
+		Sessions(ctx context.Context, filter entity.FleetFilter, args map[string]any) ([]entity.FleetSession, []entity.FleetError, error)
+		Infobases(ctx context.Context, filter entity.FleetFilter, args map[string]any) ([]entity.FleetInfobase, []entity.FleetError, error)
+	}
+
+	// CtrlVault -.
+	CtrlVault interface {
+		ClusterCredentials(ctx context.Context, entrypoint, cluster string) (entity.Credentials, bool)
+		InfobaseCredentials(ctx context.Context, entrypoint, cluster, infobase string) (entity.Credentials, bool)
+		Keys(ctx context.Context) ([]entity.VaultKey, error)
+		Set(ctx context.Context, key entity.VaultKey, cred entity.Credentials) error
+		Remove(ctx context.Context, key entity.VaultKey) error
+	}
+
+	// CtrlJobs -.
+	CtrlJobs interface {
+		Enqueue(ctx context.Context, job entity.Job, run JobFunc) (entity.Job, error)
+		Get(ctx context.Context, id string) (entity.Job, error)
+	}
+
+	// JobFunc - body of background job, update applies change to job state under lock of job queue.
+	JobFunc func(ctx context.Context, update func(change func(job *entity.Job))) error
 )
@@ -0,0 +1,222 @@
+package jobs
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	uc "github.com/antonmisa/1cctl/internal/usecase"
+)
+
+const (
+	_defaultWorkers   int = 1
+	_defaultQueueSize int = 100
+
+	_keepFinished int = 1000
+
+	_idSize int = 16
+)
+
+var (
+	ErrNotFound  = uc.ErrJobNotFound
+	ErrQueueFull = uc.ErrJobQueueFull
+	ErrClosed    = errors.New("job queue is closed")
+)
+
+type task struct {
+	id  string
+	run uc.JobFunc
+}
+
+// CtrlJobs - in memory queue of jobs with bounded number of workers.
+type CtrlJobs struct {
+	sync.Mutex
+	jobs  map[string]*entity.Job
+	order []string
+	queue chan task
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ uc.CtrlJobs = (*CtrlJobs)(nil)
+
+// New - starting workers, not more than workers jobs are run simultaneously.
+func New(workers int, queueSize int) *CtrlJobs {
+	if workers <= 0 {
+		workers = _defaultWorkers
+	}
+
+	if queueSize <= 0 {
+		queueSize = _defaultQueueSize
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	j := &CtrlJobs{
+		jobs:   make(map[string]*entity.Job, queueSize),
+		order:  make([]string, 0, queueSize),
+		queue:  make(chan task, queueSize),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	for i := 0; i < workers; i++ {
+		j.wg.Add(1)
+
+		go j.work()
+	}
+
+	return j
+}
+
+// Enqueue - registering job and putting it to queue, ctx of caller is not passed to job.
+func (j *CtrlJobs) Enqueue(ctx context.Context, job entity.Job, run uc.JobFunc) (entity.Job, error) {
+	id, err := newID()
+	if err != nil {
+		return entity.Job{}, fmt.Errorf("ctrljobs - enqueue - newID: %w", err)
+	}
+
+	job.ID = id
+	job.Status = entity.JobQueued
+	job.Created = time.Now()
+
+	j.Lock()
+	defer j.Unlock()
+
+	if j.ctx.Err() != nil {
+		return entity.Job{}, fmt.Errorf("ctrljobs - enqueue: %w", ErrClosed)
+	}
+
+	select {
+	case j.queue <- task{id: id, run: run}:
+	default:
+		return entity.Job{}, fmt.Errorf("ctrljobs - enqueue: %w", ErrQueueFull)
+	}
+
+	stored := clone(&job)
+
+	j.jobs[id] = &stored
+	j.order = append(j.order, id)
+
+	j.prune()
+
+	return clone(&stored), nil
+}
+
+// Get -.
+func (j *CtrlJobs) Get(ctx context.Context, id string) (entity.Job, error) {
+	j.Lock()
+	defer j.Unlock()
+
+	job, ok := j.jobs[id]
+	if !ok {
+		return entity.Job{}, fmt.Errorf("ctrljobs - get: %w", ErrNotFound)
+	}
+
+	return clone(job), nil
+}
+
+// Close - cancelling running jobs and waiting for workers.
+func (j *CtrlJobs) Close() {
+	j.Lock()
+	j.cancel()
+	j.Unlock()
+
+	j.wg.Wait()
+}
+
+func (j *CtrlJobs) work() {
+	defer j.wg.Done()
+
+	for {
+		select {
+		case <-j.ctx.Done():
+			return
+		case t := <-j.queue:
+			j.run(t)
+		}
+	}
+}
+
+func (j *CtrlJobs) run(t task) {
+	j.update(t.id, func(job *entity.Job) {
+		job.Status = entity.JobRunning
+		job.Started = time.Now()
+	})
+
+	err := t.run(j.ctx, func(change func(job *entity.Job)) {
+		j.update(t.id, change)
+	})
+
+	j.update(t.id, func(job *entity.Job) {
+		job.Finished = time.Now()
+
+		if err != nil {
+			job.Status = entity.JobFailed
+			job.Error = err.Error()
+
+			return
+		}
+
+		job.Status = entity.JobSucceeded
+	})
+}
+
+func (j *CtrlJobs) update(id string, change func(job *entity.Job)) {
+	j.Lock()
+	defer j.Unlock()
+
+	if job, ok := j.jobs[id]; ok {
+		change(job)
+	}
+}
+
+// prune - forgetting oldest finished jobs, queued and running ones are kept.
+func (j *CtrlJobs) prune() {
+	excess := len(j.order) - _keepFinished
+
+	if excess <= 0 {
+		return
+	}
+
+	kept := j.order[:0]
+
+	for _, id := range j.order {
+		job := j.jobs[id]
+
+		if excess > 0 && (job.Status == entity.JobSucceeded || job.Status == entity.JobFailed) {
+			delete(j.jobs, id)
+			excess--
+
+			continue
+		}
+
+		kept = append(kept, id)
+	}
+
+	j.order = kept
+}
+
+func newID() (string, error) {
+	b := make([]byte, _idSize)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
+
+// clone - copying job so caller does not share steps with queue.
+func clone(job *entity.Job) entity.Job {
+	rv := *job
+	rv.Steps = append([]entity.JobStep(nil), job.Steps...)
+
+	return rv
+}
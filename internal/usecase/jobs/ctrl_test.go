@@ -0,0 +1,178 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func waitFinished(t *testing.T, j *CtrlJobs, id string) entity.Job {
+	t.Helper()
+
+	var job entity.Job
+
+	require.Eventually(t, func() bool {
+		var err error
+
+		job, err = j.Get(context.Background(), id)
+		require.NoError(t, err)
+
+		return job.Status == entity.JobSucceeded || job.Status == entity.JobFailed
+	}, time.Second, time.Millisecond)
+
+	return job
+}
+
+func TestEnqueue(t *testing.T) {
+	cases := []struct {
+		name    string
+		run     func(ctx context.Context, update func(change func(job *entity.Job))) error
+		status  entity.JobStatus
+		log     string
+		errText string
+	}{
+		{
+			name: "Success",
+			run: func(ctx context.Context, update func(change func(job *entity.Job))) error {
+				update(func(job *entity.Job) { job.Log = "done" })
+
+				return nil
+			},
+			status: entity.JobSucceeded,
+			log:    "done",
+		},
+		{
+			name: "Error",
+			run: func(ctx context.Context, update func(change func(job *entity.Job))) error {
+				update(func(job *entity.Job) { job.ExitCode = 1 })
+
+				return errors.New("dump error")
+			},
+			status:  entity.JobFailed,
+			errText: "dump error",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			j := New(1, 1)
+			defer j.Close()
+
+			job, err := j.Enqueue(context.Background(), entity.Job{Kind: "backup"}, tc.run)
+			require.NoError(t, err)
+			require.NotEmpty(t, job.ID)
+			require.Equal(t, entity.JobQueued, job.Status)
+
+			job = waitFinished(t, j, job.ID)
+
+			require.Equal(t, tc.status, job.Status)
+			require.Equal(t, "backup", job.Kind)
+			require.Equal(t, tc.log, job.Log)
+			require.Equal(t, tc.errText, job.Error)
+			require.False(t, job.Started.IsZero())
+			require.False(t, job.Finished.Before(job.Started))
+		})
+	}
+}
+
+func TestEnqueueBounded(t *testing.T) {
+	t.Parallel()
+
+	const (
+		workers = 2
+		total   = 6
+	)
+
+	j := New(workers, total)
+	defer j.Close()
+
+	var (
+		running int32
+		maximum int32
+		mu      sync.Mutex
+	)
+
+	run := func(ctx context.Context, update func(change func(job *entity.Job))) error {
+		cur := atomic.AddInt32(&running, 1)
+
+		mu.Lock()
+		if cur > maximum {
+			maximum = cur
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+
+		return nil
+	}
+
+	ids := make([]string, 0, total)
+
+	for i := 0; i < total; i++ {
+		job, err := j.Enqueue(context.Background(), entity.Job{}, run)
+		require.NoError(t, err)
+
+		ids = append(ids, job.ID)
+	}
+
+	for _, id := range ids {
+		waitFinished(t, j, id)
+	}
+
+	require.LessOrEqual(t, maximum, int32(workers))
+}
+
+func TestEnqueueQueueFull(t *testing.T) {
+	t.Parallel()
+
+	j := New(1, 1)
+	defer j.Close()
+
+	block := make(chan struct{})
+	defer close(block)
+
+	run := func(ctx context.Context, update func(change func(job *entity.Job))) error {
+		select {
+		case <-block:
+		case <-ctx.Done():
+		}
+
+		return nil
+	}
+
+	first, err := j.Enqueue(context.Background(), entity.Job{}, run)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		job, _ := j.Get(context.Background(), first.ID)
+
+		return job.Status == entity.JobRunning
+	}, time.Second, time.Millisecond)
+
+	_, err = j.Enqueue(context.Background(), entity.Job{}, run)
+	require.NoError(t, err)
+
+	_, err = j.Enqueue(context.Background(), entity.Job{}, run)
+	require.ErrorIs(t, err, ErrQueueFull)
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	j := New(1, 1)
+	defer j.Close()
+
+	_, err := j.Get(context.Background(), "unknown")
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+var (
+	ErrLimitExists   = errors.New("limit already exists")
+	ErrLimitNotFound = errors.New("limit not found")
+)
+
+// Limits - getting resource consumption limits of cluster.
+func (c *CtrlUseCase) Limits(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Limit, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("GetLimits from 1C")
+
+	limits, err := c.pipe.GetLimits(ctx, entrypoint, cluster, clusterCred)
+	if err != nil {
+		return nil, fmt.Errorf("CtrlUseCase - Limits - c.pipe.GetLimits: %w", err)
+	}
+
+	return limits, nil
+}
+
+// CreateLimit - adding resource consumption limit to cluster, limit with the same name must not exist.
+func (c *CtrlUseCase) CreateLimit(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.LimitOptions) (entity.Limit, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("check limit")
+
+	_, ok, err := c.limitByName(ctx, entrypoint, cluster, clusterCred, opts.Name)
+	if err != nil {
+		return entity.Limit{}, fmt.Errorf("CtrlUseCase - CreateLimit - c.limitByName: %w", err)
+	}
+
+	if ok {
+		return entity.Limit{}, fmt.Errorf("CtrlUseCase - CreateLimit: %s: %w", opts.Name, ErrLimitExists)
+	}
+
+	return c.writeLimit(ctx, "CreateLimit", entrypoint, cluster, clusterCred, opts)
+}
+
+// UpdateLimit - changing existing resource consumption limit of cluster.
+func (c *CtrlUseCase) UpdateLimit(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.LimitOptions) (entity.Limit, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("check limit")
+
+	_, ok, err := c.limitByName(ctx, entrypoint, cluster, clusterCred, opts.Name)
+	if err != nil {
+		return entity.Limit{}, fmt.Errorf("CtrlUseCase - UpdateLimit - c.limitByName: %w", err)
+	}
+
+	if !ok {
+		return entity.Limit{}, fmt.Errorf("CtrlUseCase - UpdateLimit: %s: %w", opts.Name, ErrLimitNotFound)
+	}
+
+	return c.writeLimit(ctx, "UpdateLimit", entrypoint, cluster, clusterCred, opts)
+}
+
+// RemoveLimit - deleting resource consumption limit of cluster.
+func (c *CtrlUseCase) RemoveLimit(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) error {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("check limit")
+
+	_, ok, err := c.limitByName(ctx, entrypoint, cluster, clusterCred, name)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - RemoveLimit - c.limitByName: %w", err)
+	}
+
+	if !ok {
+		return fmt.Errorf("CtrlUseCase - RemoveLimit: %s: %w", name, ErrLimitNotFound)
+	}
+
+	span.AddEvent("RemoveLimit in 1C")
+
+	err = c.pipe.RemoveLimit(ctx, entrypoint, cluster, clusterCred, name)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - RemoveLimit - c.pipe.RemoveLimit: %w", err)
+	}
+
+	return nil
+}
+
+// writeLimit - writing limit to 1C and reading it back.
+func (c *CtrlUseCase) writeLimit(ctx context.Context, method string, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.LimitOptions) (entity.Limit, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("UpdateLimit in 1C")
+
+	err := c.pipe.UpdateLimit(ctx, entrypoint, cluster, clusterCred, opts)
+	if err != nil {
+		return entity.Limit{}, fmt.Errorf("CtrlUseCase - %s - c.pipe.UpdateLimit: %w", method, err)
+	}
+
+	limit, ok, err := c.limitByName(ctx, entrypoint, cluster, clusterCred, opts.Name)
+	if err != nil {
+		return entity.Limit{}, fmt.Errorf("CtrlUseCase - %s - c.limitByName: %w", method, err)
+	}
+
+	if !ok {
+		return entity.Limit{}, fmt.Errorf("CtrlUseCase - %s: %s: %w", method, opts.Name, ErrLimitNotFound)
+	}
+
+	return limit, nil
+}
+
+// limitByName - finding resource consumption limit of cluster, decoder lowers values so names are compared ignoring case.
+func (c *CtrlUseCase) limitByName(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) (entity.Limit, bool, error) {
+	limits, err := c.pipe.GetLimits(ctx, entrypoint, cluster, clusterCred)
+	if err != nil {
+		return entity.Limit{}, false, err
+	}
+
+	for _, p := range limits {
+		if entity.SameName(p.Name, name) {
+			return p, true, nil
+		}
+	}
+
+	return entity.Limit{}, false, nil
+}
@@ -0,0 +1,63 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+)
+
+func TestRemoveLimit(t *testing.T) {
+	cluster := entity.Cluster{ID: "1234-5678"}
+
+	cases := []struct {
+		name   string
+		limits []entity.Limit
+		errIs  error
+	}{
+		{
+			name:   "Success",
+			limits: []entity.Limit{{Name: "heavy_calls"}},
+		},
+		{
+			name:   "Error not found",
+			limits: []entity.Limit{},
+			errIs:  usecase.ErrLimitNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			pipeMock := ucm.NewCtrlPipe(t)
+
+			pipeMock.On("GetLimits", mock.Anything, "1capp01:1545", cluster, entity.Credentials{}).
+				Return(tc.limits, nil)
+
+			if tc.errIs == nil {
+				pipeMock.On("RemoveLimit", mock.Anything, "1capp01:1545", cluster, entity.Credentials{}, "heavy_calls").
+					Return(nil)
+			}
+
+			c := usecase.New(ucm.NewCtrlCache(t), pipeMock, ucm.NewCtrlBackup(t), ucm.NewCtrlJobs(t), ucm.NewCtrlCatalog(t))
+
+			err := c.RemoveLimit(context.Background(), "1capp01:1545", cluster, entity.Credentials{}, "heavy_calls")
+
+			if tc.errIs != nil {
+				require.ErrorIs(t, err, tc.errIs)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
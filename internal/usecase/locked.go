@@ -0,0 +1,254 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+var ErrSessionsRemain = errors.New("sessions remain in infobase")
+
+const (
+	_stepLock   string = "lock"
+	_stepGrace  string = "grace"
+	_stepKick   string = "kick"
+	_stepUnlock string = "unlock"
+
+	_unlockTimeout time.Duration = time.Minute
+)
+
+// lockedRun - job doing work with infobase locked: sessions are denied, users are warned during grace period,
+// remaining sessions are terminated and infobase is unlocked at the end whatever happened.
+type lockedRun struct {
+	c *CtrlUseCase
+
+	method string
+
+	entrypoint   string
+	cluster      entity.Cluster
+	clusterCred  entity.Credentials
+	infobase     entity.Infobase
+	infobaseCred entity.Credentials
+
+	lockCode    string
+	message     string
+	gracePeriod time.Duration
+
+	work string
+	do   JobFunc
+
+	update func(change func(job *entity.Job))
+}
+
+// newLockedRun - preparing job with locking infobase, empty lockCode and gracePeriod mean default ones.
+func (c *CtrlUseCase) newLockedRun(method string, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, lockCode string, message string, gracePeriod time.Duration) *lockedRun {
+	if lockCode == "" {
+		lockCode = c.lockCode
+	}
+
+	if gracePeriod <= 0 {
+		gracePeriod = c.gracePeriod
+	}
+
+	return &lockedRun{
+		c:            c,
+		method:       method,
+		entrypoint:   entrypoint,
+		cluster:      cluster,
+		clusterCred:  clusterCred,
+		infobase:     infobase,
+		infobaseCred: infobaseCred,
+		lockCode:     lockCode,
+		message:      message,
+		gracePeriod:  gracePeriod,
+	}
+}
+
+// steps - all steps of job in order of running, not started yet.
+func (w *lockedRun) steps() []entity.JobStep {
+	names := []string{_stepLock, _stepGrace, _stepKick, w.work, _stepUnlock}
+
+	steps := make([]entity.JobStep, 0, len(names))
+	for _, name := range names {
+		steps = append(steps, entity.JobStep{Name: name, Status: entity.JobQueued})
+	}
+
+	return steps
+}
+
+// enqueue - putting job to queue with all steps listed.
+func (w *lockedRun) enqueue(ctx context.Context, job entity.Job) (entity.Job, error) {
+	job.Steps = w.steps()
+
+	return w.c.jobs.Enqueue(ctx, job, func(ctx context.Context, update func(change func(job *entity.Job))) error {
+		w.update = update
+
+		return w.run(ctx)
+	})
+}
+
+func (w *lockedRun) run(ctx context.Context) (err error) {
+	defer w.skipRest()
+
+	err = w.step(_stepLock, func() error { return w.lock(ctx) })
+
+	// Lock could be applied even if rac reported error, so unlocking is tried anyway
+	defer func() {
+		if uerr := w.step(_stepUnlock, w.unlock); uerr != nil {
+			err = errors.Join(err, uerr)
+		}
+	}()
+
+	if err != nil {
+		return err
+	}
+
+	if err = w.step(_stepGrace, func() error { return w.grace(ctx) }); err != nil {
+		return err
+	}
+
+	if err = w.step(_stepKick, func() error { return w.kick(ctx) }); err != nil {
+		return err
+	}
+
+	return w.step(w.work, func() error { return w.do(ctx, w.update) })
+}
+
+func (w *lockedRun) lock(ctx context.Context) error {
+	from := time.Now().Add(w.gracePeriod)
+
+	err := w.c.LockInfobase(ctx, w.entrypoint, w.cluster, w.clusterCred, w.infobase, w.infobaseCred, entity.LockOptions{
+		DeniedFrom:        from,
+		DeniedTo:          from.Add(w.c.lockWindow),
+		Message:           w.message,
+		PermissionCode:    w.lockCode,
+		ScheduledJobsDeny: true,
+	})
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - %s - c.LockInfobase: %w", w.method, err)
+	}
+
+	return nil
+}
+
+// grace - waiting while users see lock message and finish their work.
+func (w *lockedRun) grace(ctx context.Context) error {
+	if w.gracePeriod <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(w.gracePeriod)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("CtrlUseCase - %s - grace: %w", w.method, ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
+
+// kick - terminating sessions until none is left or retries are over.
+func (w *lockedRun) kick(ctx context.Context) error {
+	var lastErr error
+
+	for attempt := 1; ; attempt++ {
+		sessions, err := w.c.pipe.GetSessions(ctx, w.entrypoint, w.cluster, w.infobase, w.clusterCred)
+		if err != nil {
+			return fmt.Errorf("CtrlUseCase - %s - c.pipe.GetSessions: %w", w.method, err)
+		}
+
+		if len(sessions) == 0 {
+			return nil
+		}
+
+		if attempt > w.c.kickRetries {
+			return errors.Join(fmt.Errorf("CtrlUseCase - %s - kick: %d: %w", w.method, len(sessions), ErrSessionsRemain), lastErr)
+		}
+
+		w.change(_stepKick, func(step *entity.JobStep) {
+			step.Attempts = attempt
+		})
+
+		// Sessions could end by themselves meanwhile, so error is checked by next listing
+		lastErr = w.c.DeleteSessions(ctx, w.entrypoint, w.cluster, w.clusterCred, sessions)
+
+		timer := time.NewTimer(w.c.kickInterval)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return fmt.Errorf("CtrlUseCase - %s - kick: %w", w.method, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// unlock - allowing sessions again, context of job could be already cancelled so own one is used.
+func (w *lockedRun) unlock() error {
+	ctx, cancel := context.WithTimeout(context.Background(), _unlockTimeout)
+	defer cancel()
+
+	err := w.c.UnlockInfobase(ctx, w.entrypoint, w.cluster, w.clusterCred, w.infobase, w.infobaseCred, w.lockCode)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - %s - c.UnlockInfobase: %w", w.method, err)
+	}
+
+	return nil
+}
+
+// step - running fn with tracking state of step in job.
+func (w *lockedRun) step(name string, fn func() error) error {
+	w.change(name, func(step *entity.JobStep) {
+		step.Status = entity.JobRunning
+		step.Started = time.Now()
+	})
+
+	err := fn()
+
+	w.change(name, func(step *entity.JobStep) {
+		step.Finished = time.Now()
+
+		if step.Attempts == 0 {
+			step.Attempts = 1
+		}
+
+		if err != nil {
+			step.Status = entity.JobFailed
+			step.Error = err.Error()
+
+			return
+		}
+
+		step.Status = entity.JobSucceeded
+	})
+
+	return err
+}
+
+// skipRest - marking steps which were not reached.
+func (w *lockedRun) skipRest() {
+	w.update(func(job *entity.Job) {
+		for i := range job.Steps {
+			if job.Steps[i].Status == entity.JobQueued {
+				job.Steps[i].Status = entity.JobSkipped
+			}
+		}
+	})
+}
+
+func (w *lockedRun) change(name string, change func(step *entity.JobStep)) {
+	w.update(func(job *entity.Job) {
+		for i := range job.Steps {
+			if job.Steps[i].Name == name {
+				change(&job.Steps[i])
+
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+)
+
+func TestLockInfobase(t *testing.T) {
+	cluster := entity.Cluster{ID: "1234-5678"}
+	infobase := entity.Infobase{ID: "8765-4321"}
+
+	now := time.Now()
+
+	cases := []struct {
+		name  string
+		opts  entity.LockOptions
+		errIs error
+	}{
+		{
+			name: "Success wo window",
+		},
+		{
+			name: "Success w denied to only",
+			opts: entity.LockOptions{DeniedTo: now.Add(time.Hour)},
+		},
+		{
+			name: "Success w window",
+			opts: entity.LockOptions{DeniedFrom: now.Add(time.Hour), DeniedTo: now.Add(2 * time.Hour)},
+		},
+		{
+			name:  "Error denied to before denied from",
+			opts:  entity.LockOptions{DeniedFrom: now.Add(2 * time.Hour), DeniedTo: now.Add(time.Hour)},
+			errIs: usecase.ErrLockWindowInvalid,
+		},
+		{
+			name:  "Error denied to in the past",
+			opts:  entity.LockOptions{DeniedTo: now.Add(-time.Hour)},
+			errIs: usecase.ErrLockWindowInvalid,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			pipeMock := ucm.NewCtrlPipe(t)
+
+			if tc.errIs == nil {
+				pipeMock.On("DisableSessions", mock.Anything, "1capp01:1545", cluster, infobase, entity.Credentials{}, entity.Credentials{},
+					mock.MatchedBy(func(opts entity.LockOptions) bool {
+						return !opts.DeniedFrom.IsZero()
+					})).
+					Return(nil)
+			}
+
+			c := usecase.New(ucm.NewCtrlCache(t), pipeMock, ucm.NewCtrlBackup(t), ucm.NewCtrlJobs(t), ucm.NewCtrlCatalog(t))
+
+			err := c.LockInfobase(context.Background(), "1capp01:1545", cluster, entity.Credentials{}, infobase, entity.Credentials{}, tc.opts)
+			if tc.errIs != nil {
+				require.ErrorIs(t, err, tc.errIs)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
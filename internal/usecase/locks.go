@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+// Locks - getting managed locks of infobase with sessions holding them, locks are always read from 1C
+// while sessions may be taken from cache.
+func (c *CtrlUseCase) Locks(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, filter entity.LockFilter, args map[string]any) ([]entity.Lock, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("GetLocks from 1C")
+
+	locks, err := c.pipe.GetLocks(ctx, entrypoint, cluster, infobase, clusterCred, filter)
+	if err != nil {
+		return nil, fmt.Errorf("CtrlUseCase - Locks - c.pipe.GetLocks: %w", err)
+	}
+
+	if len(locks) == 0 {
+		return locks, nil
+	}
+
+	span.AddEvent("join owners")
+
+	sessions, err := c.Sessions(ctx, entrypoint, cluster, clusterCred, infobase, args)
+	if err != nil {
+		return nil, fmt.Errorf("CtrlUseCase - Locks - c.Sessions: %w", err)
+	}
+
+	owners := make(map[string]*entity.Session, len(sessions))
+
+	for i := range sessions {
+		owners[sessions[i].ID] = &sessions[i]
+	}
+
+	for i := range locks {
+		locks[i].Owner = owners[locks[i].SessionID]
+	}
+
+	return locks, nil
+}
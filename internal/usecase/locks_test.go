@@ -0,0 +1,98 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+)
+
+func TestLocks(t *testing.T) {
+	cluster := entity.Cluster{ID: "1234-5678"}
+	infobase := entity.Infobase{ID: "8765-4321"}
+	filter := entity.LockFilter{Connection: "1-1-1-1"}
+
+	owner := entity.Session{ID: "1111-2222", SID: 5, UserName: "buh"}
+
+	cases := []struct {
+		name        string
+		locks       []entity.Lock
+		locksErr    error
+		sessionsErr error
+		owners      []*entity.Session
+		isErr       bool
+	}{
+		{
+			name: "Success",
+			locks: []entity.Lock{
+				{SessionID: "1111-2222", Object: "1"},
+				{SessionID: "00000000-0000-0000-0000-000000000000", Object: "2"},
+			},
+			owners: []*entity.Session{&owner, nil},
+		},
+		{
+			name:   "Success wo locks",
+			locks:  []entity.Lock{},
+			owners: []*entity.Session{},
+		},
+		{
+			name:     "Error locks",
+			locksErr: errors.New("rac error"),
+			isErr:    true,
+		},
+		{
+			name:        "Error sessions",
+			locks:       []entity.Lock{{SessionID: "1111-2222"}},
+			sessionsErr: errors.New("rac error"),
+			isErr:       true,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cacheMock := ucm.NewCtrlCache(t)
+			pipeMock := ucm.NewCtrlPipe(t)
+
+			pipeMock.On("GetLocks", mock.Anything, "1capp01:1545", cluster, infobase, entity.Credentials{}, filter).
+				Return(tc.locks, tc.locksErr)
+
+			if len(tc.locks) > 0 {
+				pipeMock.On("GetSessions", mock.Anything, "1capp01:1545", cluster, infobase, entity.Credentials{}).
+					Return([]entity.Session{owner, {ID: "3333-4444"}}, tc.sessionsErr)
+
+				cacheMock.On("PutSessions", mock.Anything, "1capp01:1545", cluster, infobase, mock.Anything).
+					Return(nil).
+					Maybe()
+			}
+
+			c := usecase.New(cacheMock, pipeMock, ucm.NewCtrlBackup(t), ucm.NewCtrlJobs(t), ucm.NewCtrlCatalog(t))
+
+			locks, err := c.Locks(context.Background(), "1capp01:1545", cluster, entity.Credentials{}, infobase, filter,
+				map[string]any{common.UseCache: false})
+
+			if tc.isErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, locks, len(tc.owners))
+
+			for i, lock := range locks {
+				require.Equal(t, tc.owners[i], lock.Owner)
+			}
+		})
+	}
+}
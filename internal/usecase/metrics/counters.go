@@ -0,0 +1,116 @@
+// Package metrics exports state of 1C clusters to prometheus.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	uc "github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/pkg/logger"
+)
+
+const (
+	_defaultScrapeTimeout time.Duration = 10 * time.Second
+)
+
+// CounterTarget - counters of cluster which values are exported.
+type CounterTarget struct {
+	Entrypoint  string
+	Cluster     entity.Cluster
+	ClusterCred entity.Credentials
+	Counters    []string
+}
+
+// CounterCollector - reading values of resource consumption counters through usecase on each scrape.
+type CounterCollector struct {
+	ctrl    uc.Ctrl
+	l       logger.Interface
+	targets []CounterTarget
+	timeout time.Duration
+
+	value  *prometheus.Desc
+	failed *prometheus.Desc
+}
+
+var _ prometheus.Collector = (*CounterCollector)(nil)
+
+// NewCounterCollector - timeout limits reading of all counters of one scrape, zero means default.
+func NewCounterCollector(ctrl uc.Ctrl, l logger.Interface, targets []CounterTarget, timeout time.Duration) *CounterCollector {
+	if timeout <= 0 {
+		timeout = _defaultScrapeTimeout
+	}
+
+	return &CounterCollector{
+		ctrl:    ctrl,
+		l:       l,
+		targets: targets,
+		timeout: timeout,
+		value: prometheus.NewDesc("onec_counter_value",
+			"Accumulated value of resource consumption counter of 1C cluster",
+			[]string{"entrypoint", "cluster", "counter", "object", "resource"}, nil),
+		failed: prometheus.NewDesc("onec_counter_scrape_failed",
+			"1 if values of counter were not read on last scrape",
+			[]string{"entrypoint", "cluster", "counter"}, nil),
+	}
+}
+
+// Describe -.
+func (cc *CounterCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- cc.value
+	ch <- cc.failed
+}
+
+// Collect - failed counters are logged and marked by onec_counter_scrape_failed, others are still exported.
+func (cc *CounterCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), cc.timeout)
+	defer cancel()
+
+	for _, t := range cc.targets {
+		for _, counter := range t.Counters {
+			values, err := cc.ctrl.CounterValues(ctx, t.Entrypoint, t.Cluster, t.ClusterCred, counter, "")
+
+			failed := 0.0
+
+			if err != nil {
+				cc.l.Error(fmt.Errorf("metrics - CounterCollector - Collect - cc.ctrl.CounterValues: %s: %w", counter, err))
+
+				failed = 1
+			}
+
+			ch <- prometheus.MustNewConstMetric(cc.failed, prometheus.GaugeValue, failed, t.Entrypoint, t.Cluster.ID, counter)
+
+			for _, v := range values {
+				for _, r := range resources(v) {
+					ch <- prometheus.MustNewConstMetric(cc.value, prometheus.GaugeValue, float64(r.value),
+						t.Entrypoint, t.Cluster.ID, counter, v.Object, r.name)
+				}
+			}
+		}
+	}
+}
+
+type resource struct {
+	name  string
+	value int
+}
+
+// resources - values of counter labeled by resource name.
+func resources(v entity.CounterValue) []resource {
+	return []resource{
+		{"duration", v.Duration},
+		{"cpu_time", v.CPUTime},
+		{"memory", v.Memory},
+		{"read", v.Read},
+		{"write", v.Write},
+		{"duration_dbms", v.DurationDBMS},
+		{"dbms_bytes", v.DBMSBytes},
+		{"service", v.Service},
+		{"call", v.Call},
+		{"active_sessions", v.NumberOfActiveSessions},
+		{"sessions", v.NumberOfSessions},
+	}
+}
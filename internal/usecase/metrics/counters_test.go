@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func TestCounterCollector(t *testing.T) {
+	t.Parallel()
+
+	cluster := entity.Cluster{ID: "1234-5678"}
+
+	ctrlMock := ucm.NewCtrl(t)
+
+	ctrlMock.On("CounterValues", mock.Anything, "1capp01:1545", cluster, entity.Credentials{Name: "admin"}, "users_cpu", "").
+		Return([]entity.CounterValue{{Object: "ivanov", CPUTime: 800, Call: 15}}, nil)
+
+	ctrlMock.On("CounterValues", mock.Anything, "1capp01:1545", cluster, entity.Credentials{Name: "admin"}, "ib_memory", "").
+		Return([]entity.CounterValue(nil), errors.New("counter not found"))
+
+	logMock := lm.NewInterface(t)
+
+	logMock.On("Error", mock.Anything).Twice()
+
+	cc := NewCounterCollector(ctrlMock, logMock, []CounterTarget{{
+		Entrypoint:  "1capp01:1545",
+		Cluster:     cluster,
+		ClusterCred: entity.Credentials{Name: "admin"},
+		Counters:    []string{"users_cpu", "ib_memory"},
+	}}, 0)
+
+	expected := `
+# HELP onec_counter_scrape_failed 1 if values of counter were not read on last scrape
+# TYPE onec_counter_scrape_failed gauge
+onec_counter_scrape_failed{cluster="1234-5678",counter="ib_memory",entrypoint="1capp01:1545"} 1
+onec_counter_scrape_failed{cluster="1234-5678",counter="users_cpu",entrypoint="1capp01:1545"} 0
+`
+
+	require.NoError(t, testutil.CollectAndCompare(cc, strings.NewReader(expected), "onec_counter_scrape_failed"))
+	require.Equal(t, 2+len(resources(entity.CounterValue{})), testutil.CollectAndCount(cc))
+}
@@ -0,0 +1,276 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	uc "github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+	"github.com/antonmisa/1cctl/pkg/logger"
+)
+
+const (
+	_defaultPollInterval time.Duration = 30 * time.Second
+)
+
+// ExporterTarget - entrypoint which clusters are polled, credentials are used for all its clusters.
+type ExporterTarget struct {
+	Entrypoint  string
+	ClusterCred entity.Credentials
+}
+
+// series - labels of gauges, sessions and connections are aggregated by them.
+type series struct {
+	entrypoint string
+	cluster    string
+	infobase   string
+	appID      string
+}
+
+// sample - values of gauges for one series.
+type sample struct {
+	sessions    float64
+	connections float64
+	memory      float64
+	cpuTime     float64
+	blocked     float64
+}
+
+// Exporter - polling sessions and connections of clusters through usecase on interval,
+// scrape returns the last polled values so rac is not called by prometheus directly.
+type Exporter struct {
+	sync.Mutex
+	ctrl     uc.Ctrl
+	l        logger.Interface
+	targets  []ExporterTarget
+	interval time.Duration
+	timeout  time.Duration
+
+	samples map[string]map[series]sample
+	failed  map[string]bool
+
+	sessions    *prometheus.Desc
+	connections *prometheus.Desc
+	memory      *prometheus.Desc
+	cpuTime     *prometheus.Desc
+	blocked     *prometheus.Desc
+	pollFailed  *prometheus.Desc
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ prometheus.Collector = (*Exporter)(nil)
+
+// NewExporter - zero interval and timeout mean defaults, targets are polled after Start.
+func NewExporter(ctrl uc.Ctrl, l logger.Interface, targets []ExporterTarget, interval time.Duration, timeout time.Duration) *Exporter {
+	if interval <= 0 {
+		interval = _defaultPollInterval
+	}
+
+	if timeout <= 0 {
+		timeout = _defaultScrapeTimeout
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	labels := []string{"entrypoint", "cluster", "infobase", "app_id"}
+
+	return &Exporter{
+		ctrl:     ctrl,
+		l:        l,
+		targets:  targets,
+		interval: interval,
+		timeout:  timeout,
+		samples:  make(map[string]map[series]sample, len(targets)),
+		failed:   make(map[string]bool, len(targets)),
+		sessions: prometheus.NewDesc("onec_sessions_total",
+			"Number of sessions of infobase by application", labels, nil),
+		connections: prometheus.NewDesc("onec_connections_total",
+			"Number of connections of infobase by application", labels, nil),
+		memory: prometheus.NewDesc("onec_session_memory_bytes",
+			"Memory of calls being executed by sessions of infobase", labels, nil),
+		cpuTime: prometheus.NewDesc("onec_session_cpu_time",
+			"CPU time of calls being executed by sessions of infobase, milliseconds", labels, nil),
+		blocked: prometheus.NewDesc("onec_sessions_blocked_by_dbms",
+			"Number of sessions of infobase waiting for DBMS locks", labels, nil),
+		pollFailed: prometheus.NewDesc("onec_exporter_poll_failed",
+			"1 if clusters of entrypoint were not read completely on last poll",
+			[]string{"entrypoint"}, nil),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Start - polling targets at once and then on interval in background.
+func (e *Exporter) Start() {
+	e.wg.Add(1)
+
+	go func() {
+		defer e.wg.Done()
+
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+
+		for {
+			e.Poll()
+
+			select {
+			case <-e.ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop - waiting for running poll.
+func (e *Exporter) Stop() {
+	e.cancel()
+	e.wg.Wait()
+}
+
+// Poll - reading all targets once, series of clusters which were not read are dropped to not export stale values.
+func (e *Exporter) Poll() {
+	for _, t := range e.targets {
+		samples, err := e.poll(t)
+		if err != nil {
+			e.l.Error(fmt.Errorf("metrics - Exporter - Poll - e.poll: %s: %w", t.Entrypoint, err))
+		}
+
+		e.Lock()
+		e.samples[t.Entrypoint] = samples
+		e.failed[t.Entrypoint] = err != nil
+		e.Unlock()
+	}
+}
+
+// poll - aggregating sessions and connections of all clusters of entrypoint,
+// clusters which are read are kept even if other ones failed.
+func (e *Exporter) poll(t ExporterTarget) (map[series]sample, error) {
+	ctx, cancel := context.WithTimeout(e.ctx, e.timeout)
+	defer cancel()
+
+	samples := make(map[series]sample)
+
+	clusters, err := e.ctrl.Clusters(ctx, t.Entrypoint, map[string]any{common.UseCache: false})
+	if err != nil {
+		return samples, fmt.Errorf("e.ctrl.Clusters: %w", err)
+	}
+
+	var errs []error
+
+	for _, cluster := range clusters {
+		if err := e.pollCluster(ctx, t, cluster, samples); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", cluster.ID, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return samples, fmt.Errorf("clusters are not read: %v", errs)
+	}
+
+	return samples, nil
+}
+
+func (e *Exporter) pollCluster(ctx context.Context, t ExporterTarget, cluster entity.Cluster, samples map[series]sample) error {
+	args := map[string]any{common.UseCache: false}
+
+	infobases, err := e.ctrl.Infobases(ctx, t.Entrypoint, cluster, t.ClusterCred, map[string]any{common.UseCache: true})
+	if err != nil {
+		return fmt.Errorf("e.ctrl.Infobases: %w", err)
+	}
+
+	names := make(map[string]string, len(infobases))
+	for _, ib := range infobases {
+		names[ib.ID] = entity.TrimName(ib.Name)
+	}
+
+	sessions, err := e.ctrl.Sessions(ctx, t.Entrypoint, cluster, t.ClusterCred, entity.Infobase{}, args)
+	if err != nil {
+		return fmt.Errorf("e.ctrl.Sessions: %w", err)
+	}
+
+	connections, err := e.ctrl.Connections(ctx, t.Entrypoint, cluster, t.ClusterCred, entity.Infobase{}, args)
+	if err != nil {
+		return fmt.Errorf("e.ctrl.Connections: %w", err)
+	}
+
+	for _, s := range sessions {
+		key := series{t.Entrypoint, cluster.ID, infobaseName(names, s.InfobaseID), s.AppID}
+
+		v := samples[key]
+		v.sessions++
+		v.memory += float64(s.MemoryCur)
+		v.cpuTime += float64(s.CPUCur)
+
+		if s.BlockedDB != 0 {
+			v.blocked++
+		}
+
+		samples[key] = v
+	}
+
+	for _, c := range connections {
+		key := series{t.Entrypoint, cluster.ID, infobaseName(names, c.InfobaseID), c.AppID}
+
+		v := samples[key]
+		v.connections++
+
+		samples[key] = v
+	}
+
+	return nil
+}
+
+// infobaseName - sessions of infobase which is not listed yet are exported under its id.
+func infobaseName(names map[string]string, id string) string {
+	if name, ok := names[id]; ok {
+		return name
+	}
+
+	return id
+}
+
+// Describe -.
+func (e *Exporter) Describe(ch chan<- *prometheus.Desc) {
+	ch <- e.sessions
+	ch <- e.connections
+	ch <- e.memory
+	ch <- e.cpuTime
+	ch <- e.blocked
+	ch <- e.pollFailed
+}
+
+// Collect - exporting values of the last poll.
+func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
+	e.Lock()
+	defer e.Unlock()
+
+	for entrypoint, failed := range e.failed {
+		v := 0.0
+		if failed {
+			v = 1
+		}
+
+		ch <- prometheus.MustNewConstMetric(e.pollFailed, prometheus.GaugeValue, v, entrypoint)
+	}
+
+	for _, samples := range e.samples {
+		for k, v := range samples {
+			labels := []string{k.entrypoint, k.cluster, k.infobase, k.appID}
+
+			ch <- prometheus.MustNewConstMetric(e.sessions, prometheus.GaugeValue, v.sessions, labels...)
+			ch <- prometheus.MustNewConstMetric(e.connections, prometheus.GaugeValue, v.connections, labels...)
+			ch <- prometheus.MustNewConstMetric(e.memory, prometheus.GaugeValue, v.memory, labels...)
+			ch <- prometheus.MustNewConstMetric(e.cpuTime, prometheus.GaugeValue, v.cpuTime, labels...)
+			ch <- prometheus.MustNewConstMetric(e.blocked, prometheus.GaugeValue, v.blocked, labels...)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func TestExporterPoll(t *testing.T) {
+	t.Parallel()
+
+	cred := entity.Credentials{Name: "admin"}
+	cluster := entity.Cluster{ID: "1234-5678"}
+	broken := entity.Cluster{ID: "8765-4321"}
+
+	ctrlMock := ucm.NewCtrl(t)
+
+	ctrlMock.On("Clusters", mock.Anything, "1capp01:1545", mock.Anything).
+		Return([]entity.Cluster{cluster, broken}, nil)
+
+	ctrlMock.On("Infobases", mock.Anything, "1capp01:1545", cluster, cred, mock.Anything).
+		Return([]entity.Infobase{{ID: "ib-1", Name: "\"buh\""}}, nil)
+
+	ctrlMock.On("Infobases", mock.Anything, "1capp01:1545", broken, cred, mock.Anything).
+		Return([]entity.Infobase(nil), errors.New("access denied"))
+
+	ctrlMock.On("Sessions", mock.Anything, "1capp01:1545", cluster, cred, entity.Infobase{}, mock.Anything).
+		Return([]entity.Session{
+			{InfobaseID: "ib-1", AppID: "1cv8c", MemoryCur: 1024, CPUCur: 10, BlockedDB: 7},
+			{InfobaseID: "ib-1", AppID: "1cv8c", MemoryCur: 2048, CPUCur: 5},
+			{InfobaseID: "ib-2", AppID: "backgroundjob"},
+		}, nil)
+
+	ctrlMock.On("Connections", mock.Anything, "1capp01:1545", cluster, cred, entity.Infobase{}, mock.Anything).
+		Return([]entity.Connection{{InfobaseID: "ib-1", AppID: "1cv8c"}}, nil)
+
+	logMock := lm.NewInterface(t)
+
+	logMock.On("Error", mock.Anything).Once()
+
+	e := NewExporter(ctrlMock, logMock, []ExporterTarget{{Entrypoint: "1capp01:1545", ClusterCred: cred}}, 0, 0)
+	e.Poll()
+
+	expected := `
+# HELP onec_exporter_poll_failed 1 if clusters of entrypoint were not read completely on last poll
+# TYPE onec_exporter_poll_failed gauge
+onec_exporter_poll_failed{entrypoint="1capp01:1545"} 1
+# HELP onec_session_memory_bytes Memory of calls being executed by sessions of infobase
+# TYPE onec_session_memory_bytes gauge
+onec_session_memory_bytes{app_id="1cv8c",cluster="1234-5678",entrypoint="1capp01:1545",infobase="buh"} 3072
+onec_session_memory_bytes{app_id="backgroundjob",cluster="1234-5678",entrypoint="1capp01:1545",infobase="ib-2"} 0
+# HELP onec_sessions_blocked_by_dbms Number of sessions of infobase waiting for DBMS locks
+# TYPE onec_sessions_blocked_by_dbms gauge
+onec_sessions_blocked_by_dbms{app_id="1cv8c",cluster="1234-5678",entrypoint="1capp01:1545",infobase="buh"} 1
+onec_sessions_blocked_by_dbms{app_id="backgroundjob",cluster="1234-5678",entrypoint="1capp01:1545",infobase="ib-2"} 0
+# HELP onec_sessions_total Number of sessions of infobase by application
+# TYPE onec_sessions_total gauge
+onec_sessions_total{app_id="1cv8c",cluster="1234-5678",entrypoint="1capp01:1545",infobase="buh"} 2
+onec_sessions_total{app_id="backgroundjob",cluster="1234-5678",entrypoint="1capp01:1545",infobase="ib-2"} 1
+# HELP onec_connections_total Number of connections of infobase by application
+# TYPE onec_connections_total gauge
+onec_connections_total{app_id="1cv8c",cluster="1234-5678",entrypoint="1capp01:1545",infobase="buh"} 1
+onec_connections_total{app_id="backgroundjob",cluster="1234-5678",entrypoint="1capp01:1545",infobase="ib-2"} 0
+`
+
+	require.NoError(t, testutil.CollectAndCompare(e, strings.NewReader(expected),
+		"onec_exporter_poll_failed", "onec_session_memory_bytes", "onec_sessions_blocked_by_dbms",
+		"onec_sessions_total", "onec_connections_total"))
+}
@@ -0,0 +1,129 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/antonmisa/1cctl/pkg/pipe"
+)
+
+const (
+	_otherEntrypoint string = "other"
+)
+
+// Piper - measuring latency and errors of rac calls made through wrapped piper.
+type Piper struct {
+	pipe        pipe.Piper
+	entrypoints map[string]string
+
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+}
+
+var (
+	_ pipe.Piper           = (*Piper)(nil)
+	_ prometheus.Collector = (*Piper)(nil)
+)
+
+// NewPiper - metrics are exported after Piper is registered in prometheus. Entrypoints map known addresses
+// to values of label, calls of other addresses come from requests and are labelled as other
+// so clients could not create any number of series.
+func NewPiper(p pipe.Piper, entrypoints map[string]string) *Piper {
+	return &Piper{
+		pipe:        p,
+		entrypoints: entrypoints,
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "onec_rac_call_duration_seconds",
+			Help:    "Duration of rac calls from start to exit",
+			Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		}, []string{"entrypoint", "command"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "onec_rac_call_errors_total",
+			Help: "Number of rac calls which were not started or exited with error",
+		}, []string{"entrypoint", "command"}),
+	}
+}
+
+// Run - running rac through wrapped piper, duration is observed on Wait of returned command.
+func (p *Piper) Run(ctx context.Context, arg ...string) (pipe.Commander, io.ReadCloser, error) {
+	entrypoint, command := callLabels(arg)
+
+	entrypoint, ok := p.entrypoints[entrypoint]
+	if !ok {
+		entrypoint = _otherEntrypoint
+	}
+
+	cmd, stdout, err := p.pipe.Run(ctx, arg...)
+	if err != nil {
+		p.errors.WithLabelValues(entrypoint, command).Inc()
+
+		return cmd, stdout, err
+	}
+
+	return &commander{
+		Commander: cmd,
+		duration:  p.duration.WithLabelValues(entrypoint, command),
+		errors:    p.errors.WithLabelValues(entrypoint, command),
+	}, stdout, nil
+}
+
+// Describe -.
+func (p *Piper) Describe(ch chan<- *prometheus.Desc) {
+	p.duration.Describe(ch)
+	p.errors.Describe(ch)
+}
+
+// Collect -.
+func (p *Piper) Collect(ch chan<- prometheus.Metric) {
+	p.duration.Collect(ch)
+	p.errors.Collect(ch)
+}
+
+type commander struct {
+	pipe.Commander
+
+	duration prometheus.Observer
+	errors   prometheus.Counter
+	started  time.Time
+}
+
+func (c *commander) Start() error {
+	c.started = time.Now()
+
+	err := c.Commander.Start()
+	if err != nil {
+		c.errors.Inc()
+	}
+
+	return err
+}
+
+func (c *commander) Wait() error {
+	err := c.Commander.Wait()
+
+	c.duration.Observe(time.Since(c.started).Seconds())
+
+	if err != nil {
+		c.errors.Inc()
+	}
+
+	return err
+}
+
+// callLabels - rac is run as "entrypoint object command [flags]", e.g. "localhost:1545 session list --cluster ...".
+func callLabels(arg []string) (string, string) {
+	switch {
+	case len(arg) >= 3:
+		return arg[0], strings.Join(arg[1:3], " ")
+	case len(arg) == 2:
+		return arg[0], arg[1]
+	case len(arg) == 1:
+		return arg[0], ""
+	default:
+		return "", ""
+	}
+}
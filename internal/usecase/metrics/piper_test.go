@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	pm "github.com/antonmisa/1cctl/pkg/pipe/mocks"
+)
+
+func TestPiper(t *testing.T) {
+	t.Parallel()
+
+	cmdMock := pm.NewCommander(t)
+
+	cmdMock.On("Start").Return(nil)
+	cmdMock.On("Wait").Return(errors.New("exit status 255")).Once()
+	cmdMock.On("Wait").Return(nil).Once()
+
+	pipeMock := pm.NewPiper(t)
+
+	pipeMock.On("Run", context.Background(), "1capp01:1545", "session", "list", "--cluster", "1234-5678").
+		Return(cmdMock, nil, nil)
+
+	pipeMock.On("Run", context.Background(), "unknown:1545", "cluster", "list").
+		Return(nil, nil, errors.New("exec: rac not found"))
+
+	p := NewPiper(pipeMock, map[string]string{"1capp01:1545": "main"})
+
+	for i := 0; i < 2; i++ {
+		cmd, _, err := p.Run(context.Background(), "1capp01:1545", "session", "list", "--cluster", "1234-5678")
+		require.NoError(t, err)
+
+		require.NoError(t, cmd.Start())
+		_ = cmd.Wait()
+	}
+
+	_, _, err := p.Run(context.Background(), "unknown:1545", "cluster", "list")
+	require.Error(t, err)
+
+	expected := `
+# HELP onec_rac_call_errors_total Number of rac calls which were not started or exited with error
+# TYPE onec_rac_call_errors_total counter
+onec_rac_call_errors_total{command="cluster list",entrypoint="other"} 1
+onec_rac_call_errors_total{command="session list",entrypoint="main"} 1
+`
+
+	require.NoError(t, testutil.CollectAndCompare(p, strings.NewReader(expected), "onec_rac_call_errors_total"))
+	require.Equal(t, 3, testutil.CollectAndCount(p))
+}
@@ -14,6 +14,136 @@ type Ctrl struct {
 	mock.Mock
 }
 
+// AgentAdmins provides a mock function with given fields: ctx, entrypoint, agentCred
+func (_m *Ctrl) AgentAdmins(ctx context.Context, entrypoint string, agentCred entity.Credentials) ([]entity.Admin, error) {
+	ret := _m.Called(ctx, entrypoint, agentCred)
+
+	var r0 []entity.Admin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Credentials) ([]entity.Admin, error)); ok {
+		return rf(ctx, entrypoint, agentCred)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Credentials) []entity.Admin); ok {
+		r0 = rf(ctx, entrypoint, agentCred)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Admin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Credentials) error); ok {
+		r1 = rf(ctx, entrypoint, agentCred)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyRules provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, full
+func (_m *Ctrl) ApplyRules(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, full bool) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, full)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, bool) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, full)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Backup provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts
+func (_m *Ctrl) Backup(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, opts entity.BackupOptions) (entity.Job, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+
+	var r0 entity.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.Credentials, entity.BackupOptions) (entity.Job, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.Credentials, entity.BackupOptions) entity.Job); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+	} else {
+		r0 = ret.Get(0).(entity.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.Credentials, entity.BackupOptions) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Backups provides a mock function with given fields: ctx, cluster, infobase
+func (_m *Ctrl) Backups(ctx context.Context, cluster entity.Cluster, infobase entity.Infobase) ([]entity.Backup, error) {
+	ret := _m.Called(ctx, cluster, infobase)
+
+	var r0 []entity.Backup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Cluster, entity.Infobase) ([]entity.Backup, error)); ok {
+		return rf(ctx, cluster, infobase)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Cluster, entity.Infobase) []entity.Backup); ok {
+		r0 = rf(ctx, cluster, infobase)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Backup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Cluster, entity.Infobase) error); ok {
+		r1 = rf(ctx, cluster, infobase)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClearCounter provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, counter, object
+func (_m *Ctrl) ClearCounter(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, counter string, object string) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, counter, object)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, string, string) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, counter, object)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClusterAdmins provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred
+func (_m *Ctrl) ClusterAdmins(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Admin, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred)
+
+	var r0 []entity.Admin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) ([]entity.Admin, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) []entity.Admin); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Admin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Clusters provides a mock function with given fields: ctx, entrypoint, args
 func (_m *Ctrl) Clusters(ctx context.Context, entrypoint string, args map[string]interface{}) ([]entity.Cluster, error) {
 	ret := _m.Called(ctx, entrypoint, args)
@@ -66,6 +196,224 @@ func (_m *Ctrl) Connections(ctx context.Context, entrypoint string, cluster enti
 	return r0, r1
 }
 
+// CounterValues provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, counter, object
+func (_m *Ctrl) CounterValues(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, counter string, object string) ([]entity.CounterValue, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, counter, object)
+
+	var r0 []entity.CounterValue
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, string, string) ([]entity.CounterValue, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, counter, object)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, string, string) []entity.CounterValue); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, counter, object)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CounterValue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, string, string) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, counter, object)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Counters provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred
+func (_m *Ctrl) Counters(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Counter, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred)
+
+	var r0 []entity.Counter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) ([]entity.Counter, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) []entity.Counter); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Counter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateInfobase provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, opts
+func (_m *Ctrl) CreateInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.InfobaseCreateOptions) (entity.Infobase, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, opts)
+
+	var r0 entity.Infobase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.InfobaseCreateOptions) (entity.Infobase, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.InfobaseCreateOptions) entity.Infobase); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r0 = ret.Get(0).(entity.Infobase)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.InfobaseCreateOptions) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateLimit provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, opts
+func (_m *Ctrl) CreateLimit(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.LimitOptions) (entity.Limit, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, opts)
+
+	var r0 entity.Limit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.LimitOptions) (entity.Limit, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.LimitOptions) entity.Limit); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r0 = ret.Get(0).(entity.Limit)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.LimitOptions) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateProfile provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, opts
+func (_m *Ctrl) CreateProfile(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.ProfileOptions) (entity.Profile, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, opts)
+
+	var r0 entity.Profile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.ProfileOptions) (entity.Profile, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.ProfileOptions) entity.Profile); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r0 = ret.Get(0).(entity.Profile)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.ProfileOptions) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteConnection provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, connection
+func (_m *Ctrl) DeleteConnection(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, connection entity.Connection) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, connection)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Connection) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, connection)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteConnections provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, connections
+func (_m *Ctrl) DeleteConnections(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, connections []entity.Connection) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, connections)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, []entity.Connection) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, connections)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSession provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, session
+func (_m *Ctrl) DeleteSession(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, session entity.Session) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, session)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Session) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, session)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSessions provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, sessions
+func (_m *Ctrl) DeleteSessions(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, sessions []entity.Session) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, sessions)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, []entity.Session) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, sessions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DropInfobase provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts
+func (_m *Ctrl) DropInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, opts entity.InfobaseDropOptions) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.Credentials, entity.InfobaseDropOptions) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InfobaseDetails provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred
+func (_m *Ctrl) InfobaseDetails(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials) (entity.InfobaseDetails, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred)
+
+	var r0 entity.InfobaseDetails
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.Credentials) (entity.InfobaseDetails, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.Credentials) entity.InfobaseDetails); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred)
+	} else {
+		r0 = ret.Get(0).(entity.InfobaseDetails)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.Credentials) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Infobases provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, args
 func (_m *Ctrl) Infobases(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, args map[string]interface{}) ([]entity.Infobase, error) {
 	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, args)
@@ -92,6 +440,434 @@ func (_m *Ctrl) Infobases(ctx context.Context, entrypoint string, cluster entity
 	return r0, r1
 }
 
+// InsertCluster provides a mock function with given fields: ctx, entrypoint, agentCred, opts
+func (_m *Ctrl) InsertCluster(ctx context.Context, entrypoint string, agentCred entity.Credentials, opts entity.ClusterInsertOptions) (entity.Cluster, error) {
+	ret := _m.Called(ctx, entrypoint, agentCred, opts)
+
+	var r0 entity.Cluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Credentials, entity.ClusterInsertOptions) (entity.Cluster, error)); ok {
+		return rf(ctx, entrypoint, agentCred, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Credentials, entity.ClusterInsertOptions) entity.Cluster); ok {
+		r0 = rf(ctx, entrypoint, agentCred, opts)
+	} else {
+		r0 = ret.Get(0).(entity.Cluster)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Credentials, entity.ClusterInsertOptions) error); ok {
+		r1 = rf(ctx, entrypoint, agentCred, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertRule provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, server, opts
+func (_m *Ctrl) InsertRule(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, opts entity.RuleOptions) (entity.Rule, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, server, opts)
+
+	var r0 entity.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Server, entity.RuleOptions) (entity.Rule, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, server, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Server, entity.RuleOptions) entity.Rule); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, server, opts)
+	} else {
+		r0 = ret.Get(0).(entity.Rule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Server, entity.RuleOptions) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, server, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Job provides a mock function with given fields: ctx, id
+func (_m *Ctrl) Job(ctx context.Context, id string) (entity.Job, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 entity.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Job, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Job); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Limits provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred
+func (_m *Ctrl) Limits(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Limit, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred)
+
+	var r0 []entity.Limit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) ([]entity.Limit, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) []entity.Limit); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Limit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockInfobase provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts
+func (_m *Ctrl) LockInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, opts entity.LockOptions) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.Credentials, entity.LockOptions) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Locks provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, infobase, filter, args
+func (_m *Ctrl) Locks(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, filter entity.LockFilter, args map[string]interface{}) ([]entity.Lock, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, infobase, filter, args)
+
+	var r0 []entity.Lock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.LockFilter, map[string]interface{}) ([]entity.Lock, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, infobase, filter, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.LockFilter, map[string]interface{}) []entity.Lock); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, infobase, filter, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Lock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.LockFilter, map[string]interface{}) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, infobase, filter, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Managers provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, args
+func (_m *Ctrl) Managers(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, args map[string]interface{}) ([]entity.Manager, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, args)
+
+	var r0 []entity.Manager
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, map[string]interface{}) ([]entity.Manager, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, map[string]interface{}) []entity.Manager); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Manager)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, map[string]interface{}) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Processes provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, args
+func (_m *Ctrl) Processes(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, args map[string]interface{}) ([]entity.Process, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, args)
+
+	var r0 []entity.Process
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, map[string]interface{}) ([]entity.Process, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, map[string]interface{}) []entity.Process); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Process)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, map[string]interface{}) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Profiles provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred
+func (_m *Ctrl) Profiles(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Profile, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred)
+
+	var r0 []entity.Profile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) ([]entity.Profile, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) []entity.Profile); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Profile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegisterAgentAdmin provides a mock function with given fields: ctx, entrypoint, agentCred, opts
+func (_m *Ctrl) RegisterAgentAdmin(ctx context.Context, entrypoint string, agentCred entity.Credentials, opts entity.AdminOptions) error {
+	ret := _m.Called(ctx, entrypoint, agentCred, opts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Credentials, entity.AdminOptions) error); ok {
+		r0 = rf(ctx, entrypoint, agentCred, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RegisterClusterAdmin provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, opts
+func (_m *Ctrl) RegisterClusterAdmin(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.AdminOptions) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, opts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.AdminOptions) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveAgentAdmin provides a mock function with given fields: ctx, entrypoint, agentCred, name
+func (_m *Ctrl) RemoveAgentAdmin(ctx context.Context, entrypoint string, agentCred entity.Credentials, name string) error {
+	ret := _m.Called(ctx, entrypoint, agentCred, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Credentials, string) error); ok {
+		r0 = rf(ctx, entrypoint, agentCred, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveCluster provides a mock function with given fields: ctx, entrypoint, agentCred, cluster, clusterCred
+func (_m *Ctrl) RemoveCluster(ctx context.Context, entrypoint string, agentCred entity.Credentials, cluster entity.Cluster, clusterCred entity.Credentials) error {
+	ret := _m.Called(ctx, entrypoint, agentCred, cluster, clusterCred)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Credentials, entity.Cluster, entity.Credentials) error); ok {
+		r0 = rf(ctx, entrypoint, agentCred, cluster, clusterCred)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveClusterAdmin provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, name
+func (_m *Ctrl) RemoveClusterAdmin(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, string) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveLimit provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, name
+func (_m *Ctrl) RemoveLimit(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, string) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveProfile provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, name
+func (_m *Ctrl) RemoveProfile(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, string) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveRule provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, server, rule
+func (_m *Ctrl) RemoveRule(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, rule string) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, server, rule)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Server, string) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, server, rule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Restore provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts
+func (_m *Ctrl) Restore(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, opts entity.RestoreOptions) (entity.Job, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+
+	var r0 entity.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.Credentials, entity.RestoreOptions) (entity.Job, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.Credentials, entity.RestoreOptions) entity.Job); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+	} else {
+		r0 = ret.Get(0).(entity.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.Credentials, entity.RestoreOptions) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Rules provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, server
+func (_m *Ctrl) Rules(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server) ([]entity.Rule, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, server)
+
+	var r0 []entity.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Server) ([]entity.Rule, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, server)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Server) []entity.Rule); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, server)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Rule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Server) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SafeBackup provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts
+func (_m *Ctrl) SafeBackup(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, opts entity.SafeBackupOptions) (entity.Job, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+
+	var r0 entity.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.Credentials, entity.SafeBackupOptions) (entity.Job, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.Credentials, entity.SafeBackupOptions) entity.Job); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+	} else {
+		r0 = ret.Get(0).(entity.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.Credentials, entity.SafeBackupOptions) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Servers provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, args
+func (_m *Ctrl) Servers(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, args map[string]interface{}) ([]entity.Server, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, args)
+
+	var r0 []entity.Server
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, map[string]interface{}) ([]entity.Server, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, map[string]interface{}) []entity.Server); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Server)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, map[string]interface{}) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Sessions provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, infobase, args
 func (_m *Ctrl) Sessions(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, args map[string]interface{}) ([]entity.Session, error) {
 	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, infobase, args)
@@ -118,6 +894,140 @@ func (_m *Ctrl) Sessions(ctx context.Context, entrypoint string, cluster entity.
 	return r0, r1
 }
 
+// UnlockInfobase provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, code
+func (_m *Ctrl) UnlockInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, code string) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, code)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.Credentials, string) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCluster provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, opts
+func (_m *Ctrl) UpdateCluster(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.ClusterUpdateOptions) (entity.Cluster, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, opts)
+
+	var r0 entity.Cluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.ClusterUpdateOptions) (entity.Cluster, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.ClusterUpdateOptions) entity.Cluster); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r0 = ret.Get(0).(entity.Cluster)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.ClusterUpdateOptions) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateInfobase provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts
+func (_m *Ctrl) UpdateInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, opts entity.InfobaseUpdateOptions) (entity.InfobaseDetails, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+
+	var r0 entity.InfobaseDetails
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.Credentials, entity.InfobaseUpdateOptions) (entity.InfobaseDetails, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.Credentials, entity.InfobaseUpdateOptions) entity.InfobaseDetails); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+	} else {
+		r0 = ret.Get(0).(entity.InfobaseDetails)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Infobase, entity.Credentials, entity.InfobaseUpdateOptions) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, infobase, infobaseCred, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateLimit provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, opts
+func (_m *Ctrl) UpdateLimit(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.LimitOptions) (entity.Limit, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, opts)
+
+	var r0 entity.Limit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.LimitOptions) (entity.Limit, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.LimitOptions) entity.Limit); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r0 = ret.Get(0).(entity.Limit)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.LimitOptions) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateProfile provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, opts
+func (_m *Ctrl) UpdateProfile(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.ProfileOptions) (entity.Profile, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, opts)
+
+	var r0 entity.Profile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.ProfileOptions) (entity.Profile, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.ProfileOptions) entity.Profile); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r0 = ret.Get(0).(entity.Profile)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.ProfileOptions) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateRule provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, server, rule, opts
+func (_m *Ctrl) UpdateRule(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, rule string, opts entity.RuleOptions) (entity.Rule, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, server, rule, opts)
+
+	var r0 entity.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Server, string, entity.RuleOptions) (entity.Rule, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, server, rule, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Server, string, entity.RuleOptions) entity.Rule); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, server, rule, opts)
+	} else {
+		r0 = ret.Get(0).(entity.Rule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Server, string, entity.RuleOptions) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, server, rule, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewCtrl creates a new instance of Ctrl. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewCtrl(t interface {
@@ -15,17 +15,51 @@ type CtrlBackup struct {
 }
 
 // RunBackup provides a mock function with given fields: ctx, cluster, infobase, infobaseCred, lockCode, outputPath
-func (_m *CtrlBackup) RunBackup(ctx context.Context, cluster entity.Cluster, infobase entity.Infobase, infobaseCred entity.Credentials, lockCode string, outputPath string) error {
+func (_m *CtrlBackup) RunBackup(ctx context.Context, cluster entity.Cluster, infobase entity.Infobase, infobaseCred entity.Credentials, lockCode string, outputPath string) (entity.DesignerResult, error) {
 	ret := _m.Called(ctx, cluster, infobase, infobaseCred, lockCode, outputPath)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, entity.Cluster, entity.Infobase, entity.Credentials, string, string) error); ok {
+	var r0 entity.DesignerResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Cluster, entity.Infobase, entity.Credentials, string, string) (entity.DesignerResult, error)); ok {
+		return rf(ctx, cluster, infobase, infobaseCred, lockCode, outputPath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Cluster, entity.Infobase, entity.Credentials, string, string) entity.DesignerResult); ok {
 		r0 = rf(ctx, cluster, infobase, infobaseCred, lockCode, outputPath)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(entity.DesignerResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Cluster, entity.Infobase, entity.Credentials, string, string) error); ok {
+		r1 = rf(ctx, cluster, infobase, infobaseCred, lockCode, outputPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunRestore provides a mock function with given fields: ctx, cluster, infobase, infobaseCred, lockCode, inputPath
+func (_m *CtrlBackup) RunRestore(ctx context.Context, cluster entity.Cluster, infobase entity.Infobase, infobaseCred entity.Credentials, lockCode string, inputPath string) (entity.DesignerResult, error) {
+	ret := _m.Called(ctx, cluster, infobase, infobaseCred, lockCode, inputPath)
+
+	var r0 entity.DesignerResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Cluster, entity.Infobase, entity.Credentials, string, string) (entity.DesignerResult, error)); ok {
+		return rf(ctx, cluster, infobase, infobaseCred, lockCode, inputPath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Cluster, entity.Infobase, entity.Credentials, string, string) entity.DesignerResult); ok {
+		r0 = rf(ctx, cluster, infobase, infobaseCred, lockCode, inputPath)
+	} else {
+		r0 = ret.Get(0).(entity.DesignerResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Cluster, entity.Infobase, entity.Credentials, string, string) error); ok {
+		r1 = rf(ctx, cluster, infobase, infobaseCred, lockCode, inputPath)
+	} else {
+		r1 = ret.Error(1)
 	}
 
-	return r0
+	return r0, r1
 }
 
 // NewCtrlBackup creates a new instance of CtrlBackup. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -14,6 +14,62 @@ type CtrlCache struct {
 	mock.Mock
 }
 
+// DeleteClusters provides a mock function with given fields: ctx, entrypoint
+func (_m *CtrlCache) DeleteClusters(ctx context.Context, entrypoint string) error {
+	ret := _m.Called(ctx, entrypoint)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, entrypoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteConnections provides a mock function with given fields: ctx, entrypoint, cluster
+func (_m *CtrlCache) DeleteConnections(ctx context.Context, entrypoint string, cluster entity.Cluster) error {
+	ret := _m.Called(ctx, entrypoint, cluster)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster) error); ok {
+		r0 = rf(ctx, entrypoint, cluster)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteInfobases provides a mock function with given fields: ctx, entrypoint, cluster
+func (_m *CtrlCache) DeleteInfobases(ctx context.Context, entrypoint string, cluster entity.Cluster) error {
+	ret := _m.Called(ctx, entrypoint, cluster)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster) error); ok {
+		r0 = rf(ctx, entrypoint, cluster)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSessions provides a mock function with given fields: ctx, entrypoint, cluster
+func (_m *CtrlCache) DeleteSessions(ctx context.Context, entrypoint string, cluster entity.Cluster) error {
+	ret := _m.Called(ctx, entrypoint, cluster)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster) error); ok {
+		r0 = rf(ctx, entrypoint, cluster)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetClusters provides a mock function with given fields: ctx, entrypoint
 func (_m *CtrlCache) GetClusters(ctx context.Context, entrypoint string) ([]entity.Cluster, error) {
 	ret := _m.Called(ctx, entrypoint)
@@ -92,6 +148,84 @@ func (_m *CtrlCache) GetInfobases(ctx context.Context, entrypoint string, cluste
 	return r0, r1
 }
 
+// GetManagers provides a mock function with given fields: ctx, entrypoint, cluster
+func (_m *CtrlCache) GetManagers(ctx context.Context, entrypoint string, cluster entity.Cluster) ([]entity.Manager, error) {
+	ret := _m.Called(ctx, entrypoint, cluster)
+
+	var r0 []entity.Manager
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster) ([]entity.Manager, error)); ok {
+		return rf(ctx, entrypoint, cluster)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster) []entity.Manager); ok {
+		r0 = rf(ctx, entrypoint, cluster)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Manager)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster) error); ok {
+		r1 = rf(ctx, entrypoint, cluster)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProcesses provides a mock function with given fields: ctx, entrypoint, cluster
+func (_m *CtrlCache) GetProcesses(ctx context.Context, entrypoint string, cluster entity.Cluster) ([]entity.Process, error) {
+	ret := _m.Called(ctx, entrypoint, cluster)
+
+	var r0 []entity.Process
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster) ([]entity.Process, error)); ok {
+		return rf(ctx, entrypoint, cluster)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster) []entity.Process); ok {
+		r0 = rf(ctx, entrypoint, cluster)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Process)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster) error); ok {
+		r1 = rf(ctx, entrypoint, cluster)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetServers provides a mock function with given fields: ctx, entrypoint, cluster
+func (_m *CtrlCache) GetServers(ctx context.Context, entrypoint string, cluster entity.Cluster) ([]entity.Server, error) {
+	ret := _m.Called(ctx, entrypoint, cluster)
+
+	var r0 []entity.Server
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster) ([]entity.Server, error)); ok {
+		return rf(ctx, entrypoint, cluster)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster) []entity.Server); ok {
+		r0 = rf(ctx, entrypoint, cluster)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Server)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster) error); ok {
+		r1 = rf(ctx, entrypoint, cluster)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSessions provides a mock function with given fields: ctx, entrypoint, cluster, infobase
 func (_m *CtrlCache) GetSessions(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase) ([]entity.Session, error) {
 	ret := _m.Called(ctx, entrypoint, cluster, infobase)
@@ -160,6 +294,48 @@ func (_m *CtrlCache) PutInfobases(ctx context.Context, entrypoint string, cluste
 	return r0
 }
 
+// PutManagers provides a mock function with given fields: ctx, entrypoint, cluster, managers
+func (_m *CtrlCache) PutManagers(ctx context.Context, entrypoint string, cluster entity.Cluster, managers []entity.Manager) error {
+	ret := _m.Called(ctx, entrypoint, cluster, managers)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, []entity.Manager) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, managers)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutProcesses provides a mock function with given fields: ctx, entrypoint, cluster, processes
+func (_m *CtrlCache) PutProcesses(ctx context.Context, entrypoint string, cluster entity.Cluster, processes []entity.Process) error {
+	ret := _m.Called(ctx, entrypoint, cluster, processes)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, []entity.Process) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, processes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutServers provides a mock function with given fields: ctx, entrypoint, cluster, servers
+func (_m *CtrlCache) PutServers(ctx context.Context, entrypoint string, cluster entity.Cluster, servers []entity.Server) error {
+	ret := _m.Called(ctx, entrypoint, cluster, servers)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, []entity.Server) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, servers)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutSessions provides a mock function with given fields: ctx, entrypoint, cluster, infobase, sessions
 func (_m *CtrlCache) PutSessions(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, sessions []entity.Session) error {
 	ret := _m.Called(ctx, entrypoint, cluster, infobase, sessions)
@@ -0,0 +1,131 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/antonmisa/1cctl/internal/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// CtrlCatalog is an autogenerated mock type for the CtrlCatalog type
+type CtrlCatalog struct {
+	mock.Mock
+}
+
+// Add provides a mock function with given fields: ctx, backup
+func (_m *CtrlCatalog) Add(ctx context.Context, backup entity.Backup) (entity.Backup, error) {
+	ret := _m.Called(ctx, backup)
+
+	var r0 entity.Backup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Backup) (entity.Backup, error)); ok {
+		return rf(ctx, backup)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Backup) entity.Backup); ok {
+		r0 = rf(ctx, backup)
+	} else {
+		r0 = ret.Get(0).(entity.Backup)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Backup) error); ok {
+		r1 = rf(ctx, backup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *CtrlCatalog) List(ctx context.Context) ([]entity.Backup, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []entity.Backup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.Backup, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.Backup); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Backup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Path provides a mock function with given fields: ctx, cluster, infobase, created
+func (_m *CtrlCatalog) Path(ctx context.Context, cluster entity.Cluster, infobase entity.Infobase, created time.Time) (string, error) {
+	ret := _m.Called(ctx, cluster, infobase, created)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Cluster, entity.Infobase, time.Time) (string, error)); ok {
+		return rf(ctx, cluster, infobase, created)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Cluster, entity.Infobase, time.Time) string); ok {
+		r0 = rf(ctx, cluster, infobase, created)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Cluster, entity.Infobase, time.Time) error); ok {
+		r1 = rf(ctx, cluster, infobase, created)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Prune provides a mock function with given fields: ctx, cluster, infobase, policy
+func (_m *CtrlCatalog) Prune(ctx context.Context, cluster entity.Cluster, infobase entity.Infobase, policy entity.RetentionPolicy) ([]entity.Backup, error) {
+	ret := _m.Called(ctx, cluster, infobase, policy)
+
+	var r0 []entity.Backup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Cluster, entity.Infobase, entity.RetentionPolicy) ([]entity.Backup, error)); ok {
+		return rf(ctx, cluster, infobase, policy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Cluster, entity.Infobase, entity.RetentionPolicy) []entity.Backup); ok {
+		r0 = rf(ctx, cluster, infobase, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Backup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Cluster, entity.Infobase, entity.RetentionPolicy) error); ok {
+		r1 = rf(ctx, cluster, infobase, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCtrlCatalog creates a new instance of CtrlCatalog. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCtrlCatalog(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CtrlCatalog {
+	mock := &CtrlCatalog{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
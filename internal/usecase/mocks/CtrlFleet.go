@@ -0,0 +1,99 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/antonmisa/1cctl/internal/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CtrlFleet is an autogenerated mock type for the CtrlFleet type
+type CtrlFleet struct {
+	mock.Mock
+}
+
+// Infobases provides a mock function with given fields: ctx, filter, args
+func (_m *CtrlFleet) Infobases(ctx context.Context, filter entity.FleetFilter, args map[string]interface{}) ([]entity.FleetInfobase, []entity.FleetError, error) {
+	ret := _m.Called(ctx, filter, args)
+
+	var r0 []entity.FleetInfobase
+	var r1 []entity.FleetError
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.FleetFilter, map[string]interface{}) ([]entity.FleetInfobase, []entity.FleetError, error)); ok {
+		return rf(ctx, filter, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.FleetFilter, map[string]interface{}) []entity.FleetInfobase); ok {
+		r0 = rf(ctx, filter, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.FleetInfobase)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.FleetFilter, map[string]interface{}) []entity.FleetError); ok {
+		r1 = rf(ctx, filter, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]entity.FleetError)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, entity.FleetFilter, map[string]interface{}) error); ok {
+		r2 = rf(ctx, filter, args)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Sessions provides a mock function with given fields: ctx, filter, args
+func (_m *CtrlFleet) Sessions(ctx context.Context, filter entity.FleetFilter, args map[string]interface{}) ([]entity.FleetSession, []entity.FleetError, error) {
+	ret := _m.Called(ctx, filter, args)
+
+	var r0 []entity.FleetSession
+	var r1 []entity.FleetError
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.FleetFilter, map[string]interface{}) ([]entity.FleetSession, []entity.FleetError, error)); ok {
+		return rf(ctx, filter, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.FleetFilter, map[string]interface{}) []entity.FleetSession); ok {
+		r0 = rf(ctx, filter, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.FleetSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.FleetFilter, map[string]interface{}) []entity.FleetError); ok {
+		r1 = rf(ctx, filter, args)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]entity.FleetError)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, entity.FleetFilter, map[string]interface{}) error); ok {
+		r2 = rf(ctx, filter, args)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NewCtrlFleet creates a new instance of CtrlFleet. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCtrlFleet(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CtrlFleet {
+	mock := &CtrlFleet{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
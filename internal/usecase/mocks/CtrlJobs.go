@@ -0,0 +1,79 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/antonmisa/1cctl/internal/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	usecase "github.com/antonmisa/1cctl/internal/usecase"
+)
+
+// CtrlJobs is an autogenerated mock type for the CtrlJobs type
+type CtrlJobs struct {
+	mock.Mock
+}
+
+// Enqueue provides a mock function with given fields: ctx, job, run
+func (_m *CtrlJobs) Enqueue(ctx context.Context, job entity.Job, run usecase.JobFunc) (entity.Job, error) {
+	ret := _m.Called(ctx, job, run)
+
+	var r0 entity.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Job, usecase.JobFunc) (entity.Job, error)); ok {
+		return rf(ctx, job, run)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Job, usecase.JobFunc) entity.Job); ok {
+		r0 = rf(ctx, job, run)
+	} else {
+		r0 = ret.Get(0).(entity.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Job, usecase.JobFunc) error); ok {
+		r1 = rf(ctx, job, run)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *CtrlJobs) Get(ctx context.Context, id string) (entity.Job, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 entity.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Job, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Job); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCtrlJobs creates a new instance of CtrlJobs. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCtrlJobs(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CtrlJobs {
+	mock := &CtrlJobs{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -14,6 +14,58 @@ type CtrlPipe struct {
 	mock.Mock
 }
 
+// ApplyRules provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, full
+func (_m *CtrlPipe) ApplyRules(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, full bool) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, full)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, bool) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, full)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClearCounter provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, counter, object
+func (_m *CtrlPipe) ClearCounter(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, counter string, object string) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, counter, object)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, string, string) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, counter, object)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateInfobase provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, opts
+func (_m *CtrlPipe) CreateInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.InfobaseCreateOptions) (entity.Infobase, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, opts)
+
+	var r0 entity.Infobase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.InfobaseCreateOptions) (entity.Infobase, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.InfobaseCreateOptions) entity.Infobase); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r0 = ret.Get(0).(entity.Infobase)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.InfobaseCreateOptions) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteConnection provides a mock function with given fields: ctx, entrypoint, cluster, connection, clusterCred
 func (_m *CtrlPipe) DeleteConnection(ctx context.Context, entrypoint string, cluster entity.Cluster, connection entity.Connection, clusterCred entity.Credentials) error {
 	ret := _m.Called(ctx, entrypoint, cluster, connection, clusterCred)
@@ -70,13 +122,27 @@ func (_m *CtrlPipe) DeleteSessions(ctx context.Context, entrypoint string, clust
 	return r0
 }
 
-// DisableSessions provides a mock function with given fields: ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred, code
-func (_m *CtrlPipe) DisableSessions(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, infobaseCred entity.Credentials, code string) error {
-	ret := _m.Called(ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred, code)
+// DisableSessions provides a mock function with given fields: ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred, opts
+func (_m *CtrlPipe) DisableSessions(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, infobaseCred entity.Credentials, opts entity.LockOptions) error {
+	ret := _m.Called(ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred, opts)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Infobase, entity.Credentials, entity.Credentials, string) error); ok {
-		r0 = rf(ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred, code)
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Infobase, entity.Credentials, entity.Credentials, entity.LockOptions) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DropInfobase provides a mock function with given fields: ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred, opts
+func (_m *CtrlPipe) DropInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, infobaseCred entity.Credentials, opts entity.InfobaseDropOptions) error {
+	ret := _m.Called(ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred, opts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Infobase, entity.Credentials, entity.Credentials, entity.InfobaseDropOptions) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred, opts)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -98,6 +164,58 @@ func (_m *CtrlPipe) EnableSessions(ctx context.Context, entrypoint string, clust
 	return r0
 }
 
+// GetAgentAdmins provides a mock function with given fields: ctx, entrypoint, agentCred
+func (_m *CtrlPipe) GetAgentAdmins(ctx context.Context, entrypoint string, agentCred entity.Credentials) ([]entity.Admin, error) {
+	ret := _m.Called(ctx, entrypoint, agentCred)
+
+	var r0 []entity.Admin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Credentials) ([]entity.Admin, error)); ok {
+		return rf(ctx, entrypoint, agentCred)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Credentials) []entity.Admin); ok {
+		r0 = rf(ctx, entrypoint, agentCred)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Admin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Credentials) error); ok {
+		r1 = rf(ctx, entrypoint, agentCred)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetClusterAdmins provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred
+func (_m *CtrlPipe) GetClusterAdmins(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Admin, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred)
+
+	var r0 []entity.Admin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) ([]entity.Admin, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) []entity.Admin); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Admin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetClusters provides a mock function with given fields: ctx, entrypoint
 func (_m *CtrlPipe) GetClusters(ctx context.Context, entrypoint string) ([]entity.Cluster, error) {
 	ret := _m.Called(ctx, entrypoint)
@@ -150,6 +268,82 @@ func (_m *CtrlPipe) GetConnections(ctx context.Context, entrypoint string, clust
 	return r0, r1
 }
 
+// GetCounterValues provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, counter, object
+func (_m *CtrlPipe) GetCounterValues(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, counter string, object string) ([]entity.CounterValue, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, counter, object)
+
+	var r0 []entity.CounterValue
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, string, string) ([]entity.CounterValue, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, counter, object)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, string, string) []entity.CounterValue); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, counter, object)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CounterValue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, string, string) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, counter, object)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCounters provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred
+func (_m *CtrlPipe) GetCounters(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Counter, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred)
+
+	var r0 []entity.Counter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) ([]entity.Counter, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) []entity.Counter); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Counter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInfobaseDetails provides a mock function with given fields: ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred
+func (_m *CtrlPipe) GetInfobaseDetails(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, infobaseCred entity.Credentials) (entity.InfobaseDetails, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred)
+
+	var r0 entity.InfobaseDetails
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Infobase, entity.Credentials, entity.Credentials) (entity.InfobaseDetails, error)); ok {
+		return rf(ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Infobase, entity.Credentials, entity.Credentials) entity.InfobaseDetails); ok {
+		r0 = rf(ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred)
+	} else {
+		r0 = ret.Get(0).(entity.InfobaseDetails)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Infobase, entity.Credentials, entity.Credentials) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetInfobases provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred
 func (_m *CtrlPipe) GetInfobases(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Infobase, error) {
 	ret := _m.Called(ctx, entrypoint, cluster, clusterCred)
@@ -176,6 +370,188 @@ func (_m *CtrlPipe) GetInfobases(ctx context.Context, entrypoint string, cluster
 	return r0, r1
 }
 
+// GetLimits provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred
+func (_m *CtrlPipe) GetLimits(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Limit, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred)
+
+	var r0 []entity.Limit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) ([]entity.Limit, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) []entity.Limit); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Limit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLocks provides a mock function with given fields: ctx, entrypoint, cluster, infobase, clusterCred, filter
+func (_m *CtrlPipe) GetLocks(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, filter entity.LockFilter) ([]entity.Lock, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, infobase, clusterCred, filter)
+
+	var r0 []entity.Lock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Infobase, entity.Credentials, entity.LockFilter) ([]entity.Lock, error)); ok {
+		return rf(ctx, entrypoint, cluster, infobase, clusterCred, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Infobase, entity.Credentials, entity.LockFilter) []entity.Lock); ok {
+		r0 = rf(ctx, entrypoint, cluster, infobase, clusterCred, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Lock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Infobase, entity.Credentials, entity.LockFilter) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, infobase, clusterCred, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetManagers provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred
+func (_m *CtrlPipe) GetManagers(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Manager, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred)
+
+	var r0 []entity.Manager
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) ([]entity.Manager, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) []entity.Manager); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Manager)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProcesses provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred
+func (_m *CtrlPipe) GetProcesses(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Process, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred)
+
+	var r0 []entity.Process
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) ([]entity.Process, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) []entity.Process); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Process)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProfiles provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred
+func (_m *CtrlPipe) GetProfiles(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Profile, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred)
+
+	var r0 []entity.Profile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) ([]entity.Profile, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) []entity.Profile); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Profile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRules provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, server
+func (_m *CtrlPipe) GetRules(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server) ([]entity.Rule, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, server)
+
+	var r0 []entity.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Server) ([]entity.Rule, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, server)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Server) []entity.Rule); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, server)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Rule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Server) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, server)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetServers provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred
+func (_m *CtrlPipe) GetServers(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Server, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred)
+
+	var r0 []entity.Server
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) ([]entity.Server, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) []entity.Server); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Server)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSessions provides a mock function with given fields: ctx, entrypoint, cluster, infobase, clusterCred
 func (_m *CtrlPipe) GetSessions(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials) ([]entity.Session, error) {
 	ret := _m.Called(ctx, entrypoint, cluster, infobase, clusterCred)
@@ -202,6 +578,236 @@ func (_m *CtrlPipe) GetSessions(ctx context.Context, entrypoint string, cluster
 	return r0, r1
 }
 
+// InsertCluster provides a mock function with given fields: ctx, entrypoint, agentCred, opts
+func (_m *CtrlPipe) InsertCluster(ctx context.Context, entrypoint string, agentCred entity.Credentials, opts entity.ClusterInsertOptions) (entity.Cluster, error) {
+	ret := _m.Called(ctx, entrypoint, agentCred, opts)
+
+	var r0 entity.Cluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Credentials, entity.ClusterInsertOptions) (entity.Cluster, error)); ok {
+		return rf(ctx, entrypoint, agentCred, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Credentials, entity.ClusterInsertOptions) entity.Cluster); ok {
+		r0 = rf(ctx, entrypoint, agentCred, opts)
+	} else {
+		r0 = ret.Get(0).(entity.Cluster)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Credentials, entity.ClusterInsertOptions) error); ok {
+		r1 = rf(ctx, entrypoint, agentCred, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertRule provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, server, opts
+func (_m *CtrlPipe) InsertRule(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, opts entity.RuleOptions) (entity.Rule, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, server, opts)
+
+	var r0 entity.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Server, entity.RuleOptions) (entity.Rule, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred, server, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Server, entity.RuleOptions) entity.Rule); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, server, opts)
+	} else {
+		r0 = ret.Get(0).(entity.Rule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Server, entity.RuleOptions) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred, server, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegisterAgentAdmin provides a mock function with given fields: ctx, entrypoint, agentCred, opts
+func (_m *CtrlPipe) RegisterAgentAdmin(ctx context.Context, entrypoint string, agentCred entity.Credentials, opts entity.AdminOptions) error {
+	ret := _m.Called(ctx, entrypoint, agentCred, opts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Credentials, entity.AdminOptions) error); ok {
+		r0 = rf(ctx, entrypoint, agentCred, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RegisterClusterAdmin provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, opts
+func (_m *CtrlPipe) RegisterClusterAdmin(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.AdminOptions) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, opts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.AdminOptions) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveAgentAdmin provides a mock function with given fields: ctx, entrypoint, agentCred, name
+func (_m *CtrlPipe) RemoveAgentAdmin(ctx context.Context, entrypoint string, agentCred entity.Credentials, name string) error {
+	ret := _m.Called(ctx, entrypoint, agentCred, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Credentials, string) error); ok {
+		r0 = rf(ctx, entrypoint, agentCred, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveCluster provides a mock function with given fields: ctx, entrypoint, agentCred, cluster, clusterCred
+func (_m *CtrlPipe) RemoveCluster(ctx context.Context, entrypoint string, agentCred entity.Credentials, cluster entity.Cluster, clusterCred entity.Credentials) error {
+	ret := _m.Called(ctx, entrypoint, agentCred, cluster, clusterCred)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Credentials, entity.Cluster, entity.Credentials) error); ok {
+		r0 = rf(ctx, entrypoint, agentCred, cluster, clusterCred)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveClusterAdmin provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, name
+func (_m *CtrlPipe) RemoveClusterAdmin(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, string) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveLimit provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, name
+func (_m *CtrlPipe) RemoveLimit(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, string) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveProfile provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, name
+func (_m *CtrlPipe) RemoveProfile(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, string) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveRule provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, server, rule
+func (_m *CtrlPipe) RemoveRule(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, rule string) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, server, rule)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Server, string) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, server, rule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCluster provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, opts
+func (_m *CtrlPipe) UpdateCluster(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.ClusterUpdateOptions) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, opts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.ClusterUpdateOptions) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateInfobase provides a mock function with given fields: ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred, opts
+func (_m *CtrlPipe) UpdateInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, infobaseCred entity.Credentials, opts entity.InfobaseUpdateOptions) error {
+	ret := _m.Called(ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred, opts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Infobase, entity.Credentials, entity.Credentials, entity.InfobaseUpdateOptions) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, infobase, clusterCred, infobaseCred, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateLimit provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, opts
+func (_m *CtrlPipe) UpdateLimit(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.LimitOptions) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, opts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.LimitOptions) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateProfile provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, opts
+func (_m *CtrlPipe) UpdateProfile(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.ProfileOptions) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, opts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.ProfileOptions) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateRule provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred, server, rule, opts
+func (_m *CtrlPipe) UpdateRule(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, rule string, opts entity.RuleOptions) error {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred, server, rule, opts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials, entity.Server, string, entity.RuleOptions) error); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred, server, rule, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewCtrlPipe creates a new instance of CtrlPipe. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewCtrlPipe(t interface {
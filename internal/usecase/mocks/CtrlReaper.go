@@ -0,0 +1,55 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/antonmisa/1cctl/internal/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CtrlReaper is an autogenerated mock type for the CtrlReaper type
+type CtrlReaper struct {
+	mock.Mock
+}
+
+// Audit provides a mock function with given fields: ctx
+func (_m *CtrlReaper) Audit(ctx context.Context) ([]entity.ReapRecord, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []entity.ReapRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.ReapRecord, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.ReapRecord); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ReapRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCtrlReaper creates a new instance of CtrlReaper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCtrlReaper(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CtrlReaper {
+	mock := &CtrlReaper{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,93 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/antonmisa/1cctl/internal/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CtrlRegistry is an autogenerated mock type for the CtrlRegistry type
+type CtrlRegistry struct {
+	mock.Mock
+}
+
+// Allowed provides a mock function with given fields: ctx, address
+func (_m *CtrlRegistry) Allowed(ctx context.Context, address string) bool {
+	ret := _m.Called(ctx, address)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, address)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Entrypoint provides a mock function with given fields: ctx, name
+func (_m *CtrlRegistry) Entrypoint(ctx context.Context, name string) (entity.Entrypoint, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 entity.Entrypoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Entrypoint, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Entrypoint); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(entity.Entrypoint)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Entrypoints provides a mock function with given fields: ctx
+func (_m *CtrlRegistry) Entrypoints(ctx context.Context) ([]entity.Entrypoint, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []entity.Entrypoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.Entrypoint, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.Entrypoint); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Entrypoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCtrlRegistry creates a new instance of CtrlRegistry. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCtrlRegistry(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CtrlRegistry {
+	mock := &CtrlRegistry{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,55 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/antonmisa/1cctl/internal/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CtrlScheduler is an autogenerated mock type for the CtrlScheduler type
+type CtrlScheduler struct {
+	mock.Mock
+}
+
+// Schedules provides a mock function with given fields: ctx
+func (_m *CtrlScheduler) Schedules(ctx context.Context) ([]entity.Schedule, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []entity.Schedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.Schedule, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.Schedule); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Schedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCtrlScheduler creates a new instance of CtrlScheduler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCtrlScheduler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CtrlScheduler {
+	mock := &CtrlScheduler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
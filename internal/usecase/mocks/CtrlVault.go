@@ -0,0 +1,131 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/antonmisa/1cctl/internal/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CtrlVault is an autogenerated mock type for the CtrlVault type
+type CtrlVault struct {
+	mock.Mock
+}
+
+// ClusterCredentials provides a mock function with given fields: ctx, entrypoint, cluster
+func (_m *CtrlVault) ClusterCredentials(ctx context.Context, entrypoint string, cluster string) (entity.Credentials, bool) {
+	ret := _m.Called(ctx, entrypoint, cluster)
+
+	var r0 entity.Credentials
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (entity.Credentials, bool)); ok {
+		return rf(ctx, entrypoint, cluster)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) entity.Credentials); ok {
+		r0 = rf(ctx, entrypoint, cluster)
+	} else {
+		r0 = ret.Get(0).(entity.Credentials)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) bool); ok {
+		r1 = rf(ctx, entrypoint, cluster)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// InfobaseCredentials provides a mock function with given fields: ctx, entrypoint, cluster, infobase
+func (_m *CtrlVault) InfobaseCredentials(ctx context.Context, entrypoint string, cluster string, infobase string) (entity.Credentials, bool) {
+	ret := _m.Called(ctx, entrypoint, cluster, infobase)
+
+	var r0 entity.Credentials
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (entity.Credentials, bool)); ok {
+		return rf(ctx, entrypoint, cluster, infobase)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) entity.Credentials); ok {
+		r0 = rf(ctx, entrypoint, cluster, infobase)
+	} else {
+		r0 = ret.Get(0).(entity.Credentials)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) bool); ok {
+		r1 = rf(ctx, entrypoint, cluster, infobase)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// Keys provides a mock function with given fields: ctx
+func (_m *CtrlVault) Keys(ctx context.Context) ([]entity.VaultKey, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []entity.VaultKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.VaultKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.VaultKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.VaultKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Remove provides a mock function with given fields: ctx, key
+func (_m *CtrlVault) Remove(ctx context.Context, key entity.VaultKey) error {
+	ret := _m.Called(ctx, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.VaultKey) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Set provides a mock function with given fields: ctx, key, cred
+func (_m *CtrlVault) Set(ctx context.Context, key entity.VaultKey, cred entity.Credentials) error {
+	ret := _m.Called(ctx, key, cred)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.VaultKey, entity.Credentials) error); ok {
+		r0 = rf(ctx, key, cred)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCtrlVault creates a new instance of CtrlVault. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCtrlVault(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CtrlVault {
+	mock := &CtrlVault{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,55 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/antonmisa/1cctl/internal/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CtrlWatcher is an autogenerated mock type for the CtrlWatcher type
+type CtrlWatcher struct {
+	mock.Mock
+}
+
+// Watch provides a mock function with given fields: ctx, entrypoint, cluster, clusterCred
+func (_m *CtrlWatcher) Watch(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) (<-chan entity.SessionEvent, error) {
+	ret := _m.Called(ctx, entrypoint, cluster, clusterCred)
+
+	var r0 <-chan entity.SessionEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) (<-chan entity.SessionEvent, error)); ok {
+		return rf(ctx, entrypoint, cluster, clusterCred)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Cluster, entity.Credentials) <-chan entity.SessionEvent); ok {
+		r0 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan entity.SessionEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Cluster, entity.Credentials) error); ok {
+		r1 = rf(ctx, entrypoint, cluster, clusterCred)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCtrlWatcher creates a new instance of CtrlWatcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCtrlWatcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CtrlWatcher {
+	mock := &CtrlWatcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
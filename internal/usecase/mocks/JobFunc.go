@@ -0,0 +1,43 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	entity "github.com/antonmisa/1cctl/internal/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// JobFunc is an autogenerated mock type for the JobFunc type
+type JobFunc struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, update
+func (_m *JobFunc) Execute(ctx context.Context, update func(func(*entity.Job))) error {
+	ret := _m.Called(ctx, update)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(func(*entity.Job))) error); ok {
+		r0 = rf(ctx, update)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewJobFunc creates a new instance of JobFunc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobFunc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobFunc {
+	mock := &JobFunc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+// Option -.
+type Option func(*CtrlUseCase)
+
+// BackupDir - directory where dumps of infobases are put.
+func BackupDir(dir string) Option {
+	return func(c *CtrlUseCase) {
+		c.backupDir = dir
+	}
+}
+
+// LockCode - default permission code for designer to connect to locked infobase.
+func LockCode(code string) Option {
+	return func(c *CtrlUseCase) {
+		c.lockCode = code
+	}
+}
+
+// GracePeriod - default time between warning users about lock and terminating their sessions.
+func GracePeriod(d time.Duration) Option {
+	return func(c *CtrlUseCase) {
+		c.gracePeriod = d
+	}
+}
+
+// KickRetries - number of attempts to terminate sessions of locked infobase and pause between them.
+func KickRetries(retries int, interval time.Duration) Option {
+	return func(c *CtrlUseCase) {
+		c.kickRetries = retries
+		c.kickInterval = interval
+	}
+}
+
+// LockWindow - how long infobase stays locked if unlocking after backup never happens.
+func LockWindow(d time.Duration) Option {
+	return func(c *CtrlUseCase) {
+		c.lockWindow = d
+	}
+}
+
+// Retention - policy of pruning dumps of infobase after its successful backup.
+func Retention(policy entity.RetentionPolicy) Option {
+	return func(c *CtrlUseCase) {
+		c.retention = policy
+	}
+}
@@ -0,0 +1,37 @@
+package pipe
+
+import (
+	"context"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+// GetAgentAdmins - administrators of central server agent.
+func (r *CtrlPipe) GetAgentAdmins(ctx context.Context, entrypoint string, agentCred entity.Credentials) ([]entity.Admin, error) {
+	args := withAgentCred([]string{entrypoint, "agent", "admin", "list"}, agentCred)
+
+	return list[entity.Admin](ctx, r, "getagentadmins", args)
+}
+
+// RegisterAgentAdmin - adding administrator to central server agent, first one may be added without credentials.
+func (r *CtrlPipe) RegisterAgentAdmin(ctx context.Context, entrypoint string, agentCred entity.Credentials, opts entity.AdminOptions) error {
+	args := append([]string{entrypoint, "agent", "admin", "register"}, adminArgs(opts)...)
+
+	return execute(ctx, r, "registeragentadmin", withAgentCred(args, agentCred))
+}
+
+// RemoveAgentAdmin - deleting administrator of central server agent by name.
+func (r *CtrlPipe) RemoveAgentAdmin(ctx context.Context, entrypoint string, agentCred entity.Credentials, name string) error {
+	args := withAgentCred([]string{entrypoint, "agent", "admin", "remove", "--name", name}, agentCred)
+
+	return execute(ctx, r, "removeagentadmin", args)
+}
+
+// withAgentCred - adding credentials of central server agent administrator to args if they are set.
+func withAgentCred(args []string, agentCred entity.Credentials) []string {
+	if agentCred != (entity.Credentials{}) {
+		args = append(args, "--agent-user", agentCred.Name, "--agent-pwd", agentCred.Pwd)
+	}
+
+	return args
+}
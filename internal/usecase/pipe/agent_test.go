@@ -0,0 +1,43 @@
+// nolint
+package pipe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAgentAdmins(t *testing.T) {
+	t.Parallel()
+
+	args := []any{"localhost:1545", "agent", "admin", "list", "--agent-user", "agent", "--agent-pwd", "apwd"}
+
+	ctrl := New(newListMocks(t, NewFakeAdmin(), args, nil, nil, nil))
+
+	res, err := ctrl.GetAgentAdmins(context.Background(), "localhost:1545", entity.Credentials{Name: "agent", Pwd: "apwd"})
+
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, "admin", res[0].Name)
+}
+
+func TestRegisterAndRemoveAgentAdmin(t *testing.T) {
+	t.Parallel()
+
+	args := []any{"localhost:1545", "agent", "admin", "register", "--name", "agent", "--auth", "pwd", "--pwd", "apwd"}
+
+	ctrl := New(newListMocks(t, NewFakeSession0(), args, nil, nil, nil))
+
+	err := ctrl.RegisterAgentAdmin(context.Background(), "localhost:1545", entity.Credentials{},
+		entity.AdminOptions{Name: "agent", Pwd: "apwd", Auth: "pwd"})
+	require.NoError(t, err)
+
+	args = []any{"localhost:1545", "agent", "admin", "remove", "--name", "operator", "--agent-user", "agent", "--agent-pwd", "apwd"}
+
+	ctrl = New(newListMocks(t, NewFakeSession0(), args, nil, nil, nil))
+
+	err = ctrl.RemoveAgentAdmin(context.Background(), "localhost:1545", entity.Credentials{Name: "agent", Pwd: "apwd"}, "operator")
+	require.NoError(t, err)
+}
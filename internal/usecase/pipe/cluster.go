@@ -0,0 +1,121 @@
+package pipe
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+// UpdateCluster - changing settings of cluster which are set in opts.
+func (r *CtrlPipe) UpdateCluster(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.ClusterUpdateOptions) error {
+	args := append([]string{entrypoint, "cluster", "update", "--cluster", cluster.ID}, clusterSettingsArgs(opts)...)
+
+	return execute(ctx, r, "updatecluster", withClusterCred(args, clusterCred))
+}
+
+// InsertCluster - registering cluster on agent, identifier of new cluster is returned.
+func (r *CtrlPipe) InsertCluster(ctx context.Context, entrypoint string, agentCred entity.Credentials, opts entity.ClusterInsertOptions) (entity.Cluster, error) {
+	args := append([]string{entrypoint, "cluster", "insert", "--host", opts.Host, "--port", strconv.Itoa(opts.Port)},
+		clusterSettingsArgs(opts.ClusterUpdateOptions)...)
+
+	clusters, err := list[entity.Cluster](ctx, r, "insertcluster", withAgentCred(args, agentCred))
+	if err != nil {
+		return entity.Cluster{}, err
+	}
+
+	if len(clusters) == 0 {
+		return entity.Cluster{}, fmt.Errorf("ctrlpipe - insertcluster: %w", ErrClusterIsEmpty)
+	}
+
+	return clusters[0], nil
+}
+
+// RemoveCluster - unregistering cluster from agent.
+func (r *CtrlPipe) RemoveCluster(ctx context.Context, entrypoint string, agentCred entity.Credentials, cluster entity.Cluster, clusterCred entity.Credentials) error {
+	if cluster.ID == "" {
+		return fmt.Errorf("ctrlpipe - removecluster: %w", ErrClusterIsEmpty)
+	}
+
+	args := withAgentCred([]string{entrypoint, "cluster", "remove", "--cluster", cluster.ID}, agentCred)
+
+	return execute(ctx, r, "removecluster", withClusterCred(args, clusterCred))
+}
+
+// clusterSettingsArgs - rac flags of cluster settings which are set in opts.
+func clusterSettingsArgs(opts entity.ClusterUpdateOptions) []string {
+	var args []string
+
+	if opts.Name != nil {
+		args = append(args, "--name", *opts.Name)
+	}
+
+	for _, v := range []struct {
+		flag  string
+		value *int
+	}{
+		{"--expiration-timeout", opts.ExpirationTimeout},
+		{"--lifetime-limit", opts.LifetimeLimit},
+		{"--max-memory-size", opts.MaxMemorySize},
+		{"--max-memory-time-limit", opts.MaxMemoryTimeLimit},
+		{"--security-level", opts.SecurityLevel},
+		{"--session-fault-tolerance-level", opts.SessionFaultToleranceLevel},
+		{"--errors-count-threshold", opts.ErrorsCountThreshold},
+	} {
+		if v.value != nil {
+			args = append(args, v.flag, strconv.Itoa(*v.value))
+		}
+	}
+
+	if opts.LoadBalancingMode != nil {
+		args = append(args, "--load-balancing-mode", *opts.LoadBalancingMode)
+	}
+
+	if opts.KillProblemProcesses != nil {
+		args = append(args, "--kill-problem-processes", yesNo(*opts.KillProblemProcesses))
+	}
+
+	return args
+}
+
+// GetClusterAdmins - administrators of cluster.
+func (r *CtrlPipe) GetClusterAdmins(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Admin, error) {
+	args := withClusterCred([]string{entrypoint, "cluster", "admin", "list", "--cluster", cluster.ID}, clusterCred)
+
+	return list[entity.Admin](ctx, r, "getclusteradmins", args)
+}
+
+// RegisterClusterAdmin - adding administrator to cluster, first one may be added without credentials.
+func (r *CtrlPipe) RegisterClusterAdmin(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.AdminOptions) error {
+	args := append([]string{entrypoint, "cluster", "admin", "register", "--cluster", cluster.ID}, adminArgs(opts)...)
+
+	return execute(ctx, r, "registerclusteradmin", withClusterCred(args, clusterCred))
+}
+
+// RemoveClusterAdmin - deleting administrator of cluster by name.
+func (r *CtrlPipe) RemoveClusterAdmin(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) error {
+	args := withClusterCred([]string{entrypoint, "cluster", "admin", "remove", "--cluster", cluster.ID, "--name", name}, clusterCred)
+
+	return execute(ctx, r, "removeclusteradmin", args)
+}
+
+// adminArgs - rac flags of administrator registration.
+func adminArgs(opts entity.AdminOptions) []string {
+	args := []string{"--name", opts.Name, "--auth", opts.Auth}
+
+	for _, v := range []struct {
+		flag  string
+		value string
+	}{
+		{"--pwd", opts.Pwd},
+		{"--os-user", opts.OSUser},
+		{"--descr", opts.Desc},
+	} {
+		if v.value != "" {
+			args = append(args, v.flag, v.value)
+		}
+	}
+
+	return args
+}
@@ -0,0 +1,181 @@
+// nolint
+package pipe
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func NewFakeAdmin() *FakeReadCloser {
+	text := `name    : admin
+			 auth    : pwd
+			 os-user :
+			 descr   : "Main admin"
+
+			 name    : operator
+			 auth    : pwd,os
+			 os-user : DOMAIN\operator
+			 descr   :`
+
+	return &FakeReadCloser{
+		body: []byte(text),
+	}
+}
+
+func TestUpdateCluster(t *testing.T) {
+	name := "Main cluster"
+	timeout := 1200
+	mode := "memory"
+	kill := true
+
+	cases := []struct {
+		name      string
+		opts      entity.ClusterUpdateOptions
+		args      []any
+		respError string
+		waitError error
+	}{
+		{
+			name: "Success name and timeout",
+			opts: entity.ClusterUpdateOptions{Name: &name, ExpirationTimeout: &timeout},
+			args: []any{"localhost:1545", "cluster", "update", "--cluster", "1111-2222", "--name", "Main cluster",
+				"--expiration-timeout", "1200", "--cluster-user", "admin", "--cluster-pwd", "pwd"},
+		},
+		{
+			name: "Success balancing and kill",
+			opts: entity.ClusterUpdateOptions{LoadBalancingMode: &mode, KillProblemProcesses: &kill},
+			args: []any{"localhost:1545", "cluster", "update", "--cluster", "1111-2222", "--load-balancing-mode", "memory",
+				"--kill-problem-processes", "yes", "--cluster-user", "admin", "--cluster-pwd", "pwd"},
+		},
+		{
+			name:      "Error wait",
+			args:      []any{"localhost:1545", "cluster", "update", "--cluster", "1111-2222", "--cluster-user", "admin", "--cluster-pwd", "pwd"},
+			respError: ": wait error",
+			waitError: errors.New("wait error"),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := New(newListMocks(t, NewFakeSession0(), tc.args, nil, nil, tc.waitError))
+
+			err := ctrl.UpdateCluster(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"},
+				entity.Credentials{Name: "admin", Pwd: "pwd"}, tc.opts)
+
+			if tc.respError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.respError)
+			}
+		})
+	}
+}
+
+func TestGetClusterAdmins(t *testing.T) {
+	t.Parallel()
+
+	args := []any{"localhost:1545", "cluster", "admin", "list", "--cluster", "1111-2222"}
+
+	ctrl := New(newListMocks(t, NewFakeAdmin(), args, nil, nil, nil))
+
+	res, err := ctrl.GetClusterAdmins(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"}, entity.Credentials{})
+
+	require.NoError(t, err)
+	require.Equal(t, []entity.Admin{
+		{Name: "admin", Auth: "pwd", Desc: "\"main admin\""},
+		{Name: "operator", Auth: "pwd,os", OSUser: "domain\\operator"},
+	}, res)
+}
+
+func TestRegisterAndRemoveClusterAdmin(t *testing.T) {
+	t.Parallel()
+
+	args := []any{"localhost:1545", "cluster", "admin", "register", "--cluster", "1111-2222", "--name", "operator", "--auth", "pwd,os",
+		"--pwd", "secret", "--os-user", "DOMAIN\\operator"}
+
+	ctrl := New(newListMocks(t, NewFakeSession0(), args, nil, nil, nil))
+
+	err := ctrl.RegisterClusterAdmin(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"}, entity.Credentials{},
+		entity.AdminOptions{Name: "operator", Pwd: "secret", Auth: "pwd,os", OSUser: "DOMAIN\\operator"})
+	require.NoError(t, err)
+
+	args = []any{"localhost:1545", "cluster", "admin", "remove", "--cluster", "1111-2222", "--name", "operator",
+		"--cluster-user", "admin", "--cluster-pwd", "pwd"}
+
+	ctrl = New(newListMocks(t, NewFakeSession0(), args, nil, nil, nil))
+
+	err = ctrl.RemoveClusterAdmin(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"},
+		entity.Credentials{Name: "admin", Pwd: "pwd"}, "operator")
+	require.NoError(t, err)
+}
+
+func TestInsertCluster(t *testing.T) {
+	name := "Main cluster"
+	timeout := 1200
+
+	cases := []struct {
+		name      string
+		stdout    *FakeReadCloser
+		res       entity.Cluster
+		respError string
+	}{
+		{
+			name:   "Success",
+			stdout: &FakeReadCloser{body: []byte("cluster : 3333-4444")},
+			res:    entity.Cluster{ID: "3333-4444"},
+		},
+		{
+			name:      "Error empty output",
+			stdout:    NewFakeSession0(),
+			respError: ErrClusterIsEmpty.Error(),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := []any{"localhost:1545", "cluster", "insert", "--host", "1capp01", "--port", "1541", "--name", "Main cluster",
+				"--expiration-timeout", "1200", "--agent-user", "agent", "--agent-pwd", "apwd"}
+
+			ctrl := New(newListMocks(t, tc.stdout, args, nil, nil, nil))
+
+			res, err := ctrl.InsertCluster(context.Background(), "localhost:1545", entity.Credentials{Name: "agent", Pwd: "apwd"},
+				entity.ClusterInsertOptions{
+					Host:                 "1capp01",
+					Port:                 1541,
+					ClusterUpdateOptions: entity.ClusterUpdateOptions{Name: &name, ExpirationTimeout: &timeout},
+				})
+
+			if tc.respError == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			} else {
+				require.ErrorContains(t, err, tc.respError)
+			}
+		})
+	}
+}
+
+func TestRemoveCluster(t *testing.T) {
+	t.Parallel()
+
+	args := []any{"localhost:1545", "cluster", "remove", "--cluster", "1111-2222", "--agent-user", "agent", "--agent-pwd", "apwd",
+		"--cluster-user", "admin", "--cluster-pwd", "pwd"}
+
+	ctrl := New(newListMocks(t, NewFakeSession0(), args, nil, nil, nil))
+
+	err := ctrl.RemoveCluster(context.Background(), "localhost:1545", entity.Credentials{Name: "agent", Pwd: "apwd"},
+		entity.Cluster{ID: "1111-2222"}, entity.Credentials{Name: "admin", Pwd: "pwd"})
+	require.NoError(t, err)
+}
@@ -0,0 +1,37 @@
+package pipe
+
+import (
+	"context"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+// GetCounters - resource consumption counters of cluster.
+func (r *CtrlPipe) GetCounters(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Counter, error) {
+	args := withClusterCred([]string{entrypoint, "counter", "list", "--cluster", cluster.ID}, clusterCred)
+
+	return list[entity.Counter](ctx, r, "getcounters", args)
+}
+
+// GetCounterValues - accumulated values of counter, object narrows them to one user, infobase, etc.
+func (r *CtrlPipe) GetCounterValues(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, counter string, object string) ([]entity.CounterValue, error) {
+	args := withClusterCred(withObject([]string{entrypoint, "counter", "values", "--cluster", cluster.ID, "--counter", counter}, object), clusterCred)
+
+	return list[entity.CounterValue](ctx, r, "getcountervalues", args)
+}
+
+// ClearCounter - resetting accumulated values of counter, object narrows them to one user, infobase, etc.
+func (r *CtrlPipe) ClearCounter(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, counter string, object string) error {
+	args := withClusterCred(withObject([]string{entrypoint, "counter", "clear", "--cluster", cluster.ID, "--counter", counter}, object), clusterCred)
+
+	return execute(ctx, r, "clearcounter", args)
+}
+
+// withObject - adding filter of counter object to args if it is set.
+func withObject(args []string, object string) []string {
+	if object != "" {
+		args = append(args, "--object", object)
+	}
+
+	return args
+}
@@ -0,0 +1,95 @@
+// nolint
+package pipe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func NewFakeCounterValues() *FakeReadCloser {
+	text := `object                    : ivanov
+			 duration                  : 1200
+			 cpu-time                  : 800
+			 memory                    : 1048576
+			 read                      : 0
+			 write                     : 0
+			 duration-dbms             : 300
+			 dbms-bytes                : 0
+			 service                   : 0
+			 call                      : 15
+			 number-of-active-sessions : 1
+			 number-of-sessions        : 2
+
+			 object                    : petrov
+			 duration                  : 60
+			 cpu-time                  : 40
+			 memory                    : 0
+			 read                      : 0
+			 write                     : 0
+			 duration-dbms             : 0
+			 dbms-bytes                : 0
+			 service                   : 0
+			 call                      : 1
+			 number-of-active-sessions : 0
+			 number-of-sessions        : 1`
+
+	return &FakeReadCloser{
+		body: []byte(text),
+	}
+}
+
+func TestGetCounterValues(t *testing.T) {
+	cases := []struct {
+		name   string
+		object string
+		args   []any
+	}{
+		{
+			name: "Success all objects",
+			args: []any{"localhost:1545", "counter", "values", "--cluster", "1111-2222", "--counter", "users_cpu"},
+		},
+		{
+			name:   "Success w object",
+			object: "ivanov",
+			args: []any{"localhost:1545", "counter", "values", "--cluster", "1111-2222", "--counter", "users_cpu", "--object", "ivanov",
+				"--cluster-user", "admin", "--cluster-pwd", "pwd"},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cred := entity.Credentials{}
+			if tc.object != "" {
+				cred = entity.Credentials{Name: "admin", Pwd: "pwd"}
+			}
+
+			ctrl := New(newListMocks(t, NewFakeCounterValues(), tc.args, nil, nil, nil))
+
+			res, err := ctrl.GetCounterValues(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"}, cred, "users_cpu", tc.object)
+
+			require.NoError(t, err)
+			require.Equal(t, []entity.CounterValue{
+				{Object: "ivanov", Duration: 1200, CPUTime: 800, Memory: 1048576, DurationDBMS: 300, Call: 15, NumberOfActiveSessions: 1, NumberOfSessions: 2},
+				{Object: "petrov", Duration: 60, CPUTime: 40, Call: 1, NumberOfSessions: 1},
+			}, res)
+		})
+	}
+}
+
+func TestClearCounter(t *testing.T) {
+	t.Parallel()
+
+	args := []any{"localhost:1545", "counter", "clear", "--cluster", "1111-2222", "--counter", "users_cpu"}
+
+	ctrl := New(newListMocks(t, NewFakeSession0(), args, nil, nil, nil))
+
+	err := ctrl.ClearCounter(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"}, entity.Credentials{}, "users_cpu", "")
+	require.NoError(t, err)
+}
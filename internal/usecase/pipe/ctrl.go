@@ -22,6 +22,8 @@ const (
 
 	defaultBlockTime time.Duration = 60
 
+	defaultDeniedMessage string = "БАЗА ЗАКРЫТА НА СОЗДАНИЕ РЕЗЕРВНОЙ КОПИИ"
+
 	initialBlockLimitSize int = 50
 
 	formatDate string = "01-02-2006 15:04:05"
@@ -31,6 +33,8 @@ var (
 	ErrInfobaseIsEmpty   = errors.New("infobase is empty")
 	ErrSessionIsEmpty    = errors.New("session is empty")
 	ErrConnectionIsEmpty = errors.New("connection is empty")
+	ErrClusterIsEmpty    = errors.New("cluster is empty")
+	ErrRuleIsEmpty       = errors.New("rule is empty")
 )
 
 // CtrlPipe -.
@@ -395,21 +399,37 @@ func (r *CtrlPipe) GetSessions(ctx context.Context, entrypoint string, cluster e
 	}
 }
 
-func (r *CtrlPipe) DisableSessions(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, infobaseCred entity.Credentials, code string) error {
-	now := time.Now()
-
+// DisableSessions - denying sessions to infobase, empty window and message of opts are filled by defaults.
+func (r *CtrlPipe) DisableSessions(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, infobaseCred entity.Credentials, opts entity.LockOptions) error {
 	if infobase == (entity.Infobase{}) {
 		return fmt.Errorf("ctrlpipe - disablesessions: %w", ErrInfobaseIsEmpty)
 	}
 
+	if opts.DeniedFrom.IsZero() {
+		opts.DeniedFrom = time.Now()
+	}
+
+	if opts.DeniedTo.IsZero() {
+		opts.DeniedTo = opts.DeniedFrom.Add(defaultBlockTime * time.Minute)
+	}
+
+	if opts.Message == "" {
+		opts.Message = defaultDeniedMessage
+	}
+
+	scheduledJobsDeny := "off"
+	if opts.ScheduledJobsDeny {
+		scheduledJobsDeny = "on"
+	}
+
 	args := []string{entrypoint, "infobase", "update",
 		"--cluster", cluster.ID,
 		"--infobase", infobase.ID,
-		"--denied-from", now.Format(formatDate),
-		"--denied-message", "БАЗА ЗАКРЫТА НА СОЗДАНИЕ РЕЗЕРВНОЙ КОПИИ",
-		"--denied-to", now.Add(defaultBlockTime * time.Minute).Format(formatDate),
-		"--permission-code", code,
-		"--scheduled-jobs-deny", "on",
+		"--denied-from", opts.DeniedFrom.Local().Format(formatDate),
+		"--denied-message", opts.Message,
+		"--denied-to", opts.DeniedTo.Local().Format(formatDate),
+		"--permission-code", opts.PermissionCode,
+		"--scheduled-jobs-deny", scheduledJobsDeny,
 		"--sessions-deny", "on"}
 
 	if clusterCred != (entity.Credentials{}) {
@@ -729,7 +749,11 @@ func (r *CtrlPipe) DeleteConnection(ctx context.Context, entrypoint string, clus
 
 	args := []string{entrypoint, "connection", "disconnect",
 		"--cluster", cluster.ID,
-		"--conection", connection.ID}
+		"--connection", connection.ID}
+
+	if connection.ProcessID != "" {
+		args = append(args, []string{"--process", connection.ProcessID}...)
+	}
 
 	if clusterCred != (entity.Credentials{}) {
 		args = append(args, []string{"--cluster-user", clusterCred.Name, "--cluster-pwd", clusterCred.Pwd}...)
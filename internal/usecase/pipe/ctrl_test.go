@@ -6,6 +6,7 @@ import (
 	"errors"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/antonmisa/1cctl/internal/entity"
 	"github.com/antonmisa/1cctl/pkg/pipe/mocks"
@@ -811,7 +812,7 @@ func TestDisableSessions(t *testing.T) {
 
 			ctrl := New(pipeMock)
 
-			err := ctrl.DisableSessions(tc.ctx, tc.cs, tc.cl, tc.ib, tc.clCred, tc.ibCred, tc.code)
+			err := ctrl.DisableSessions(tc.ctx, tc.cs, tc.cl, tc.ib, tc.clCred, tc.ibCred, entity.LockOptions{PermissionCode: tc.code, ScheduledJobsDeny: true})
 
 			if err == nil {
 				require.NoError(t, err)
@@ -823,6 +824,33 @@ func TestDisableSessions(t *testing.T) {
 	}
 }
 
+func TestDisableSessionsWindow(t *testing.T) {
+	t.Parallel()
+
+	// Window of request comes with zone, rac takes local time of server.
+	from, err := time.Parse(time.RFC3339, "2026-10-18T10:00:00+03:00")
+	require.NoError(t, err)
+
+	to, err := time.Parse(time.RFC3339, "2026-10-18T08:00:00Z")
+	require.NoError(t, err)
+
+	args := []any{"localhost:1545", "infobase", "update",
+		"--cluster", "1212-3434-5656",
+		"--infobase", "3333-4444",
+		"--denied-from", time.Date(2026, time.October, 18, 7, 0, 0, 0, time.UTC).In(time.Local).Format(formatDate),
+		"--denied-message", "locked",
+		"--denied-to", time.Date(2026, time.October, 18, 8, 0, 0, 0, time.UTC).In(time.Local).Format(formatDate),
+		"--permission-code", "12345",
+		"--scheduled-jobs-deny", "off",
+		"--sessions-deny", "on"}
+
+	ctrl := New(newListMocks(t, NewFakeSession0(), args, nil, nil, nil))
+
+	err = ctrl.DisableSessions(context.Background(), "localhost:1545", entity.Cluster{ID: "1212-3434-5656"}, entity.Infobase{ID: "3333-4444"},
+		entity.Credentials{}, entity.Credentials{}, entity.LockOptions{DeniedFrom: from, DeniedTo: to, Message: "locked", PermissionCode: "12345"})
+	require.NoError(t, err)
+}
+
 func TestEnableSessions(t *testing.T) {
 	cases := []struct {
 		name              string
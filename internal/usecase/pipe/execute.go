@@ -0,0 +1,59 @@
+package pipe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+// execute - running rac with args which output is of no interest.
+func execute(ctx context.Context, r *CtrlPipe, method string, args []string) error {
+	cmd, stdout, err := r.pipe.Run(ctx, args...)
+	if err != nil {
+		return fmt.Errorf("ctrlpipe - %s - error opening pipe: %w", method, err)
+	}
+
+	defer cmd.Cancel()
+
+	if stdout != nil {
+		defer stdout.Close()
+	}
+
+	if err = cmd.Start(); err != nil {
+		return fmt.Errorf("ctrlpipe - %s - cmd.Start: %w", method, err)
+	}
+
+	if err = cmd.Wait(); err != nil {
+		return fmt.Errorf("ctrlpipe - %s - cmd.Wait: %w", method, err)
+	}
+
+	return nil
+}
+
+// withInfobaseCred - adding credentials of infobase user to args if they are set.
+func withInfobaseCred(args []string, infobaseCred entity.Credentials) []string {
+	if infobaseCred != (entity.Credentials{}) {
+		args = append(args, "--infobase-user", infobaseCred.Name, "--infobase-pwd", infobaseCred.Pwd)
+	}
+
+	return args
+}
+
+// onOff - rac flag value for bool.
+func onOff(v bool) string {
+	if v {
+		return "on"
+	}
+
+	return "off"
+}
+
+// yesNo - rac flag value for bool.
+func yesNo(v bool) string {
+	if v {
+		return "yes"
+	}
+
+	return "no"
+}
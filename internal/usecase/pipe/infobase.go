@@ -0,0 +1,147 @@
+package pipe
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+// GetInfobaseDetails - settings of infobase, empty details mean rac returned nothing.
+func (r *CtrlPipe) GetInfobaseDetails(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, infobaseCred entity.Credentials) (entity.InfobaseDetails, error) {
+	if infobase.ID == "" {
+		return entity.InfobaseDetails{}, fmt.Errorf("ctrlpipe - getinfobasedetails: %w", ErrInfobaseIsEmpty)
+	}
+
+	args := []string{entrypoint, "infobase", "info", "--cluster", cluster.ID, "--infobase", infobase.ID}
+
+	details, err := list[entity.InfobaseDetails](ctx, r, "getinfobasedetails", withInfobaseCred(withClusterCred(args, clusterCred), infobaseCred))
+	if err != nil || len(details) == 0 {
+		return entity.InfobaseDetails{}, err
+	}
+
+	return details[0], nil
+}
+
+// CreateInfobase - registering infobase in cluster, identifier of new infobase is returned.
+func (r *CtrlPipe) CreateInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.InfobaseCreateOptions) (entity.Infobase, error) {
+	args := []string{entrypoint, "infobase", "create", "--cluster", cluster.ID,
+		"--name", opts.Name,
+		"--dbms", opts.DBMS,
+		"--db-server", opts.DBServer,
+		"--db-name", opts.DBName,
+		"--scheduled-jobs-deny", onOff(opts.ScheduledJobsDeny)}
+
+	if opts.CreateDatabase {
+		args = append(args, "--create-database")
+	}
+
+	for _, v := range []struct {
+		flag  string
+		value string
+	}{
+		{"--db-user", opts.DBUser},
+		{"--db-pwd", opts.DBPwd},
+		{"--locale", opts.Locale},
+		{"--descr", opts.Desc},
+	} {
+		if v.value != "" {
+			args = append(args, v.flag, v.value)
+		}
+	}
+
+	if opts.DateOffset != 0 {
+		args = append(args, "--date-offset", strconv.Itoa(opts.DateOffset))
+	}
+
+	infobases, err := list[entity.Infobase](ctx, r, "createinfobase", withClusterCred(args, clusterCred))
+	if err != nil {
+		return entity.Infobase{}, err
+	}
+
+	if len(infobases) == 0 {
+		return entity.Infobase{}, fmt.Errorf("ctrlpipe - createinfobase: %w", ErrInfobaseIsEmpty)
+	}
+
+	return entity.Infobase{ID: infobases[0].ID, Name: opts.Name, Desc: opts.Desc}, nil
+}
+
+// DropInfobase - removing infobase from cluster with its database if it is asked.
+func (r *CtrlPipe) DropInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, infobaseCred entity.Credentials, opts entity.InfobaseDropOptions) error {
+	if infobase.ID == "" {
+		return fmt.Errorf("ctrlpipe - dropinfobase: %w", ErrInfobaseIsEmpty)
+	}
+
+	args := []string{entrypoint, "infobase", "drop", "--cluster", cluster.ID, "--infobase", infobase.ID}
+
+	switch {
+	case opts.DropDatabase:
+		args = append(args, "--drop-database")
+	case opts.ClearDatabase:
+		args = append(args, "--clear-database")
+	}
+
+	return execute(ctx, r, "dropinfobase", withInfobaseCred(withClusterCred(args, clusterCred), infobaseCred))
+}
+
+// UpdateInfobase - changing settings of infobase which are set in opts.
+func (r *CtrlPipe) UpdateInfobase(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, infobaseCred entity.Credentials, opts entity.InfobaseUpdateOptions) error {
+	if infobase.ID == "" {
+		return fmt.Errorf("ctrlpipe - updateinfobase: %w", ErrInfobaseIsEmpty)
+	}
+
+	args := []string{entrypoint, "infobase", "update", "--cluster", cluster.ID, "--infobase", infobase.ID}
+
+	for _, v := range []struct {
+		flag  string
+		value *string
+	}{
+		{"--descr", opts.Desc},
+		{"--dbms", opts.DBMS},
+		{"--db-server", opts.DBServer},
+		{"--db-name", opts.DBName},
+		{"--db-user", opts.DBUser},
+		{"--db-pwd", opts.DBPwd},
+		{"--denied-message", opts.DeniedMessage},
+		{"--denied-parameter", opts.DeniedParameter},
+		{"--permission-code", opts.PermissionCode},
+		{"--security-profile-name", opts.SecurityProfile},
+		{"--safe-mode-security-profile-name", opts.SafeModeSecurityProfile},
+	} {
+		if v.value != nil {
+			args = append(args, v.flag, *v.value)
+		}
+	}
+
+	if opts.DeniedFrom != nil {
+		args = append(args, "--denied-from", opts.DeniedFrom.Local().Format(formatDate))
+	}
+
+	if opts.DeniedTo != nil {
+		args = append(args, "--denied-to", opts.DeniedTo.Local().Format(formatDate))
+	}
+
+	if opts.SessionsDeny != nil {
+		args = append(args, "--sessions-deny", onOff(*opts.SessionsDeny))
+	}
+
+	if opts.ScheduledJobsDeny != nil {
+		args = append(args, "--scheduled-jobs-deny", onOff(*opts.ScheduledJobsDeny))
+	}
+
+	if opts.LicenseDistribution != nil {
+		distribution := "deny"
+		if *opts.LicenseDistribution {
+			distribution = "allow"
+		}
+
+		args = append(args, "--license-distribution", distribution)
+	}
+
+	if opts.ReserveWorkingProcesses != nil {
+		args = append(args, "--reserve-working-processes", yesNo(*opts.ReserveWorkingProcesses))
+	}
+
+	return execute(ctx, r, "updateinfobase", withInfobaseCred(withClusterCred(args, clusterCred), infobaseCred))
+}
@@ -0,0 +1,244 @@
+// nolint
+package pipe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func NewFakeInfobaseDetails() *FakeReadCloser {
+	text := `infobase             : 1212-3434-5656
+			 name                 : buh
+			 dbms                 : MSSQLServer
+			 db-server            : sql01
+			 db-name              : buh
+			 db-user              : sa
+			 security-level       : 0
+			 license-distribution : allow
+			 scheduled-jobs-deny  : off
+			 sessions-deny        : on
+			 denied-from          : 2023-08-10T22:00:00
+			 denied-message       : "locked"
+			 denied-to            : 2023-08-10T23:00:00
+			 permission-code      : 12345
+			 descr                : ""
+			 locale               : ru_RU
+			 date-offset          : 2000`
+
+	return &FakeReadCloser{
+		body: []byte(text),
+	}
+}
+
+func TestGetInfobaseDetails(t *testing.T) {
+	cases := []struct {
+		name      string
+		ib        entity.Infobase
+		stdout    *FakeReadCloser
+		res       entity.InfobaseDetails
+		respError string
+	}{
+		{
+			name:   "Success",
+			ib:     entity.Infobase{ID: "1212-3434-5656"},
+			stdout: NewFakeInfobaseDetails(),
+			res: entity.InfobaseDetails{
+				ID:                  "1212-3434-5656",
+				Name:                "buh",
+				Desc:                "\"\"",
+				DBMS:                "mssqlserver",
+				DBServer:            "sql01",
+				DBName:              "buh",
+				DBUser:              "sa",
+				Locale:              "ru_ru",
+				DateOffset:          2000,
+				LicenseDistribution: "allow",
+				SessionsDeny:        "on",
+				DeniedFrom:          time.Date(2023, time.August, 10, 22, 0, 0, 0, time.UTC),
+				DeniedTo:            time.Date(2023, time.August, 10, 23, 0, 0, 0, time.UTC),
+				DeniedMessage:       "\"locked\"",
+				PermissionCode:      "12345",
+				ScheduledJobsDeny:   "off",
+			},
+		},
+		{
+			name:   "Success empty output",
+			ib:     entity.Infobase{ID: "1212-3434-5656"},
+			stdout: NewFakeSession0(),
+		},
+		{
+			name:      "Error empty infobase",
+			respError: ErrInfobaseIsEmpty.Error(),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := []any{"localhost:1545", "infobase", "info", "--cluster", "1111-2222", "--infobase", "1212-3434-5656",
+				"--cluster-user", "admin", "--cluster-pwd", "pwd", "--infobase-user", "ib", "--infobase-pwd", "ibpwd"}
+
+			var ctrl *CtrlPipe
+
+			if tc.stdout != nil {
+				ctrl = New(newListMocks(t, tc.stdout, args, nil, nil, nil))
+			} else {
+				ctrl = New(nil)
+			}
+
+			res, err := ctrl.GetInfobaseDetails(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"}, tc.ib,
+				entity.Credentials{Name: "admin", Pwd: "pwd"}, entity.Credentials{Name: "ib", Pwd: "ibpwd"})
+
+			if tc.respError == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			} else {
+				require.ErrorContains(t, err, tc.respError)
+			}
+		})
+	}
+}
+
+func TestUpdateInfobase(t *testing.T) {
+	desc := "new desc"
+	deny := true
+	// Window comes with zone of client, rac takes local time of server
+	from := time.Date(2023, time.August, 10, 22, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+	local := time.Date(2023, time.August, 10, 19, 0, 0, 0, time.UTC).In(time.Local).Format(formatDate)
+
+	cases := []struct {
+		name           string
+		opts           entity.InfobaseUpdateOptions
+		args           []any
+		comMockWaitErr error
+		respError      string
+	}{
+		{
+			name: "Success",
+			opts: entity.InfobaseUpdateOptions{Desc: &desc, DeniedFrom: &from, SessionsDeny: &deny, LicenseDistribution: &deny},
+			args: []any{"localhost:1545", "infobase", "update", "--cluster", "1111-2222", "--infobase", "1212-3434-5656",
+				"--descr", "new desc", "--denied-from", local, "--sessions-deny", "on", "--license-distribution", "allow"},
+		},
+		{
+			name:           "Error wait",
+			args:           []any{"localhost:1545", "infobase", "update", "--cluster", "1111-2222", "--infobase", "1212-3434-5656"},
+			comMockWaitErr: errors.New("wait error"),
+			respError:      ": wait error",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := New(newListMocks(t, NewFakeSession0(), tc.args, nil, nil, tc.comMockWaitErr))
+
+			err := ctrl.UpdateInfobase(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"}, entity.Infobase{ID: "1212-3434-5656"},
+				entity.Credentials{}, entity.Credentials{}, tc.opts)
+
+			if tc.respError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.respError)
+			}
+		})
+	}
+}
+
+func TestCreateInfobase(t *testing.T) {
+	cases := []struct {
+		name      string
+		opts      entity.InfobaseCreateOptions
+		args      []any
+		stdout    *FakeReadCloser
+		res       entity.Infobase
+		respError string
+	}{
+		{
+			name: "Success",
+			opts: entity.InfobaseCreateOptions{Name: "test", DBMS: "PostgreSQL", DBServer: "sql01", DBName: "test", Locale: "ru_RU", CreateDatabase: true},
+			args: []any{"localhost:1545", "infobase", "create", "--cluster", "1111-2222", "--name", "test", "--dbms", "PostgreSQL",
+				"--db-server", "sql01", "--db-name", "test", "--scheduled-jobs-deny", "off", "--create-database", "--locale", "ru_RU"},
+			stdout: &FakeReadCloser{body: []byte("infobase : 1212-3434-5656")},
+			res:    entity.Infobase{ID: "1212-3434-5656", Name: "test"},
+		},
+		{
+			name: "Error empty output",
+			opts: entity.InfobaseCreateOptions{Name: "test", DBMS: "PostgreSQL", DBServer: "sql01", DBName: "test"},
+			args: []any{"localhost:1545", "infobase", "create", "--cluster", "1111-2222", "--name", "test", "--dbms", "PostgreSQL",
+				"--db-server", "sql01", "--db-name", "test", "--scheduled-jobs-deny", "off"},
+			stdout:    NewFakeSession0(),
+			respError: ErrInfobaseIsEmpty.Error(),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := New(newListMocks(t, tc.stdout, tc.args, nil, nil, nil))
+
+			res, err := ctrl.CreateInfobase(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"}, entity.Credentials{}, tc.opts)
+
+			if tc.respError == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			} else {
+				require.ErrorContains(t, err, tc.respError)
+			}
+		})
+	}
+}
+
+func TestDropInfobase(t *testing.T) {
+	cases := []struct {
+		name string
+		opts entity.InfobaseDropOptions
+		args []any
+	}{
+		{
+			name: "Success keep database",
+			args: []any{"localhost:1545", "infobase", "drop", "--cluster", "1111-2222", "--infobase", "1212-3434-5656",
+				"--infobase-user", "ib", "--infobase-pwd", "ibpwd"},
+		},
+		{
+			name: "Success drop database",
+			opts: entity.InfobaseDropOptions{DropDatabase: true},
+			args: []any{"localhost:1545", "infobase", "drop", "--cluster", "1111-2222", "--infobase", "1212-3434-5656",
+				"--drop-database", "--infobase-user", "ib", "--infobase-pwd", "ibpwd"},
+		},
+		{
+			name: "Success clear database",
+			opts: entity.InfobaseDropOptions{ClearDatabase: true},
+			args: []any{"localhost:1545", "infobase", "drop", "--cluster", "1111-2222", "--infobase", "1212-3434-5656",
+				"--clear-database", "--infobase-user", "ib", "--infobase-pwd", "ibpwd"},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := New(newListMocks(t, NewFakeSession0(), tc.args, nil, nil, nil))
+
+			err := ctrl.DropInfobase(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"}, entity.Infobase{ID: "1212-3434-5656"},
+				entity.Credentials{}, entity.Credentials{Name: "ib", Pwd: "ibpwd"}, tc.opts)
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package pipe
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+// GetLimits - resource consumption limits of cluster.
+func (r *CtrlPipe) GetLimits(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Limit, error) {
+	args := withClusterCred([]string{entrypoint, "limit", "list", "--cluster", cluster.ID}, clusterCred)
+
+	return list[entity.Limit](ctx, r, "getlimits", args)
+}
+
+// UpdateLimit - writing resource consumption limit, rac creates limit if there is no limit with such name.
+// All thresholds are passed so limit is the same on every cluster it is applied to.
+func (r *CtrlPipe) UpdateLimit(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.LimitOptions) error {
+	args := []string{entrypoint, "limit", "update", "--cluster", cluster.ID,
+		"--name", opts.Name,
+		"--counter", opts.Counter,
+		"--action", opts.Action}
+
+	for _, v := range []struct {
+		flag  string
+		value int
+	}{
+		{"--duration", opts.Duration},
+		{"--cpu-time", opts.CPUTime},
+		{"--memory", opts.Memory},
+		{"--read", opts.Read},
+		{"--write", opts.Write},
+		{"--duration-dbms", opts.DurationDBMS},
+		{"--dbms-bytes", opts.DBMSBytes},
+		{"--service", opts.Service},
+		{"--call", opts.Call},
+		{"--number-of-active-sessions", opts.NumberOfActiveSessions},
+		{"--number-of-sessions", opts.NumberOfSessions},
+	} {
+		args = append(args, v.flag, strconv.Itoa(v.value))
+	}
+
+	for _, v := range []struct {
+		flag  string
+		value string
+	}{
+		{"--error-message", opts.ErrorMessage},
+		{"--descr", opts.Desc},
+	} {
+		if v.value != "" {
+			args = append(args, v.flag, v.value)
+		}
+	}
+
+	return execute(ctx, r, "updatelimit", withClusterCred(args, clusterCred))
+}
+
+// RemoveLimit - deleting resource consumption limit by name.
+func (r *CtrlPipe) RemoveLimit(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) error {
+	args := withClusterCred([]string{entrypoint, "limit", "remove", "--cluster", cluster.ID, "--name", name}, clusterCred)
+
+	return execute(ctx, r, "removelimit", args)
+}
@@ -0,0 +1,92 @@
+// nolint
+package pipe
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func NewFakeLimit() *FakeReadCloser {
+	text := `name                      : heavy_calls
+			 counter                   : calls
+			 action                    : interrupt-current-call
+			 duration                  : 60
+			 cpu-time                  : 0
+			 memory                    : 1073741824
+			 read                      : 0
+			 write                     : 0
+			 duration-dbms             : 0
+			 dbms-bytes                : 0
+			 service                   : 0
+			 call                      : 0
+			 number-of-active-sessions : 0
+			 number-of-sessions        : 0
+			 error-message             : "Call is too heavy"
+			 descr                     :`
+
+	return &FakeReadCloser{
+		body: []byte(text),
+	}
+}
+
+func TestGetLimits(t *testing.T) {
+	cases := []struct {
+		name             string
+		res              []entity.Limit
+		respError        string
+		comMockWaitError error
+	}{
+		{
+			name: "Success",
+			res: []entity.Limit{{Name: "heavy_calls", Counter: "calls", Action: "interrupt-current-call", Duration: 60, Memory: 1073741824,
+				ErrorMessage: "\"call is too heavy\""}},
+		},
+		{
+			name:             "Error wait",
+			respError:        ": wait error",
+			comMockWaitError: errors.New("wait error"),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := []any{"localhost:1545", "limit", "list", "--cluster", "1111-2222"}
+
+			ctrl := New(newListMocks(t, NewFakeLimit(), args, nil, nil, tc.comMockWaitError))
+
+			res, err := ctrl.GetLimits(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"}, entity.Credentials{})
+
+			if tc.respError == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			} else {
+				require.ErrorContains(t, err, tc.respError)
+				require.Empty(t, res)
+			}
+		})
+	}
+}
+
+func TestUpdateLimit(t *testing.T) {
+	t.Parallel()
+
+	args := []any{"localhost:1545", "limit", "update", "--cluster", "1111-2222", "--name", "heavy_calls", "--counter", "calls",
+		"--action", "interrupt-current-call", "--duration", "60", "--cpu-time", "0", "--memory", "1073741824", "--read", "0",
+		"--write", "0", "--duration-dbms", "0", "--dbms-bytes", "0", "--service", "0", "--call", "0",
+		"--number-of-active-sessions", "0", "--number-of-sessions", "0", "--error-message", "Call is too heavy"}
+
+	ctrl := New(newListMocks(t, NewFakeSession0(), args, nil, nil, nil))
+
+	err := ctrl.UpdateLimit(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"}, entity.Credentials{},
+		entity.LimitOptions{Name: "heavy_calls", Counter: "calls", Action: "interrupt-current-call", Duration: 60, Memory: 1073741824,
+			ErrorMessage: "Call is too heavy"})
+	require.NoError(t, err)
+}
@@ -0,0 +1,84 @@
+package pipe
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+// list - running rac with args and converting blocks of its output separated by empty line to entities.
+func list[T any](ctx context.Context, r *CtrlPipe, method string, args []string) ([]T, error) {
+	cmd, stdout, err := r.pipe.Run(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ctrlpipe - %s - error opening pipe: %w", method, err)
+	}
+
+	defer cmd.Cancel()
+	defer stdout.Close()
+
+	if err = cmd.Start(); err != nil {
+		return nil, fmt.Errorf("ctrlpipe - %s - cmd.Start: %w", method, err)
+	}
+
+	rv := make([]T, 0, initialDataSize)
+
+	rawStrings := make([]string, 0, initialPropertiesSizeBig)
+
+	flush := func() error {
+		if len(rawStrings) == 0 {
+			return nil
+		}
+
+		var data T
+
+		err := entity.Unmarshal(rawStrings, &data)
+		if err != nil {
+			return fmt.Errorf("ctrlpipe - %s - decoder.Unmarshal: %w", method, err)
+		}
+
+		rv = append(rv, data)
+		rawStrings = rawStrings[:0]
+
+		return nil
+	}
+
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if line != "" {
+			rawStrings = append(rawStrings, line)
+
+			continue
+		}
+
+		if err = flush(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = flush(); err != nil {
+		return nil, err
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("ctrlpipe - %s - scanner.Err: %w", method, err)
+	}
+
+	if err = cmd.Wait(); err != nil {
+		return nil, fmt.Errorf("ctrlpipe - %s - cmd.Wait: %w", method, err)
+	}
+
+	return rv, nil
+}
+
+// withClusterCred - adding credentials of cluster administrator to args if they are set.
+func withClusterCred(args []string, clusterCred entity.Credentials) []string {
+	if clusterCred != (entity.Credentials{}) {
+		args = append(args, "--cluster-user", clusterCred.Name, "--cluster-pwd", clusterCred.Pwd)
+	}
+
+	return args
+}
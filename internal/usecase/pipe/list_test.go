@@ -0,0 +1,37 @@
+// nolint
+package pipe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/antonmisa/1cctl/pkg/pipe/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+// newListMocks - piper expecting exactly args and command feeding stdout between start and wait.
+func newListMocks(t *testing.T, stdout *FakeReadCloser, args []any, pipeErr, startErr, waitErr error) *mocks.Piper {
+	comMock := mocks.NewCommander(t)
+
+	comMock.On("Start").
+		Return(startErr).
+		Run(func(args mock.Arguments) { stdout.SetEnable(true) }).
+		Maybe()
+
+	comMock.On("Wait").
+		Return(waitErr).
+		Run(func(args mock.Arguments) { stdout.SetEnable(false) }).
+		Maybe()
+
+	comMock.On("Cancel").
+		Return(nil).
+		Run(func(args mock.Arguments) { stdout.SetEnable(false) }).
+		Maybe()
+
+	pipeMock := mocks.NewPiper(t)
+
+	pipeMock.On("Run", append([]any{mock.MatchedBy(func(ctx context.Context) bool { return true })}, args...)...).
+		Return(comMock, stdout, pipeErr)
+
+	return pipeMock
+}
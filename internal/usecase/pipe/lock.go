@@ -0,0 +1,26 @@
+package pipe
+
+import (
+	"context"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+// GetLocks - managed locks of cluster, empty infobase and fields of filter mean any.
+func (r *CtrlPipe) GetLocks(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, clusterCred entity.Credentials, filter entity.LockFilter) ([]entity.Lock, error) {
+	args := []string{entrypoint, "lock", "list", "--cluster", cluster.ID}
+
+	if infobase.ID != "" {
+		args = append(args, "--infobase", infobase.ID)
+	}
+
+	if filter.Connection != "" {
+		args = append(args, "--connection", filter.Connection)
+	}
+
+	if filter.Session != "" {
+		args = append(args, "--session", filter.Session)
+	}
+
+	return list[entity.Lock](ctx, r, "getlocks", withClusterCred(args, clusterCred))
+}
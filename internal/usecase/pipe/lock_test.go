@@ -0,0 +1,103 @@
+// nolint
+package pipe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func NewFakeLock() *FakeReadCloser {
+	text := `connection : 1111-3434-5656
+			 session    : 2222-3434-5656
+			 object     : 3333-3434-5656
+			 locked     : 2023-08-10T14:04:43
+			 descr      : "БД(сеанс ,УППБД,разделяемая)"
+
+			 connection : 00000000-0000-0000-0000-000000000000
+			 session    : 4444-3434-5656
+			 object     : 5555-3434-5656
+			 locked     : 2023-08-10T14:05:01
+			 descr      : ""`
+
+	return &FakeReadCloser{
+		body: []byte(text),
+	}
+}
+
+func TestGetLocks(t *testing.T) {
+	res := []entity.Lock{
+		{
+			ConnectionID: "1111-3434-5656",
+			SessionID:    "2222-3434-5656",
+			Object:       "3333-3434-5656",
+			Locked:       time.Date(2023, time.August, 10, 14, 4, 43, 0, time.UTC),
+			Desc:         "\"бд(сеанс ,уппбд,разделяемая)\"",
+		},
+		{
+			ConnectionID: "00000000-0000-0000-0000-000000000000",
+			SessionID:    "4444-3434-5656",
+			Object:       "5555-3434-5656",
+			Locked:       time.Date(2023, time.August, 10, 14, 5, 1, 0, time.UTC),
+			Desc:         "\"\"",
+		},
+	}
+
+	cases := []struct {
+		name              string
+		ib                entity.Infobase
+		filter            entity.LockFilter
+		cred              entity.Credentials
+		args              []any
+		res               []entity.Lock
+		respError         string
+		comMockStartError error
+	}{
+		{
+			name: "Success cluster",
+			args: []any{"localhost:1545", "lock", "list", "--cluster", "1212-3434-5656"},
+			res:  res,
+		},
+		{
+			name:   "Success filtered w cred",
+			ib:     entity.Infobase{ID: "3333-4444"},
+			filter: entity.LockFilter{Connection: "1111-3434-5656", Session: "2222-3434-5656"},
+			cred:   entity.Credentials{Name: "admin", Pwd: "pwd"},
+			args: []any{"localhost:1545", "lock", "list", "--cluster", "1212-3434-5656",
+				"--infobase", "3333-4444", "--connection", "1111-3434-5656", "--session", "2222-3434-5656",
+				"--cluster-user", "admin", "--cluster-pwd", "pwd"},
+			res: res,
+		},
+		{
+			name:              "Error start",
+			args:              []any{"localhost:1545", "lock", "list", "--cluster", "1212-3434-5656"},
+			respError:         ": start error",
+			comMockStartError: errors.New("start error"),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := New(newListMocks(t, NewFakeLock(), tc.args, nil, tc.comMockStartError, nil))
+
+			locks, err := ctrl.GetLocks(context.Background(), "localhost:1545", entity.Cluster{ID: "1212-3434-5656"}, tc.ib, tc.cred, tc.filter)
+
+			if tc.respError == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, locks)
+			} else {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.respError)
+				require.Empty(t, locks)
+			}
+		})
+	}
+}
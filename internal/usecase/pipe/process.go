@@ -0,0 +1,14 @@
+package pipe
+
+import (
+	"context"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+// GetProcesses - working processes of cluster.
+func (r *CtrlPipe) GetProcesses(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Process, error) {
+	args := withClusterCred([]string{entrypoint, "process", "list", "--cluster", cluster.ID}, clusterCred)
+
+	return list[entity.Process](ctx, r, "getprocesses", args)
+}
@@ -0,0 +1,145 @@
+// nolint
+package pipe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func NewFakeProcess() *FakeReadCloser {
+	text := `process              : 1111-3434-5656
+			 host                 : 1capp01
+			 port                 : 1560
+			 pid                  : 5176
+			 turned-on            : yes
+			 running              : yes
+			 started-at           : 2023-08-10T14:04:43
+			 available-perfomance : 184
+			 connections          : 6
+			 avg-call-time        : 0.250
+
+			 process              : 2222-3434-5656
+			 host                 : 1capp02
+			 port                 : 1561
+			 pid                  : 6120
+			 avg-threads          : 0.08`
+
+	return &FakeReadCloser{
+		body: []byte(text),
+	}
+}
+
+func TestGetProcesses(t *testing.T) {
+	cases := []struct {
+		name              string
+		cred              entity.Credentials
+		stdout            *FakeReadCloser
+		res               []entity.Process
+		respError         string
+		pipeMockError     error
+		comMockStartError error
+		comMockWaitError  error
+	}{
+		{
+			name:   "Success wo cred",
+			stdout: NewFakeProcess(),
+			res: []entity.Process{
+				{
+					ID:          "1111-3434-5656",
+					Host:        "1capp01",
+					Port:        "1560",
+					PID:         "5176",
+					Enabled:     "yes",
+					Running:     "yes",
+					Started:     time.Date(2023, time.August, 10, 14, 4, 43, 0, time.UTC),
+					AvailPerf:   184,
+					Connections: 6,
+					AvgCallTime: 0.25,
+				},
+				{
+					ID:         "2222-3434-5656",
+					Host:       "1capp02",
+					Port:       "1561",
+					PID:        "6120",
+					AvgThreads: 0.08,
+				},
+			},
+		},
+		{
+			name:   "Success w cred",
+			cred:   entity.Credentials{Name: "admin", Pwd: "pwd"},
+			stdout: NewFakeProcess(),
+			res: []entity.Process{
+				{
+					ID:          "1111-3434-5656",
+					Host:        "1capp01",
+					Port:        "1560",
+					PID:         "5176",
+					Enabled:     "yes",
+					Running:     "yes",
+					Started:     time.Date(2023, time.August, 10, 14, 4, 43, 0, time.UTC),
+					AvailPerf:   184,
+					Connections: 6,
+					AvgCallTime: 0.25,
+				},
+				{
+					ID:         "2222-3434-5656",
+					Host:       "1capp02",
+					Port:       "1561",
+					PID:        "6120",
+					AvgThreads: 0.08,
+				},
+			},
+		},
+		{
+			name:          "Error no command",
+			stdout:        NewFakeProcess(),
+			respError:     ": no command",
+			pipeMockError: errors.New("no command"),
+		},
+		{
+			name:              "Error start",
+			stdout:            NewFakeProcess(),
+			respError:         ": start error",
+			comMockStartError: errors.New("start error"),
+		},
+		{
+			name:             "Error wait",
+			stdout:           NewFakeProcess(),
+			respError:        ": wait error",
+			comMockWaitError: errors.New("wait error"),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := []any{"localhost:1545", "process", "list", "--cluster", "1212-3434-5656"}
+
+			if tc.cred != (entity.Credentials{}) {
+				args = append(args, "--cluster-user", tc.cred.Name, "--cluster-pwd", tc.cred.Pwd)
+			}
+
+			ctrl := New(newListMocks(t, tc.stdout, args, tc.pipeMockError, tc.comMockStartError, tc.comMockWaitError))
+
+			res, err := ctrl.GetProcesses(context.Background(), "localhost:1545", entity.Cluster{ID: "1212-3434-5656"}, tc.cred)
+
+			if tc.respError == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			} else {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.respError)
+				require.Empty(t, res)
+			}
+		})
+	}
+}
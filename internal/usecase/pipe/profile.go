@@ -0,0 +1,49 @@
+package pipe
+
+import (
+	"context"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+// GetProfiles - security profiles of cluster.
+func (r *CtrlPipe) GetProfiles(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Profile, error) {
+	args := withClusterCred([]string{entrypoint, "profile", "list", "--cluster", cluster.ID}, clusterCred)
+
+	return list[entity.Profile](ctx, r, "getprofiles", args)
+}
+
+// UpdateProfile - writing security profile, rac creates profile if there is no profile with such name.
+func (r *CtrlPipe) UpdateProfile(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.ProfileOptions) error {
+	args := []string{entrypoint, "profile", "update", "--cluster", cluster.ID,
+		"--name", opts.Name,
+		"--config", yesNo(opts.Config),
+		"--priv", yesNo(opts.Priv),
+		"--crypto", yesNo(opts.Crypto),
+		"--right-extension", yesNo(opts.RightExtension),
+		"--all-modules-extension", yesNo(opts.AllModulesExtension)}
+
+	for _, v := range []struct {
+		flag  string
+		value string
+	}{
+		{"--descr", opts.Desc},
+		{"--right-extension-definition-roles", opts.RightExtensionDefinitionRoles},
+		{"--modules-available-for-extension", opts.ModulesAvailableForExtension},
+		{"--modules-not-available-for-extension", opts.ModulesNotAvailableForExtension},
+		{"--privileged-mode-roles", opts.PrivilegedModeRoles},
+	} {
+		if v.value != "" {
+			args = append(args, v.flag, v.value)
+		}
+	}
+
+	return execute(ctx, r, "updateprofile", withClusterCred(args, clusterCred))
+}
+
+// RemoveProfile - deleting security profile by name.
+func (r *CtrlPipe) RemoveProfile(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) error {
+	args := withClusterCred([]string{entrypoint, "profile", "remove", "--cluster", cluster.ID, "--name", name}, clusterCred)
+
+	return execute(ctx, r, "removeprofile", args)
+}
@@ -0,0 +1,63 @@
+// nolint
+package pipe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func NewFakeProfile() *FakeReadCloser {
+	text := `name                                : restricted
+			 descr                               : "Web clients"
+			 config                              : no
+			 priv                                : no
+			 crypto                              : yes
+			 right-extension                     : no
+			 right-extension-definition-roles    :
+			 all-modules-extension               : yes
+			 modules-available-for-extension     :
+			 modules-not-available-for-extension :
+			 privileged-mode-roles               :`
+
+	return &FakeReadCloser{
+		body: []byte(text),
+	}
+}
+
+func TestGetProfiles(t *testing.T) {
+	t.Parallel()
+
+	args := []any{"localhost:1545", "profile", "list", "--cluster", "1111-2222"}
+
+	ctrl := New(newListMocks(t, NewFakeProfile(), args, nil, nil, nil))
+
+	res, err := ctrl.GetProfiles(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"}, entity.Credentials{})
+
+	require.NoError(t, err)
+	require.Equal(t, []entity.Profile{{Name: "restricted", Desc: "\"web clients\"", Config: "no", Priv: "no", Crypto: "yes",
+		RightExtension: "no", AllModulesExtension: "yes"}}, res)
+}
+
+func TestUpdateAndRemoveProfile(t *testing.T) {
+	t.Parallel()
+
+	args := []any{"localhost:1545", "profile", "update", "--cluster", "1111-2222", "--name", "restricted",
+		"--config", "no", "--priv", "no", "--crypto", "yes", "--right-extension", "no", "--all-modules-extension", "yes",
+		"--descr", "Web clients", "--cluster-user", "admin", "--cluster-pwd", "pwd"}
+
+	ctrl := New(newListMocks(t, NewFakeSession0(), args, nil, nil, nil))
+
+	err := ctrl.UpdateProfile(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"}, entity.Credentials{Name: "admin", Pwd: "pwd"},
+		entity.ProfileOptions{Name: "restricted", Desc: "Web clients", Crypto: true, AllModulesExtension: true})
+	require.NoError(t, err)
+
+	args = []any{"localhost:1545", "profile", "remove", "--cluster", "1111-2222", "--name", "restricted"}
+
+	ctrl = New(newListMocks(t, NewFakeSession0(), args, nil, nil, nil))
+
+	err = ctrl.RemoveProfile(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"}, entity.Credentials{}, "restricted")
+	require.NoError(t, err)
+}
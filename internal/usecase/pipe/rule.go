@@ -0,0 +1,88 @@
+package pipe
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+// GetRules - assignment rules of working server.
+func (r *CtrlPipe) GetRules(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server) ([]entity.Rule, error) {
+	args := withClusterCred([]string{entrypoint, "rule", "list", "--cluster", cluster.ID, "--server", server.ID}, clusterCred)
+
+	return list[entity.Rule](ctx, r, "getrules", args)
+}
+
+// InsertRule - adding assignment rule to working server at position, rac prints id of new rule.
+func (r *CtrlPipe) InsertRule(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, opts entity.RuleOptions) (entity.Rule, error) {
+	args := append([]string{entrypoint, "rule", "insert", "--cluster", cluster.ID, "--server", server.ID}, ruleArgs(opts)...)
+
+	rules, err := list[entity.Rule](ctx, r, "insertrule", withClusterCred(args, clusterCred))
+	if err != nil {
+		return entity.Rule{}, err
+	}
+
+	if len(rules) == 0 {
+		return entity.Rule{}, fmt.Errorf("ctrlpipe - insertrule: %w", ErrRuleIsEmpty)
+	}
+
+	return rules[0], nil
+}
+
+// UpdateRule - changing assignment rule of working server, position moves rule in the list.
+func (r *CtrlPipe) UpdateRule(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, rule string, opts entity.RuleOptions) error {
+	if rule == "" {
+		return fmt.Errorf("ctrlpipe - updaterule: %w", ErrRuleIsEmpty)
+	}
+
+	args := append([]string{entrypoint, "rule", "update", "--cluster", cluster.ID, "--server", server.ID, "--rule", rule}, ruleArgs(opts)...)
+
+	return execute(ctx, r, "updaterule", withClusterCred(args, clusterCred))
+}
+
+// RemoveRule - deleting assignment rule of working server.
+func (r *CtrlPipe) RemoveRule(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, rule string) error {
+	if rule == "" {
+		return fmt.Errorf("ctrlpipe - removerule: %w", ErrRuleIsEmpty)
+	}
+
+	args := withClusterCred([]string{entrypoint, "rule", "remove", "--cluster", cluster.ID, "--server", server.ID, "--rule", rule}, clusterCred)
+
+	return execute(ctx, r, "removerule", args)
+}
+
+// ApplyRules - applying assignment rules of all working servers of cluster,
+// full apply moves already running infobases, partial one affects only new connections.
+func (r *CtrlPipe) ApplyRules(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, full bool) error {
+	mode := "--partial"
+	if full {
+		mode = "--full"
+	}
+
+	args := withClusterCred([]string{entrypoint, "rule", "apply", "--cluster", cluster.ID, mode}, clusterCred)
+
+	return execute(ctx, r, "applyrules", args)
+}
+
+// ruleArgs - rac flags of assignment rule, empty strings are omitted.
+func ruleArgs(opts entity.RuleOptions) []string {
+	args := []string{"--position", strconv.Itoa(opts.Position)}
+
+	for _, v := range []struct {
+		flag  string
+		value string
+	}{
+		{"--object-type", opts.ObjectType},
+		{"--infobase-name", opts.InfobaseName},
+		{"--rule-type", opts.RuleType},
+		{"--application-ext", opts.ApplicationExt},
+	} {
+		if v.value != "" {
+			args = append(args, v.flag, v.value)
+		}
+	}
+
+	return append(args, "--priority", strconv.Itoa(opts.Priority))
+}
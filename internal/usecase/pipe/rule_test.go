@@ -0,0 +1,156 @@
+// nolint
+package pipe
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func NewFakeRule() *FakeReadCloser {
+	text := `rule            : 6b6f6b4e-1f5c-4c1e-9d0a-2c3b4d5e6f70
+			 object-type     :
+			 infobase-name   : ZUP
+			 rule-type       : always
+			 application-ext :
+			 priority        : 10`
+
+	return &FakeReadCloser{
+		body: []byte(text),
+	}
+}
+
+func TestGetRules(t *testing.T) {
+	cases := []struct {
+		name             string
+		res              []entity.Rule
+		respError        string
+		comMockWaitError error
+	}{
+		{
+			name: "Success",
+			res:  []entity.Rule{{ID: "6b6f6b4e-1f5c-4c1e-9d0a-2c3b4d5e6f70", InfobaseName: "zup", RuleType: "always", Priority: 10}},
+		},
+		{
+			name:             "Error wait",
+			respError:        ": wait error",
+			comMockWaitError: errors.New("wait error"),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := []any{"localhost:1545", "rule", "list", "--cluster", "1111-2222", "--server", "3333-4444"}
+
+			ctrl := New(newListMocks(t, NewFakeRule(), args, nil, nil, tc.comMockWaitError))
+
+			res, err := ctrl.GetRules(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"}, entity.Credentials{},
+				entity.Server{ID: "3333-4444"})
+
+			if tc.respError == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			} else {
+				require.ErrorContains(t, err, tc.respError)
+				require.Empty(t, res)
+			}
+		})
+	}
+}
+
+func TestInsertRule(t *testing.T) {
+	cases := []struct {
+		name   string
+		stdout *FakeReadCloser
+		res    entity.Rule
+		errIs  error
+	}{
+		{
+			name:   "Success",
+			stdout: &FakeReadCloser{body: []byte("rule : 6b6f6b4e-1f5c-4c1e-9d0a-2c3b4d5e6f70")},
+			res:    entity.Rule{ID: "6b6f6b4e-1f5c-4c1e-9d0a-2c3b4d5e6f70"},
+		},
+		{
+			name:   "Error empty output",
+			stdout: NewFakeSession0(),
+			errIs:  ErrRuleIsEmpty,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := []any{"localhost:1545", "rule", "insert", "--cluster", "1111-2222", "--server", "3333-4444",
+				"--position", "0", "--infobase-name", "zup", "--rule-type", "always", "--priority", "10",
+				"--cluster-user", "admin", "--cluster-pwd", "pwd"}
+
+			ctrl := New(newListMocks(t, tc.stdout, args, nil, nil, nil))
+
+			res, err := ctrl.InsertRule(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"},
+				entity.Credentials{Name: "admin", Pwd: "pwd"}, entity.Server{ID: "3333-4444"},
+				entity.RuleOptions{InfobaseName: "zup", RuleType: "always", Priority: 10})
+
+			if tc.errIs != nil {
+				require.ErrorIs(t, err, tc.errIs)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.res, res)
+		})
+	}
+}
+
+func TestApplyRules(t *testing.T) {
+	cases := []struct {
+		name string
+		full bool
+		mode string
+	}{
+		{
+			name: "Partial",
+			mode: "--partial",
+		},
+		{
+			name: "Full",
+			full: true,
+			mode: "--full",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := []any{"localhost:1545", "rule", "apply", "--cluster", "1111-2222", tc.mode}
+
+			ctrl := New(newListMocks(t, NewFakeSession0(), args, nil, nil, nil))
+
+			err := ctrl.ApplyRules(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"}, entity.Credentials{}, tc.full)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRemoveRuleEmpty(t *testing.T) {
+	t.Parallel()
+
+	ctrl := New(nil)
+
+	err := ctrl.RemoveRule(context.Background(), "localhost:1545", entity.Cluster{ID: "1111-2222"}, entity.Credentials{},
+		entity.Server{ID: "3333-4444"}, "")
+	require.ErrorIs(t, err, ErrRuleIsEmpty)
+}
@@ -0,0 +1,21 @@
+package pipe
+
+import (
+	"context"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+// GetServers - working servers of cluster.
+func (r *CtrlPipe) GetServers(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Server, error) {
+	args := withClusterCred([]string{entrypoint, "server", "list", "--cluster", cluster.ID}, clusterCred)
+
+	return list[entity.Server](ctx, r, "getservers", args)
+}
+
+// GetManagers - cluster managers.
+func (r *CtrlPipe) GetManagers(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Manager, error) {
+	args := withClusterCred([]string{entrypoint, "manager", "list", "--cluster", cluster.ID}, clusterCred)
+
+	return list[entity.Manager](ctx, r, "getmanagers", args)
+}
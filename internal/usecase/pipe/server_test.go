@@ -0,0 +1,160 @@
+// nolint
+package pipe
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func NewFakeServer() *FakeReadCloser {
+	text := `server                                    : 1111-3434-5656
+			 agent-host                                : 1capp01
+			 agent-port                                : 1540
+			 port-range                                : 1560:1591
+			 name                                      : "Central server"
+			 using                                     : main
+			 infobases-limit                           : 8
+			 connections-limit                         : 128
+			 cluster-port                              : 1541
+			 temporary-allowed-total-memory-time-limit : 300`
+
+	return &FakeReadCloser{
+		body: []byte(text),
+	}
+}
+
+func NewFakeManager() *FakeReadCloser {
+	text := `manager   : 1111-3434-5656
+			 pid       : 3820
+			 using     : normal
+			 host      : 1capp01
+			 main-port : 1541
+			 descr     : "Main manager"
+
+			 manager   : 2222-3434-5656
+			 pid       : 4012
+			 using     : normal
+			 host      : 1capp02
+			 main-port : 1541
+			 descr     : ""`
+
+	return &FakeReadCloser{
+		body: []byte(text),
+	}
+}
+
+func TestGetServers(t *testing.T) {
+	cases := []struct {
+		name              string
+		cred              entity.Credentials
+		res               []entity.Server
+		respError         string
+		pipeMockError     error
+		comMockStartError error
+	}{
+		{
+			name: "Success",
+			cred: entity.Credentials{Name: "admin", Pwd: "pwd"},
+			res: []entity.Server{
+				{
+					ID:                   "1111-3434-5656",
+					AgentHost:            "1capp01",
+					AgentPort:            "1540",
+					PortRange:            "1560:1591",
+					Name:                 "\"central server\"",
+					Using:                "main",
+					InfobasesLimit:       8,
+					ConnectionsLimit:     128,
+					ClusterPort:          "1541",
+					TempAllowedMemoryLim: 300,
+				},
+			},
+		},
+		{
+			name:          "Error no command",
+			respError:     ": no command",
+			pipeMockError: errors.New("no command"),
+		},
+		{
+			name:              "Error start",
+			respError:         ": start error",
+			comMockStartError: errors.New("start error"),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := []any{"localhost:1545", "server", "list", "--cluster", "1212-3434-5656"}
+
+			if tc.cred != (entity.Credentials{}) {
+				args = append(args, "--cluster-user", tc.cred.Name, "--cluster-pwd", tc.cred.Pwd)
+			}
+
+			ctrl := New(newListMocks(t, NewFakeServer(), args, tc.pipeMockError, tc.comMockStartError, nil))
+
+			res, err := ctrl.GetServers(context.Background(), "localhost:1545", entity.Cluster{ID: "1212-3434-5656"}, tc.cred)
+
+			if tc.respError == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			} else {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.respError)
+				require.Empty(t, res)
+			}
+		})
+	}
+}
+
+func TestGetManagers(t *testing.T) {
+	cases := []struct {
+		name             string
+		res              []entity.Manager
+		respError        string
+		comMockWaitError error
+	}{
+		{
+			name: "Success",
+			res: []entity.Manager{
+				{ID: "1111-3434-5656", PID: "3820", Using: "normal", Host: "1capp01", Port: "1541", Desc: "\"main manager\""},
+				{ID: "2222-3434-5656", PID: "4012", Using: "normal", Host: "1capp02", Port: "1541", Desc: "\"\""},
+			},
+		},
+		{
+			name:             "Error wait",
+			respError:        ": wait error",
+			comMockWaitError: errors.New("wait error"),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := []any{"localhost:1545", "manager", "list", "--cluster", "1212-3434-5656"}
+
+			ctrl := New(newListMocks(t, NewFakeManager(), args, nil, nil, tc.comMockWaitError))
+
+			res, err := ctrl.GetManagers(context.Background(), "localhost:1545", entity.Cluster{ID: "1212-3434-5656"}, entity.Credentials{})
+
+			if tc.respError == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			} else {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.respError)
+				require.Empty(t, res)
+			}
+		})
+	}
+}
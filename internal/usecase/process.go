@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase/cache"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+)
+
+// Processes - getting working processes list for cluster.
+func (c *CtrlUseCase) Processes(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, args map[string]any) ([]entity.Process, error) {
+	span := trace.SpanFromContext(ctx)
+
+	if v, ok := args[common.UseCache]; ok && v.(bool) {
+		span.AddEvent("GetProcesses from cache")
+
+		processes, err := c.cache.GetProcesses(ctx, entrypoint, cluster)
+
+		if err != nil && !errors.Is(err, cache.ErrNotFound) {
+			return nil, fmt.Errorf("CtrlUseCase - Processes - c.cache.GetProcesses: %w", err)
+		} else if !reflect.DeepEqual(processes, []entity.Process{}) {
+			return processes, nil
+		}
+	}
+
+	span.AddEvent("GetProcesses from 1C")
+
+	processes, err := c.pipe.GetProcesses(ctx, entrypoint, cluster, clusterCred)
+	if err != nil {
+		return nil, fmt.Errorf("CtrlUseCase - Processes - c.pipe.GetProcesses: %w", err)
+	}
+
+	span.AddEvent("PutProcesses to cache")
+
+	err = c.cache.PutProcesses(ctx, entrypoint, cluster, processes)
+	if err != nil {
+		return nil, fmt.Errorf("CtrlUseCase - Processes - c.cache.PutProcesses: %w", err)
+	}
+
+	return processes, nil
+}
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+var (
+	ErrProfileExists   = errors.New("profile already exists")
+	ErrProfileNotFound = errors.New("profile not found")
+)
+
+// Profiles - getting security profiles of cluster.
+func (c *CtrlUseCase) Profiles(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) ([]entity.Profile, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("GetProfiles from 1C")
+
+	profiles, err := c.pipe.GetProfiles(ctx, entrypoint, cluster, clusterCred)
+	if err != nil {
+		return nil, fmt.Errorf("CtrlUseCase - Profiles - c.pipe.GetProfiles: %w", err)
+	}
+
+	return profiles, nil
+}
+
+// CreateProfile - adding security profile to cluster, profile with the same name must not exist.
+func (c *CtrlUseCase) CreateProfile(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.ProfileOptions) (entity.Profile, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("check profile")
+
+	_, ok, err := c.profileByName(ctx, entrypoint, cluster, clusterCred, opts.Name)
+	if err != nil {
+		return entity.Profile{}, fmt.Errorf("CtrlUseCase - CreateProfile - c.profileByName: %w", err)
+	}
+
+	if ok {
+		return entity.Profile{}, fmt.Errorf("CtrlUseCase - CreateProfile: %s: %w", opts.Name, ErrProfileExists)
+	}
+
+	return c.writeProfile(ctx, "CreateProfile", entrypoint, cluster, clusterCred, opts)
+}
+
+// UpdateProfile - changing existing security profile of cluster.
+func (c *CtrlUseCase) UpdateProfile(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.ProfileOptions) (entity.Profile, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("check profile")
+
+	_, ok, err := c.profileByName(ctx, entrypoint, cluster, clusterCred, opts.Name)
+	if err != nil {
+		return entity.Profile{}, fmt.Errorf("CtrlUseCase - UpdateProfile - c.profileByName: %w", err)
+	}
+
+	if !ok {
+		return entity.Profile{}, fmt.Errorf("CtrlUseCase - UpdateProfile: %s: %w", opts.Name, ErrProfileNotFound)
+	}
+
+	return c.writeProfile(ctx, "UpdateProfile", entrypoint, cluster, clusterCred, opts)
+}
+
+// RemoveProfile - deleting security profile of cluster.
+func (c *CtrlUseCase) RemoveProfile(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) error {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("check profile")
+
+	_, ok, err := c.profileByName(ctx, entrypoint, cluster, clusterCred, name)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - RemoveProfile - c.profileByName: %w", err)
+	}
+
+	if !ok {
+		return fmt.Errorf("CtrlUseCase - RemoveProfile: %s: %w", name, ErrProfileNotFound)
+	}
+
+	span.AddEvent("RemoveProfile in 1C")
+
+	err = c.pipe.RemoveProfile(ctx, entrypoint, cluster, clusterCred, name)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - RemoveProfile - c.pipe.RemoveProfile: %w", err)
+	}
+
+	return nil
+}
+
+// writeProfile - writing profile to 1C and reading it back.
+func (c *CtrlUseCase) writeProfile(ctx context.Context, method string, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, opts entity.ProfileOptions) (entity.Profile, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("UpdateProfile in 1C")
+
+	err := c.pipe.UpdateProfile(ctx, entrypoint, cluster, clusterCred, opts)
+	if err != nil {
+		return entity.Profile{}, fmt.Errorf("CtrlUseCase - %s - c.pipe.UpdateProfile: %w", method, err)
+	}
+
+	profile, ok, err := c.profileByName(ctx, entrypoint, cluster, clusterCred, opts.Name)
+	if err != nil {
+		return entity.Profile{}, fmt.Errorf("CtrlUseCase - %s - c.profileByName: %w", method, err)
+	}
+
+	if !ok {
+		return entity.Profile{}, fmt.Errorf("CtrlUseCase - %s: %s: %w", method, opts.Name, ErrProfileNotFound)
+	}
+
+	return profile, nil
+}
+
+// profileByName - finding security profile of cluster, decoder lowers values so names are compared ignoring case.
+func (c *CtrlUseCase) profileByName(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, name string) (entity.Profile, bool, error) {
+	profiles, err := c.pipe.GetProfiles(ctx, entrypoint, cluster, clusterCred)
+	if err != nil {
+		return entity.Profile{}, false, err
+	}
+
+	for _, p := range profiles {
+		if entity.SameName(p.Name, name) {
+			return p, true, nil
+		}
+	}
+
+	return entity.Profile{}, false, nil
+}
@@ -0,0 +1,92 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+)
+
+func TestWriteProfile(t *testing.T) {
+	cluster := entity.Cluster{ID: "1234-5678"}
+	opts := entity.ProfileOptions{Name: "Restricted", Crypto: true}
+	written := entity.Profile{Name: "restricted", Crypto: "yes"}
+
+	cases := []struct {
+		name   string
+		create bool
+		before []entity.Profile
+		errIs  error
+	}{
+		{
+			name:   "Success create",
+			create: true,
+			before: []entity.Profile{},
+		},
+		{
+			name:   "Error create exists",
+			create: true,
+			before: []entity.Profile{{Name: "restricted"}},
+			errIs:  usecase.ErrProfileExists,
+		},
+		{
+			name:   "Success update",
+			before: []entity.Profile{{Name: "restricted"}},
+		},
+		{
+			name:   "Error update not found",
+			before: []entity.Profile{{Name: "other"}},
+			errIs:  usecase.ErrProfileNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			pipeMock := ucm.NewCtrlPipe(t)
+
+			pipeMock.On("GetProfiles", mock.Anything, "1capp01:1545", cluster, entity.Credentials{}).
+				Return(tc.before, nil).
+				Once()
+
+			if tc.errIs == nil {
+				pipeMock.On("UpdateProfile", mock.Anything, "1capp01:1545", cluster, entity.Credentials{}, opts).
+					Return(nil)
+
+				pipeMock.On("GetProfiles", mock.Anything, "1capp01:1545", cluster, entity.Credentials{}).
+					Return([]entity.Profile{written}, nil).
+					Once()
+			}
+
+			c := usecase.New(ucm.NewCtrlCache(t), pipeMock, ucm.NewCtrlBackup(t), ucm.NewCtrlJobs(t), ucm.NewCtrlCatalog(t))
+
+			var (
+				profile entity.Profile
+				err     error
+			)
+
+			if tc.create {
+				profile, err = c.CreateProfile(context.Background(), "1capp01:1545", cluster, entity.Credentials{}, opts)
+			} else {
+				profile, err = c.UpdateProfile(context.Background(), "1capp01:1545", cluster, entity.Credentials{}, opts)
+			}
+
+			if tc.errIs != nil {
+				require.ErrorIs(t, err, tc.errIs)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, written, profile)
+		})
+	}
+}
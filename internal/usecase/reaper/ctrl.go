@@ -0,0 +1,336 @@
+// Package reaper terminates sessions of clusters which are idle longer than policies allow.
+package reaper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	uc "github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+	"github.com/antonmisa/1cctl/pkg/logger"
+)
+
+const (
+	_defaultInterval time.Duration = 5 * time.Minute
+	_defaultTimeout  time.Duration = time.Minute
+
+	_auditSize int = 1000
+)
+
+// Rule - sessions of infobase idle longer than Idle, empty infobase and app ids match any.
+type Rule struct {
+	Infobase   string
+	Idle       time.Duration
+	AppIDs     []string
+	Hibernated bool
+}
+
+// Policy - rules of cluster checked in order, the first matching one terminates session.
+// Sessions of excluded applications and users are never terminated.
+type Policy struct {
+	Name          string
+	Entrypoint    string
+	Cluster       entity.Cluster
+	ClusterCred   entity.Credentials
+	Rules         []Rule
+	ExcludeAppIDs []string
+	ExcludeUsers  []string
+}
+
+// CtrlReaper - evaluating policies on interval and terminating matching sessions through usecase,
+// every found session is written to audit trail, in dry-run mode sessions are only written
+// and the same session is written once until it becomes active again.
+type CtrlReaper struct {
+	sync.Mutex
+	ctrl     uc.Ctrl
+	l        logger.Interface
+	policies []Policy
+	interval time.Duration
+	dryRun   bool
+	path     string
+	audit    []entity.ReapRecord
+	reported []map[string]time.Time
+	now      func() time.Time
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ uc.CtrlReaper = (*CtrlReaper)(nil)
+
+// New - empty auditPath keeps audit trail only in memory, zero interval means default.
+func New(ctrl uc.Ctrl, l logger.Interface, policies []Policy, interval time.Duration, dryRun bool, auditPath string) *CtrlReaper {
+	if interval <= 0 {
+		interval = _defaultInterval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &CtrlReaper{
+		ctrl:     ctrl,
+		l:        l,
+		policies: policies,
+		interval: interval,
+		dryRun:   dryRun,
+		path:     auditPath,
+		reported: make([]map[string]time.Time, len(policies)),
+		now:      time.Now,
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// Start - evaluating policies on interval in background.
+func (r *CtrlReaper) Start() {
+	r.wg.Add(1)
+
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-r.ctx.Done():
+				return
+			case <-ticker.C:
+				r.Reap()
+			}
+		}
+	}()
+}
+
+// Stop - waiting for running evaluation.
+func (r *CtrlReaper) Stop() {
+	r.cancel()
+	r.wg.Wait()
+}
+
+// Audit - the latest sessions found by policies, newest first.
+func (r *CtrlReaper) Audit(ctx context.Context) ([]entity.ReapRecord, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	records := make([]entity.ReapRecord, 0, len(r.audit))
+
+	for i := len(r.audit) - 1; i >= 0; i-- {
+		records = append(records, r.audit[i])
+	}
+
+	return records, nil
+}
+
+// Reap - evaluating all policies once.
+func (r *CtrlReaper) Reap() {
+	for i, p := range r.policies {
+		err := r.reap(i, p)
+		if err != nil {
+			r.l.Error(fmt.Errorf("ctrlreaper - reap: %s: %w", p.Name, err))
+		}
+	}
+}
+
+func (r *CtrlReaper) reap(i int, p Policy) error {
+	ctx, cancel := context.WithTimeout(r.ctx, _defaultTimeout)
+	defer cancel()
+
+	infobases, err := r.ctrl.Infobases(ctx, p.Entrypoint, p.Cluster, p.ClusterCred, map[string]any{common.UseCache: true})
+	if err != nil {
+		return fmt.Errorf("r.ctrl.Infobases: %w", err)
+	}
+
+	names := make(map[string]string, len(infobases))
+	for _, ib := range infobases {
+		names[ib.ID] = entity.TrimName(ib.Name)
+	}
+
+	sessions, err := r.ctrl.Sessions(ctx, p.Entrypoint, p.Cluster, p.ClusterCred, entity.Infobase{}, map[string]any{common.UseCache: false})
+	if err != nil {
+		return fmt.Errorf("r.ctrl.Sessions: %w", err)
+	}
+
+	now := r.now()
+	wall := wallClock(now)
+
+	var (
+		victims []entity.Session
+		records []entity.ReapRecord
+	)
+
+	// Sessions found by previous dry run with the same last activity are still in the same idle streak.
+	r.Lock()
+	reported := r.reported[i]
+	r.Unlock()
+
+	seen := make(map[string]time.Time)
+
+	for _, s := range sessions {
+		reason, ok := match(p, names[s.InfobaseID], s, wall)
+		if !ok {
+			continue
+		}
+
+		if r.dryRun {
+			seen[s.ID] = s.LastActive
+
+			if last, ok := reported[s.ID]; ok && last.Equal(s.LastActive) {
+				continue
+			}
+		}
+
+		victims = append(victims, s)
+		records = append(records, entity.ReapRecord{
+			Time:       now,
+			Policy:     p.Name,
+			Entrypoint: p.Entrypoint,
+			ClusterID:  p.Cluster.ID,
+			Infobase:   names[s.InfobaseID],
+			SessionID:  s.ID,
+			SID:        s.SID,
+			UserName:   s.UserName,
+			AppID:      s.AppID,
+			Host:       s.Host,
+			LastActive: s.LastActive,
+			Reason:     reason,
+			DryRun:     r.dryRun,
+		})
+	}
+
+	if r.dryRun {
+		r.Lock()
+		r.reported[i] = seen
+		r.Unlock()
+	}
+
+	if len(victims) == 0 {
+		return nil
+	}
+
+	if !r.dryRun {
+		err = r.ctrl.DeleteSessions(ctx, p.Entrypoint, p.Cluster, p.ClusterCred, victims)
+		if err != nil {
+			err = fmt.Errorf("r.ctrl.DeleteSessions: %w", err)
+
+			for i := range records {
+				records[i].Error = err.Error()
+			}
+		}
+	}
+
+	r.record(records)
+
+	return err
+}
+
+// wallClock - rac reports times in local time of server without zone and decoder keeps them as UTC,
+// so current time is moved to the same wall clock before measuring idle time.
+func wallClock(t time.Time) time.Time {
+	t = t.Local()
+
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// match - reason of termination if session is matched by rules of policy and not excluded,
+// now is expected on the same wall clock as times of session.
+func match(p Policy, infobase string, s entity.Session, now time.Time) (string, bool) {
+	if contains(p.ExcludeAppIDs, s.AppID) || contains(p.ExcludeUsers, s.UserName) {
+		return "", false
+	}
+
+	// Session which was never active is not judged.
+	if s.LastActive.IsZero() {
+		return "", false
+	}
+
+	idle := now.Sub(s.LastActive)
+
+	for i, rule := range p.Rules {
+		if rule.Infobase != "" && !entity.SameName(rule.Infobase, infobase) {
+			continue
+		}
+
+		if len(rule.AppIDs) > 0 && !contains(rule.AppIDs, s.AppID) {
+			continue
+		}
+
+		if rule.Hibernated && s.Hibernate != "yes" {
+			continue
+		}
+
+		if idle < rule.Idle {
+			continue
+		}
+
+		return fmt.Sprintf("idle for %s, rule %d: idle %s", idle.Truncate(time.Second), i+1, rule.Idle), true
+	}
+
+	return "", false
+}
+
+// contains - decoder lowers values so they are compared ignoring case.
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if entity.SameName(value, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// record - keeping records in memory and appending them to audit file as json lines.
+func (r *CtrlReaper) record(records []entity.ReapRecord) {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, rec := range records {
+		r.l.Warn(fmt.Sprintf("ctrlreaper - record: policy %s, session %d of %s in %s (%s): %s, dry run: %t",
+			rec.Policy, rec.SID, rec.UserName, rec.Infobase, rec.AppID, rec.Reason, rec.DryRun))
+	}
+
+	r.audit = append(r.audit, records...)
+	if len(r.audit) > _auditSize {
+		r.audit = r.audit[len(r.audit)-_auditSize:]
+	}
+
+	if r.path == "" {
+		return
+	}
+
+	err := r.append(records)
+	if err != nil {
+		r.l.Error(fmt.Errorf("ctrlreaper - record - r.append: %w", err))
+	}
+}
+
+func (r *CtrlReaper) append(records []entity.ReapRecord) error {
+	err := os.MkdirAll(filepath.Dir(r.path), 0755)
+	if err != nil {
+		return fmt.Errorf("os.MkdirAll: %w", err)
+	}
+
+	f, err := os.OpenFile(r.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("os.OpenFile: %w", err)
+	}
+
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+
+	for _, rec := range records {
+		if err = enc.Encode(rec); err != nil {
+			return fmt.Errorf("enc.Encode: %w", err)
+		}
+	}
+
+	return nil
+}
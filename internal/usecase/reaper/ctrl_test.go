@@ -0,0 +1,297 @@
+package reaper
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func newLogMock(t *testing.T) *lm.Interface {
+	logMock := lm.NewInterface(t)
+
+	logMock.On("Warn", mock.AnythingOfType("string")).Maybe()
+	logMock.On("Error", mock.Anything).Maybe()
+
+	return logMock
+}
+
+func TestMatch(t *testing.T) {
+	now := time.Date(2023, 8, 10, 14, 0, 0, 0, time.UTC)
+
+	policy := Policy{
+		Name: "buh-idle",
+		Rules: []Rule{
+			{Infobase: "buh", Idle: 2 * time.Hour, AppIDs: []string{"1CV8C"}},
+			{Infobase: `"Zup Corp"`, Idle: time.Hour, AppIDs: []string{"1CV8"}},
+			{Idle: 30 * time.Minute, Hibernated: true},
+		},
+		ExcludeAppIDs: []string{"BackgroundJob"},
+		ExcludeUsers:  []string{"robot"},
+	}
+
+	cases := []struct {
+		name     string
+		infobase string
+		session  entity.Session
+		reason   string
+		ok       bool
+	}{
+		{
+			name:     "Idle thin client",
+			infobase: "buh",
+			session:  entity.Session{AppID: "1cv8c", LastActive: now.Add(-3 * time.Hour)},
+			reason:   "idle for 3h0m0s, rule 1: idle 2h0m0s",
+			ok:       true,
+		},
+		{
+			name:     "Active thin client",
+			infobase: "buh",
+			session:  entity.Session{AppID: "1cv8c", LastActive: now.Add(-time.Hour)},
+		},
+		{
+			name:     "Other application",
+			infobase: "buh",
+			session:  entity.Session{AppID: "designer", LastActive: now.Add(-3 * time.Hour)},
+		},
+		{
+			name:     "Quoted infobase name",
+			infobase: "zup corp",
+			session:  entity.Session{AppID: "1cv8", LastActive: now.Add(-2 * time.Hour)},
+			reason:   "idle for 2h0m0s, rule 2: idle 1h0m0s",
+			ok:       true,
+		},
+		{
+			name:     "Hibernated in other infobase",
+			infobase: "zup",
+			session:  entity.Session{AppID: "1cv8", Hibernate: "yes", LastActive: now.Add(-time.Hour)},
+			reason:   "idle for 1h0m0s, rule 3: idle 30m0s",
+			ok:       true,
+		},
+		{
+			name:     "Excluded application",
+			infobase: "zup",
+			session:  entity.Session{AppID: "backgroundjob", Hibernate: "yes", LastActive: now.Add(-time.Hour)},
+		},
+		{
+			name:     "Excluded user",
+			infobase: "buh",
+			session:  entity.Session{AppID: "1cv8c", UserName: "Robot", LastActive: now.Add(-3 * time.Hour)},
+		},
+		{
+			name:     "Never active",
+			infobase: "buh",
+			session:  entity.Session{AppID: "1cv8c"},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			reason, ok := match(policy, tc.infobase, tc.session, now)
+
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.reason, reason)
+		})
+	}
+}
+
+func TestReap(t *testing.T) {
+	now := time.Date(2023, 8, 10, 14, 0, 0, 0, time.Local).UTC()
+	wall := time.Date(2023, 8, 10, 14, 0, 0, 0, time.UTC)
+	cluster := entity.Cluster{ID: "1234-5678"}
+
+	idle := entity.Session{ID: "a", SID: 1, InfobaseID: "ib-1", AppID: "1cv8c", UserName: "ivanov", LastActive: wall.Add(-3 * time.Hour)}
+	active := entity.Session{ID: "b", SID: 2, InfobaseID: "ib-1", AppID: "1cv8c", UserName: "petrov", LastActive: wall}
+
+	cases := []struct {
+		name      string
+		dryRun    bool
+		deleteErr error
+		errText   string
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name:   "Dry run",
+			dryRun: true,
+		},
+		{
+			name:      "Error delete",
+			deleteErr: errors.New("access denied"),
+			errText:   "r.ctrl.DeleteSessions: access denied",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			ctrlMock.On("Infobases", mock.Anything, "1capp01:1545", cluster, entity.Credentials{}, mock.Anything).
+				Return([]entity.Infobase{{ID: "ib-1", Name: "buh"}}, nil)
+
+			ctrlMock.On("Sessions", mock.Anything, "1capp01:1545", cluster, entity.Credentials{}, entity.Infobase{}, mock.Anything).
+				Return([]entity.Session{idle, active}, nil)
+
+			if !tc.dryRun {
+				ctrlMock.On("DeleteSessions", mock.Anything, "1capp01:1545", cluster, entity.Credentials{}, []entity.Session{idle}).
+					Return(tc.deleteErr)
+			}
+
+			path := filepath.Join(t.TempDir(), "audit", "reaper.log")
+
+			r := New(ctrlMock, newLogMock(t), []Policy{{
+				Name:       "buh-idle",
+				Entrypoint: "1capp01:1545",
+				Cluster:    cluster,
+				Rules:      []Rule{{Infobase: "buh", Idle: 2 * time.Hour}},
+			}}, 0, tc.dryRun, path)
+
+			r.now = func() time.Time { return now }
+
+			r.Reap()
+
+			expected := []entity.ReapRecord{{
+				Time:       now,
+				Policy:     "buh-idle",
+				Entrypoint: "1capp01:1545",
+				ClusterID:  "1234-5678",
+				Infobase:   "buh",
+				SessionID:  "a",
+				SID:        1,
+				UserName:   "ivanov",
+				AppID:      "1cv8c",
+				LastActive: idle.LastActive,
+				Reason:     "idle for 3h0m0s, rule 1: idle 2h0m0s",
+				DryRun:     tc.dryRun,
+				Error:      tc.errText,
+			}}
+
+			records, err := r.Audit(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, expected, records)
+
+			f, err := os.Open(path)
+			require.NoError(t, err)
+
+			defer f.Close()
+
+			var written []entity.ReapRecord
+
+			scanner := bufio.NewScanner(f)
+			for scanner.Scan() {
+				var rec entity.ReapRecord
+
+				require.NoError(t, json.Unmarshal(scanner.Bytes(), &rec))
+
+				written = append(written, rec)
+			}
+
+			require.Equal(t, expected, written)
+		})
+	}
+}
+
+// TestReapLocalTime - not parallel as it changes local time zone of process.
+func TestReapLocalTime(t *testing.T) {
+	local := time.Local
+	time.Local = time.FixedZone("EST", -5*60*60)
+
+	t.Cleanup(func() { time.Local = local })
+
+	cluster := entity.Cluster{ID: "1234-5678"}
+
+	// Times of rac are wall clock of server, 14:00 EST is 19:00 UTC.
+	idle := entity.Session{ID: "a", SID: 1, InfobaseID: "ib-1", LastActive: time.Date(2023, 8, 10, 11, 0, 0, 0, time.UTC)}
+	active := entity.Session{ID: "b", SID: 2, InfobaseID: "ib-1", LastActive: time.Date(2023, 8, 10, 13, 30, 0, 0, time.UTC)}
+
+	ctrlMock := ucm.NewCtrl(t)
+
+	ctrlMock.On("Infobases", mock.Anything, "1capp01:1545", cluster, entity.Credentials{}, mock.Anything).
+		Return([]entity.Infobase{{ID: "ib-1", Name: "buh"}}, nil)
+
+	ctrlMock.On("Sessions", mock.Anything, "1capp01:1545", cluster, entity.Credentials{}, entity.Infobase{}, mock.Anything).
+		Return([]entity.Session{idle, active}, nil)
+
+	r := New(ctrlMock, newLogMock(t), []Policy{{
+		Name:       "buh-idle",
+		Entrypoint: "1capp01:1545",
+		Cluster:    cluster,
+		Rules:      []Rule{{Idle: 2 * time.Hour}},
+	}}, 0, true, "")
+
+	r.now = func() time.Time { return time.Date(2023, 8, 10, 19, 0, 0, 0, time.UTC) }
+
+	r.Reap()
+
+	records, err := r.Audit(context.Background())
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "a", records[0].SessionID)
+	require.Equal(t, "idle for 3h0m0s, rule 1: idle 2h0m0s", records[0].Reason)
+}
+
+func TestReapDryRunOnce(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 8, 10, 14, 0, 0, 0, time.Local).UTC()
+	wall := time.Date(2023, 8, 10, 14, 0, 0, 0, time.UTC)
+	cluster := entity.Cluster{ID: "1234-5678"}
+
+	idle := entity.Session{ID: "a", SID: 1, InfobaseID: "ib-1", LastActive: wall.Add(-3 * time.Hour)}
+	again := entity.Session{ID: "a", SID: 1, InfobaseID: "ib-1", LastActive: wall.Add(-time.Hour)}
+
+	ctrlMock := ucm.NewCtrl(t)
+
+	ctrlMock.On("Infobases", mock.Anything, "1capp01:1545", cluster, entity.Credentials{}, mock.Anything).
+		Return([]entity.Infobase{{ID: "ib-1", Name: "buh"}}, nil)
+
+	ctrlMock.On("Sessions", mock.Anything, "1capp01:1545", cluster, entity.Credentials{}, entity.Infobase{}, mock.Anything).
+		Return([]entity.Session{idle}, nil).Times(2)
+
+	// Session was active in between and is idle again.
+	ctrlMock.On("Sessions", mock.Anything, "1capp01:1545", cluster, entity.Credentials{}, entity.Infobase{}, mock.Anything).
+		Return([]entity.Session{again}, nil).Once()
+
+	r := New(ctrlMock, newLogMock(t), []Policy{{
+		Name:       "buh-idle",
+		Entrypoint: "1capp01:1545",
+		Cluster:    cluster,
+		Rules:      []Rule{{Idle: 30 * time.Minute}},
+	}}, 0, true, "")
+
+	r.now = func() time.Time { return now }
+
+	r.Reap()
+	r.Reap()
+
+	records, err := r.Audit(context.Background())
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+
+	r.Reap()
+
+	records, err = r.Audit(context.Background())
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, again.LastActive, records[0].LastActive)
+}
@@ -0,0 +1,83 @@
+// Package registry keeps named ras entrypoints known to service.
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	uc "github.com/antonmisa/1cctl/internal/usecase"
+)
+
+var (
+	ErrDuplicateName      = errors.New("duplicate name of entrypoint")
+	ErrEmptyAddress       = errors.New("address of entrypoint is empty")
+	ErrEntrypointNotFound = errors.New("entrypoint not found")
+)
+
+// CtrlRegistry - named entrypoints, in allowlist mode only their addresses may be requested.
+type CtrlRegistry struct {
+	entrypoints []entity.Entrypoint
+	byName      map[string]int
+	allowlist   bool
+}
+
+var _ uc.CtrlRegistry = (*CtrlRegistry)(nil)
+
+// New - names are unique ignoring case.
+func New(entrypoints []entity.Entrypoint, allowlist bool) (*CtrlRegistry, error) {
+	r := &CtrlRegistry{
+		entrypoints: make([]entity.Entrypoint, 0, len(entrypoints)),
+		byName:      make(map[string]int, len(entrypoints)),
+		allowlist:   allowlist,
+	}
+
+	for _, e := range entrypoints {
+		name := strings.ToLower(e.Name)
+
+		if _, ok := r.byName[name]; ok {
+			return nil, fmt.Errorf("ctrlregistry - new: %s: %w", e.Name, ErrDuplicateName)
+		}
+
+		if e.Address == "" {
+			return nil, fmt.Errorf("ctrlregistry - new: %s: %w", e.Name, ErrEmptyAddress)
+		}
+
+		r.byName[name] = len(r.entrypoints)
+		r.entrypoints = append(r.entrypoints, e)
+	}
+
+	return r, nil
+}
+
+// Entrypoints - all entrypoints in order of config.
+func (r *CtrlRegistry) Entrypoints(ctx context.Context) ([]entity.Entrypoint, error) {
+	return append([]entity.Entrypoint{}, r.entrypoints...), nil
+}
+
+// Entrypoint - entrypoint by name.
+func (r *CtrlRegistry) Entrypoint(ctx context.Context, name string) (entity.Entrypoint, error) {
+	i, ok := r.byName[strings.ToLower(name)]
+	if !ok {
+		return entity.Entrypoint{}, fmt.Errorf("ctrlregistry - entrypoint: %s: %w", name, ErrEntrypointNotFound)
+	}
+
+	return r.entrypoints[i], nil
+}
+
+// Allowed - any address is allowed unless registry is in allowlist mode.
+func (r *CtrlRegistry) Allowed(ctx context.Context, address string) bool {
+	if !r.allowlist {
+		return true
+	}
+
+	for _, e := range r.entrypoints {
+		if strings.EqualFold(e.Address, address) {
+			return true
+		}
+	}
+
+	return false
+}
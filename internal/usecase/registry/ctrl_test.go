@@ -0,0 +1,115 @@
+package registry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+func TestNew(t *testing.T) {
+	cases := []struct {
+		name        string
+		entrypoints []entity.Entrypoint
+		err         error
+	}{
+		{
+			name: "Ok",
+			entrypoints: []entity.Entrypoint{
+				{Name: "main", Address: "1capp01:1545"},
+				{Name: "reserve", Address: "1capp02:1545"},
+			},
+		},
+		{
+			name: "Duplicate name",
+			entrypoints: []entity.Entrypoint{
+				{Name: "main", Address: "1capp01:1545"},
+				{Name: "Main", Address: "1capp02:1545"},
+			},
+			err: ErrDuplicateName,
+		},
+		{
+			name: "Empty address",
+			entrypoints: []entity.Entrypoint{
+				{Name: "main"},
+			},
+			err: ErrEmptyAddress,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := New(tc.entrypoints, false)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestEntrypoint(t *testing.T) {
+	main := entity.Entrypoint{
+		Name:        "main",
+		Address:     "1capp01:1545",
+		ClusterCred: entity.Credentials{Name: "admin"},
+	}
+
+	r, err := New([]entity.Entrypoint{main}, false)
+	require.NoError(t, err)
+
+	ep, err := r.Entrypoint(context.Background(), "MAIN")
+	require.NoError(t, err)
+	require.Equal(t, main, ep)
+
+	_, err = r.Entrypoint(context.Background(), "reserve")
+	require.ErrorIs(t, err, ErrEntrypointNotFound)
+
+	entrypoints, err := r.Entrypoints(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []entity.Entrypoint{main}, entrypoints)
+}
+
+func TestAllowed(t *testing.T) {
+	entrypoints := []entity.Entrypoint{{Name: "main", Address: "1capp01:1545"}}
+
+	cases := []struct {
+		name      string
+		allowlist bool
+		address   string
+		allowed   bool
+	}{
+		{
+			name:    "Any wo allowlist",
+			address: "1capp02:1545",
+			allowed: true,
+		},
+		{
+			name:      "Known",
+			allowlist: true,
+			address:   "1CAPP01:1545",
+			allowed:   true,
+		},
+		{
+			name:      "Unknown",
+			allowlist: true,
+			address:   "1capp02:1545",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := New(entrypoints, tc.allowlist)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.allowed, r.Allowed(context.Background(), tc.address))
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+var (
+	ErrBackupFileNotFound  = errors.New("backup file not found")
+	ErrRestoreNotConfirmed = errors.New("restore is not confirmed by name of infobase")
+)
+
+const (
+	_jobRestore string = "restore"
+
+	_stepRestore string = "restore"
+)
+
+// Restore - enqueueing load of infobase from dump in backup directory under the same protection as safe backup:
+// sessions are denied, users are warned, remaining sessions are terminated and infobase is unlocked at the end.
+func (c *CtrlUseCase) Restore(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, opts entity.RestoreOptions) (entity.Job, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("check backup file")
+
+	inputPath, err := c.backupFile(opts.File)
+	if err != nil {
+		return entity.Job{}, fmt.Errorf("CtrlUseCase - Restore - c.backupFile: %w", err)
+	}
+
+	span.AddEvent("resolve cluster and infobase")
+
+	cluster, infobase, err = c.resolveInfobase(ctx, entrypoint, cluster, clusterCred, infobase)
+	if err != nil {
+		return entity.Job{}, fmt.Errorf("CtrlUseCase - Restore - c.resolveInfobase: %w", err)
+	}
+
+	if !entity.SameName(opts.Confirm, infobase.Name) {
+		return entity.Job{}, fmt.Errorf("CtrlUseCase - Restore: %w", ErrRestoreNotConfirmed)
+	}
+
+	w := c.newLockedRun("Restore", entrypoint, cluster, clusterCred, infobase, infobaseCred, opts.LockCode, opts.Message, opts.GracePeriod)
+
+	w.work = _stepRestore
+	w.do = func(ctx context.Context, update func(change func(job *entity.Job))) error {
+		result, err := c.backup.RunRestore(ctx, cluster, infobase, infobaseCred, w.lockCode, inputPath)
+
+		update(func(job *entity.Job) {
+			job.ExitCode = result.ExitCode
+			job.Log = result.Log
+		})
+
+		if err != nil {
+			return fmt.Errorf("CtrlUseCase - Restore - c.backup.RunRestore: %w", err)
+		}
+
+		return nil
+	}
+
+	span.AddEvent("enqueue restore job")
+
+	job, err := w.enqueue(ctx, entity.Job{Kind: _jobRestore, InputPath: inputPath})
+	if err != nil {
+		return entity.Job{}, fmt.Errorf("CtrlUseCase - Restore - c.jobs.Enqueue: %w", err)
+	}
+
+	return job, nil
+}
+
+// backupFile - resolving dump relative to backup directory, paths leading outside of it are refused.
+func (c *CtrlUseCase) backupFile(file string) (string, error) {
+	dir, err := filepath.Abs(c.backupDir)
+	if err != nil {
+		return "", fmt.Errorf("filepath.Abs: %w", err)
+	}
+
+	path := file
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+
+	path = filepath.Clean(path)
+
+	rel, err := filepath.Rel(dir, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s: %w", file, ErrBackupFileNotFound)
+	}
+
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("%s: %w", file, ErrBackupFileNotFound)
+	} else if err != nil {
+		return "", fmt.Errorf("os.Stat: %w", err)
+	}
+
+	if !info.Mode().IsRegular() {
+		return "", fmt.Errorf("%s: %w", file, ErrBackupFileNotFound)
+	}
+
+	return path, nil
+}
@@ -0,0 +1,144 @@
+package usecase_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/internal/usecase/jobs"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+)
+
+func TestRestore(t *testing.T) {
+	cluster := entity.Cluster{ID: "1234-5678", Host: "1capp01", Port: "1541"}
+	infobase := entity.Infobase{ID: "8765-4321", Name: `"ib"`}
+
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ib.dt"), []byte("dump"), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(filepath.Dir(dir), "outside.dt"), []byte("dump"), 0o600))
+
+	t.Cleanup(func() { os.Remove(filepath.Join(filepath.Dir(dir), "outside.dt")) })
+
+	cases := []struct {
+		name    string
+		file    string
+		confirm string
+		errIs   error
+	}{
+		{
+			name:    "Error file does not exist",
+			file:    "unknown.dt",
+			confirm: "ib",
+			errIs:   usecase.ErrBackupFileNotFound,
+		},
+		{
+			name:    "Error file outside backup directory",
+			file:    "../outside.dt",
+			confirm: "ib",
+			errIs:   usecase.ErrBackupFileNotFound,
+		},
+		{
+			name:    "Error file is directory",
+			file:    "sub",
+			confirm: "ib",
+			errIs:   usecase.ErrBackupFileNotFound,
+		},
+		{
+			name:    "Error not confirmed",
+			file:    "ib.dt",
+			confirm: "other",
+			errIs:   usecase.ErrRestoreNotConfirmed,
+		},
+		{
+			name:    "Success",
+			file:    "ib.dt",
+			confirm: "ib",
+		},
+		{
+			name:    "Success confirmed ignoring case",
+			file:    "ib.dt",
+			confirm: "IB",
+		},
+		{
+			name:    "Success absolute path",
+			file:    filepath.Join(dir, "ib.dt"),
+			confirm: "ib",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cacheMock := ucm.NewCtrlCache(t)
+
+			cacheMock.On("GetClusters", mock.Anything, mock.AnythingOfType("string")).
+				Return([]entity.Cluster{cluster}, nil).
+				Maybe()
+
+			cacheMock.On("GetInfobases", mock.Anything, mock.AnythingOfType("string"), mock.Anything).
+				Return([]entity.Infobase{infobase}, nil).
+				Maybe()
+
+			pipeMock := ucm.NewCtrlPipe(t)
+			backupMock := ucm.NewCtrlBackup(t)
+
+			if tc.errIs == nil {
+				pipeMock.On("DisableSessions", mock.Anything, mock.AnythingOfType("string"), cluster, infobase, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+
+				pipeMock.On("GetSessions", mock.Anything, mock.AnythingOfType("string"), cluster, infobase, mock.Anything).
+					Return([]entity.Session{}, nil)
+
+				pipeMock.On("EnableSessions", mock.Anything, mock.AnythingOfType("string"), cluster, infobase, mock.Anything, mock.Anything, "12345").
+					Return(nil)
+
+				backupMock.On("RunRestore", mock.Anything, cluster, infobase, mock.Anything, "12345", filepath.Join(dir, "ib.dt")).
+					Return(entity.DesignerResult{ExitCode: 0, Log: "done"}, nil)
+			}
+
+			j := jobs.New(1, 1)
+			defer j.Close()
+
+			c := usecase.New(cacheMock, pipeMock, backupMock, j, ucm.NewCtrlCatalog(t),
+				usecase.BackupDir(dir),
+				usecase.LockCode("12345"),
+			)
+
+			job, err := c.Restore(context.Background(), "1capp01:1545", entity.Cluster{ID: cluster.ID}, entity.Credentials{},
+				entity.Infobase{ID: infobase.ID}, entity.Credentials{}, entity.RestoreOptions{File: tc.file, Confirm: tc.confirm})
+
+			if tc.errIs != nil {
+				require.ErrorIs(t, err, tc.errIs)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, filepath.Join(dir, "ib.dt"), job.InputPath)
+
+			require.Eventually(t, func() bool {
+				job, err = c.Job(context.Background(), job.ID)
+				require.NoError(t, err)
+
+				return job.Status == entity.JobSucceeded || job.Status == entity.JobFailed
+			}, time.Second, time.Millisecond)
+
+			require.Equal(t, entity.JobSucceeded, job.Status)
+			require.Equal(t, "restore", job.Steps[3].Name)
+			require.Equal(t, entity.JobSucceeded, job.Steps[3].Status)
+			require.Equal(t, "done", job.Log)
+		})
+	}
+}
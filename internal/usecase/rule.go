@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+var ErrRuleNotFound = errors.New("rule not found")
+
+// Rules - getting assignment rules of working server.
+func (c *CtrlUseCase) Rules(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server) ([]entity.Rule, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("GetRules from 1C")
+
+	rules, err := c.pipe.GetRules(ctx, entrypoint, cluster, clusterCred, server)
+	if err != nil {
+		return nil, fmt.Errorf("CtrlUseCase - Rules - c.pipe.GetRules: %w", err)
+	}
+
+	return rules, nil
+}
+
+// InsertRule - adding assignment rule to working server and reading it back.
+func (c *CtrlUseCase) InsertRule(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, opts entity.RuleOptions) (entity.Rule, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("InsertRule in 1C")
+
+	inserted, err := c.pipe.InsertRule(ctx, entrypoint, cluster, clusterCred, server, opts)
+	if err != nil {
+		return entity.Rule{}, fmt.Errorf("CtrlUseCase - InsertRule - c.pipe.InsertRule: %w", err)
+	}
+
+	rule, ok, err := c.ruleByID(ctx, entrypoint, cluster, clusterCred, server, inserted.ID)
+	if err != nil {
+		return entity.Rule{}, fmt.Errorf("CtrlUseCase - InsertRule - c.ruleByID: %w", err)
+	}
+
+	if !ok {
+		return entity.Rule{}, fmt.Errorf("CtrlUseCase - InsertRule: %s: %w", inserted.ID, ErrRuleNotFound)
+	}
+
+	return rule, nil
+}
+
+// UpdateRule - changing existing assignment rule of working server.
+func (c *CtrlUseCase) UpdateRule(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, rule string, opts entity.RuleOptions) (entity.Rule, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("check rule")
+
+	_, ok, err := c.ruleByID(ctx, entrypoint, cluster, clusterCred, server, rule)
+	if err != nil {
+		return entity.Rule{}, fmt.Errorf("CtrlUseCase - UpdateRule - c.ruleByID: %w", err)
+	}
+
+	if !ok {
+		return entity.Rule{}, fmt.Errorf("CtrlUseCase - UpdateRule: %s: %w", rule, ErrRuleNotFound)
+	}
+
+	span.AddEvent("UpdateRule in 1C")
+
+	err = c.pipe.UpdateRule(ctx, entrypoint, cluster, clusterCred, server, rule, opts)
+	if err != nil {
+		return entity.Rule{}, fmt.Errorf("CtrlUseCase - UpdateRule - c.pipe.UpdateRule: %w", err)
+	}
+
+	updated, ok, err := c.ruleByID(ctx, entrypoint, cluster, clusterCred, server, rule)
+	if err != nil {
+		return entity.Rule{}, fmt.Errorf("CtrlUseCase - UpdateRule - c.ruleByID: %w", err)
+	}
+
+	if !ok {
+		return entity.Rule{}, fmt.Errorf("CtrlUseCase - UpdateRule: %s: %w", rule, ErrRuleNotFound)
+	}
+
+	return updated, nil
+}
+
+// RemoveRule - deleting assignment rule of working server.
+func (c *CtrlUseCase) RemoveRule(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, rule string) error {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("check rule")
+
+	_, ok, err := c.ruleByID(ctx, entrypoint, cluster, clusterCred, server, rule)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - RemoveRule - c.ruleByID: %w", err)
+	}
+
+	if !ok {
+		return fmt.Errorf("CtrlUseCase - RemoveRule: %s: %w", rule, ErrRuleNotFound)
+	}
+
+	span.AddEvent("RemoveRule in 1C")
+
+	err = c.pipe.RemoveRule(ctx, entrypoint, cluster, clusterCred, server, rule)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - RemoveRule - c.pipe.RemoveRule: %w", err)
+	}
+
+	return nil
+}
+
+// ApplyRules - applying assignment rules of all working servers of cluster.
+func (c *CtrlUseCase) ApplyRules(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, full bool) error {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("ApplyRules in 1C")
+
+	err := c.pipe.ApplyRules(ctx, entrypoint, cluster, clusterCred, full)
+	if err != nil {
+		return fmt.Errorf("CtrlUseCase - ApplyRules - c.pipe.ApplyRules: %w", err)
+	}
+
+	return nil
+}
+
+// ruleByID - finding assignment rule of working server.
+func (c *CtrlUseCase) ruleByID(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, server entity.Server, id string) (entity.Rule, bool, error) {
+	rules, err := c.pipe.GetRules(ctx, entrypoint, cluster, clusterCred, server)
+	if err != nil {
+		return entity.Rule{}, false, err
+	}
+
+	for _, r := range rules {
+		if strings.EqualFold(r.ID, id) {
+			return r, true, nil
+		}
+	}
+
+	return entity.Rule{}, false, nil
+}
@@ -0,0 +1,71 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+)
+
+func TestUpdateRule(t *testing.T) {
+	cluster := entity.Cluster{ID: "1234-5678"}
+	server := entity.Server{ID: "8765-4321"}
+	opts := entity.RuleOptions{InfobaseName: "zup", RuleType: "never"}
+
+	cases := []struct {
+		name  string
+		rules []entity.Rule
+		res   entity.Rule
+		errIs error
+	}{
+		{
+			name:  "Success",
+			rules: []entity.Rule{{ID: "1111-2222", InfobaseName: "zup", RuleType: "always"}},
+			res:   entity.Rule{ID: "1111-2222", InfobaseName: "zup", RuleType: "never"},
+		},
+		{
+			name:  "Error not found",
+			rules: []entity.Rule{{ID: "3333-4444"}},
+			errIs: usecase.ErrRuleNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			pipeMock := ucm.NewCtrlPipe(t)
+
+			pipeMock.On("GetRules", mock.Anything, "1capp01:1545", cluster, entity.Credentials{}, server).
+				Return(tc.rules, nil).Once()
+
+			if tc.errIs == nil {
+				pipeMock.On("UpdateRule", mock.Anything, "1capp01:1545", cluster, entity.Credentials{}, server, "1111-2222", opts).
+					Return(nil)
+
+				pipeMock.On("GetRules", mock.Anything, "1capp01:1545", cluster, entity.Credentials{}, server).
+					Return([]entity.Rule{tc.res}, nil).Once()
+			}
+
+			c := usecase.New(ucm.NewCtrlCache(t), pipeMock, ucm.NewCtrlBackup(t), ucm.NewCtrlJobs(t), ucm.NewCtrlCatalog(t))
+
+			res, err := c.UpdateRule(context.Background(), "1capp01:1545", cluster, entity.Credentials{}, server, "1111-2222", opts)
+
+			if tc.errIs != nil {
+				require.ErrorIs(t, err, tc.errIs)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.res, res)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+const (
+	_jobSafeBackup string = "safebackup"
+
+	_stepDump string = "dump"
+)
+
+// SafeBackup - enqueueing backup of infobase with denying sessions, warning users during grace period,
+// terminating remaining sessions and dumping; infobase is unlocked at the end whatever happened.
+func (c *CtrlUseCase) SafeBackup(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, infobase entity.Infobase, infobaseCred entity.Credentials, opts entity.SafeBackupOptions) (entity.Job, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.AddEvent("resolve cluster and infobase")
+
+	cluster, infobase, err := c.resolveInfobase(ctx, entrypoint, cluster, clusterCred, infobase)
+	if err != nil {
+		return entity.Job{}, fmt.Errorf("CtrlUseCase - SafeBackup - c.resolveInfobase: %w", err)
+	}
+
+	w := c.newLockedRun("SafeBackup", entrypoint, cluster, clusterCred, infobase, infobaseCred, opts.LockCode, opts.Message, opts.GracePeriod)
+
+	outputPath, err := c.catalog.Path(ctx, cluster, infobase, time.Now())
+	if err != nil {
+		return entity.Job{}, fmt.Errorf("CtrlUseCase - SafeBackup - c.catalog.Path: %w", err)
+	}
+
+	w.work = _stepDump
+	w.do = func(ctx context.Context, update func(change func(job *entity.Job))) error {
+		err := c.dump(ctx, entrypoint, cluster, infobase, infobaseCred, w.lockCode, outputPath, opts.Retention, update)
+		if err != nil {
+			return fmt.Errorf("CtrlUseCase - SafeBackup - c.dump: %w", err)
+		}
+
+		return nil
+	}
+
+	span.AddEvent("enqueue safe backup job")
+
+	job, err := w.enqueue(ctx, entity.Job{Kind: _jobSafeBackup, OutputPath: outputPath})
+	if err != nil {
+		return entity.Job{}, fmt.Errorf("CtrlUseCase - SafeBackup - c.jobs.Enqueue: %w", err)
+	}
+
+	return job, nil
+}
@@ -0,0 +1,183 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/internal/usecase/jobs"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+)
+
+func TestSafeBackup(t *testing.T) {
+	cluster := entity.Cluster{ID: "1234-5678", Host: "1capp01", Port: "1541"}
+	infobase := entity.Infobase{ID: "8765-4321", Name: "ib"}
+	session := entity.Session{ID: "1111-2222"}
+
+	cases := []struct {
+		name           string
+		lockErr        error
+		sessions       [][]entity.Session
+		deleteErr      error
+		backupErr      error
+		unlockErr      error
+		status         entity.JobStatus
+		steps          []entity.JobStatus
+		attempts       int
+		errIs          error
+		backupExpected bool
+	}{
+		{
+			name:           "Success",
+			sessions:       [][]entity.Session{{session}, {}},
+			status:         entity.JobSucceeded,
+			steps:          []entity.JobStatus{entity.JobSucceeded, entity.JobSucceeded, entity.JobSucceeded, entity.JobSucceeded, entity.JobSucceeded},
+			attempts:       1,
+			backupExpected: true,
+		},
+		{
+			name:           "Success after failed termination",
+			sessions:       [][]entity.Session{{session}, {session}, {}},
+			deleteErr:      errors.New("session is busy"),
+			status:         entity.JobSucceeded,
+			steps:          []entity.JobStatus{entity.JobSucceeded, entity.JobSucceeded, entity.JobSucceeded, entity.JobSucceeded, entity.JobSucceeded},
+			attempts:       2,
+			backupExpected: true,
+		},
+		{
+			name:     "Error lock",
+			lockErr:  errors.New("access denied"),
+			status:   entity.JobFailed,
+			steps:    []entity.JobStatus{entity.JobFailed, entity.JobSkipped, entity.JobSkipped, entity.JobSkipped, entity.JobSucceeded},
+			attempts: 0,
+		},
+		{
+			name:     "Error sessions remain",
+			sessions: [][]entity.Session{{session}, {session}, {session}},
+			status:   entity.JobFailed,
+			steps:    []entity.JobStatus{entity.JobSucceeded, entity.JobSucceeded, entity.JobFailed, entity.JobSkipped, entity.JobSucceeded},
+			attempts: 2,
+			errIs:    usecase.ErrSessionsRemain,
+		},
+		{
+			name:           "Error dump",
+			sessions:       [][]entity.Session{{}},
+			backupErr:      errors.New("exit status 1"),
+			status:         entity.JobFailed,
+			steps:          []entity.JobStatus{entity.JobSucceeded, entity.JobSucceeded, entity.JobSucceeded, entity.JobFailed, entity.JobSucceeded},
+			attempts:       1,
+			backupExpected: true,
+		},
+		{
+			name:           "Error unlock",
+			sessions:       [][]entity.Session{{}},
+			unlockErr:      errors.New("access denied"),
+			status:         entity.JobFailed,
+			steps:          []entity.JobStatus{entity.JobSucceeded, entity.JobSucceeded, entity.JobSucceeded, entity.JobSucceeded, entity.JobFailed},
+			attempts:       1,
+			backupExpected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cacheMock := ucm.NewCtrlCache(t)
+
+			cacheMock.On("GetClusters", mock.Anything, mock.AnythingOfType("string")).
+				Return([]entity.Cluster{cluster}, nil)
+
+			cacheMock.On("GetInfobases", mock.Anything, mock.AnythingOfType("string"), mock.Anything).
+				Return([]entity.Infobase{infobase}, nil)
+
+			cacheMock.On("DeleteSessions", mock.Anything, mock.AnythingOfType("string"), mock.Anything).
+				Return(nil).
+				Maybe()
+
+			pipeMock := ucm.NewCtrlPipe(t)
+
+			pipeMock.On("DisableSessions", mock.Anything, mock.AnythingOfType("string"), cluster, infobase, mock.Anything, mock.Anything,
+				mock.MatchedBy(func(opts entity.LockOptions) bool {
+					return opts.PermissionCode == "12345" && opts.ScheduledJobsDeny && opts.DeniedTo.After(opts.DeniedFrom)
+				})).
+				Return(tc.lockErr)
+
+			pipeMock.On("EnableSessions", mock.Anything, mock.AnythingOfType("string"), cluster, infobase, mock.Anything, mock.Anything, "12345").
+				Return(tc.unlockErr)
+
+			for _, sessions := range tc.sessions {
+				pipeMock.On("GetSessions", mock.Anything, mock.AnythingOfType("string"), cluster, infobase, mock.Anything).
+					Return(sessions, nil).
+					Once()
+			}
+
+			pipeMock.On("DeleteSessions", mock.Anything, mock.AnythingOfType("string"), cluster, []entity.Session{session}, mock.Anything).
+				Return(tc.deleteErr).
+				Maybe()
+
+			backupMock := ucm.NewCtrlBackup(t)
+
+			if tc.backupExpected {
+				backupMock.On("RunBackup", mock.Anything, cluster, infobase, mock.Anything, "12345", mock.AnythingOfType("string")).
+					Return(entity.DesignerResult{ExitCode: 0, Log: "done"}, tc.backupErr)
+			}
+
+			catalogMock := ucm.NewCtrlCatalog(t)
+
+			catalogMock.On("Path", mock.Anything, cluster, infobase, mock.AnythingOfType("time.Time")).
+				Return("/backups/1capp01_1541/ib/20230810_140443.dt", nil)
+
+			if tc.backupExpected && tc.backupErr == nil {
+				catalogMock.On("Add", mock.Anything, mock.MatchedBy(func(b entity.Backup) bool {
+					return b.Path == "/backups/1capp01_1541/ib/20230810_140443.dt" && b.ClusterID == cluster.ID && b.InfobaseID == infobase.ID
+				})).
+					Return(entity.Backup{}, nil)
+
+				catalogMock.On("Prune", mock.Anything, cluster, infobase, entity.RetentionPolicy{KeepLast: 3}).
+					Return([]entity.Backup{}, nil)
+			}
+
+			j := jobs.New(1, 1)
+			defer j.Close()
+
+			c := usecase.New(cacheMock, pipeMock, backupMock, j, catalogMock,
+				usecase.Retention(entity.RetentionPolicy{KeepLast: 3}),
+				usecase.LockCode("12345"),
+				usecase.KickRetries(2, time.Millisecond),
+			)
+
+			job, err := c.SafeBackup(context.Background(), "1capp01:1545", entity.Cluster{ID: cluster.ID}, entity.Credentials{},
+				entity.Infobase{ID: infobase.ID}, entity.Credentials{}, entity.SafeBackupOptions{})
+			require.NoError(t, err)
+			require.Len(t, job.Steps, len(tc.steps))
+
+			require.Eventually(t, func() bool {
+				job, err = c.Job(context.Background(), job.ID)
+				require.NoError(t, err)
+
+				return job.Status == entity.JobSucceeded || job.Status == entity.JobFailed
+			}, time.Second, time.Millisecond)
+
+			require.Equal(t, tc.status, job.Status)
+
+			for i, status := range tc.steps {
+				require.Equal(t, status, job.Steps[i].Status, job.Steps[i].Name)
+			}
+
+			require.Equal(t, tc.attempts, job.Steps[2].Attempts)
+
+			if tc.errIs != nil {
+				require.Contains(t, job.Error, tc.errIs.Error())
+			}
+		})
+	}
+}
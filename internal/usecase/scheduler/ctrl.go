@@ -0,0 +1,323 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	uc "github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/pkg/logger"
+)
+
+const (
+	_defaultPollInterval time.Duration = 5 * time.Second
+
+	_triggerTimeout time.Duration = time.Minute
+
+	_errInterrupted string = "job was interrupted by restart of service"
+)
+
+var (
+	ErrDuplicateName = errors.New("duplicate name of schedule")
+)
+
+// Task - schedule with credentials of its cluster and infobase.
+type Task struct {
+	entity.Schedule
+
+	ClusterCred  entity.Credentials
+	InfobaseCred entity.Credentials
+}
+
+type entry struct {
+	task     Task
+	schedule cron.Schedule
+}
+
+// CtrlScheduler - running backups through usecase by cron expressions, state of last runs is kept in file.
+type CtrlScheduler struct {
+	sync.Mutex
+	ctrl    uc.Ctrl
+	l       logger.Interface
+	cron    *cron.Cron
+	path    string
+	entries []entry
+	state   map[string]entity.ScheduleState
+
+	pollInterval time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ uc.CtrlScheduler = (*CtrlScheduler)(nil)
+
+// New - parsing cron expressions and loading state from statePath, schedules are run after Start.
+func New(ctrl uc.Ctrl, l logger.Interface, statePath string, tasks []Task) (*CtrlScheduler, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := &CtrlScheduler{
+		ctrl:         ctrl,
+		l:            l,
+		cron:         cron.New(),
+		path:         statePath,
+		entries:      make([]entry, 0, len(tasks)),
+		state:        make(map[string]entity.ScheduleState, len(tasks)),
+		pollInterval: _defaultPollInterval,
+		ctx:          ctx,
+		cancel:       cancel,
+	}
+
+	names := make(map[string]bool, len(tasks))
+
+	for _, t := range tasks {
+		if names[t.Name] {
+			cancel()
+
+			return nil, fmt.Errorf("ctrlscheduler - new: %s: %w", t.Name, ErrDuplicateName)
+		}
+
+		names[t.Name] = true
+
+		schedule, err := cron.ParseStandard(t.Cron)
+		if err != nil {
+			cancel()
+
+			return nil, fmt.Errorf("ctrlscheduler - new - cron.ParseStandard: %s: %w", t.Name, err)
+		}
+
+		t := t
+
+		s.entries = append(s.entries, entry{task: t, schedule: schedule})
+		s.cron.Schedule(schedule, cron.FuncJob(func() { s.run(t) }))
+	}
+
+	err := s.load()
+	if err != nil {
+		cancel()
+
+		return nil, fmt.Errorf("ctrlscheduler - new - s.load: %w", err)
+	}
+
+	return s, nil
+}
+
+// Start - running schedules in background.
+func (s *CtrlScheduler) Start() {
+	s.cron.Start()
+}
+
+// Stop - stopping schedules and watching of started jobs.
+func (s *CtrlScheduler) Stop() {
+	<-s.cron.Stop().Done()
+
+	s.cancel()
+	s.wg.Wait()
+}
+
+// Schedules - all schedules with time of next run and state of last one.
+func (s *CtrlScheduler) Schedules(ctx context.Context) ([]entity.Schedule, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	now := time.Now()
+
+	rv := make([]entity.Schedule, 0, len(s.entries))
+
+	for _, e := range s.entries {
+		schedule := e.task.Schedule
+		schedule.Next = e.schedule.Next(now)
+		schedule.ScheduleState = s.state[schedule.Name]
+
+		rv = append(rv, schedule)
+	}
+
+	return rv, nil
+}
+
+// run - enqueueing backup job of schedule, it is skipped while previous job of any schedule of the infobase
+// is not finished. Schedule is marked as queued under the same lock so overlapping runs could not both pass the check.
+func (s *CtrlScheduler) run(t Task) {
+	if !s.acquire(t) {
+		s.l.Warn("ctrlscheduler - run - previous job is not finished: " + t.Name)
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(s.ctx, _triggerTimeout)
+	defer cancel()
+
+	cluster := entity.Cluster{ID: t.ClusterID}
+	infobase := entity.Infobase{ID: t.InfobaseID}
+
+	var (
+		job entity.Job
+		err error
+	)
+
+	if t.Safe {
+		job, err = s.ctrl.SafeBackup(ctx, t.Entrypoint, cluster, t.ClusterCred, infobase, t.InfobaseCred, entity.SafeBackupOptions{Retention: t.Retention})
+	} else {
+		job, err = s.ctrl.Backup(ctx, t.Entrypoint, cluster, t.ClusterCred, infobase, t.InfobaseCred, entity.BackupOptions{Retention: t.Retention})
+	}
+
+	state := entity.ScheduleState{
+		LastRun:    time.Now(),
+		LastJob:    job.ID,
+		LastStatus: job.Status,
+	}
+
+	if err != nil {
+		s.l.Error(fmt.Errorf("ctrlscheduler - run - %s: %w", t.Name, err))
+
+		state.LastStatus = entity.JobFailed
+		state.LastError = err.Error()
+	}
+
+	s.setState(t.Name, state)
+
+	if err == nil {
+		s.wg.Add(1)
+
+		go s.watch(t.Name, job.ID)
+	}
+}
+
+// acquire - marking schedule as queued if no schedule of the same infobase has unfinished job.
+func (s *CtrlScheduler) acquire(t Task) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, e := range s.entries {
+		if e.task.Entrypoint != t.Entrypoint || e.task.ClusterID != t.ClusterID || e.task.InfobaseID != t.InfobaseID {
+			continue
+		}
+
+		status := s.state[e.task.Name].LastStatus
+		if status == entity.JobQueued || status == entity.JobRunning {
+			return false
+		}
+	}
+
+	state := s.state[t.Name]
+	state.LastStatus = entity.JobQueued
+	s.state[t.Name] = state
+
+	return true
+}
+
+// watch - following job until it is finished for keeping its outcome in state.
+func (s *CtrlScheduler) watch(name string, id string) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		job, err := s.ctrl.Job(s.ctx, id)
+		if err != nil {
+			s.l.Error(fmt.Errorf("ctrlscheduler - watch - s.ctrl.Job: %w", err))
+
+			s.changeState(name, func(state *entity.ScheduleState) {
+				state.LastStatus = entity.JobFailed
+				state.LastError = err.Error()
+			})
+
+			return
+		}
+
+		s.changeState(name, func(state *entity.ScheduleState) {
+			state.LastStatus = job.Status
+			state.LastError = job.Error
+		})
+
+		if job.Status == entity.JobSucceeded || job.Status == entity.JobFailed {
+			return
+		}
+	}
+}
+
+func (s *CtrlScheduler) setState(name string, state entity.ScheduleState) {
+	s.changeState(name, func(st *entity.ScheduleState) {
+		*st = state
+	})
+}
+
+func (s *CtrlScheduler) changeState(name string, change func(state *entity.ScheduleState)) {
+	s.Lock()
+	defer s.Unlock()
+
+	state := s.state[name]
+	change(&state)
+	s.state[name] = state
+
+	err := s.save()
+	if err != nil {
+		s.l.Error(fmt.Errorf("ctrlscheduler - changestate - s.save: %w", err))
+	}
+}
+
+// load - reading state, jobs unfinished before restart are lost with in memory queue so they are failed.
+func (s *CtrlScheduler) load() error {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("os.ReadFile: %w", err)
+	}
+
+	err = json.Unmarshal(data, &s.state)
+	if err != nil {
+		return fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	for name, state := range s.state {
+		if state.LastStatus == entity.JobQueued || state.LastStatus == entity.JobRunning {
+			state.LastStatus = entity.JobFailed
+			state.LastError = _errInterrupted
+			s.state[name] = state
+		}
+	}
+
+	return nil
+}
+
+// save - writing state through temporary file so it is never left half written.
+func (s *CtrlScheduler) save() error {
+	data, err := json.MarshalIndent(s.state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("json.MarshalIndent: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(s.path), 0755)
+	if err != nil {
+		return fmt.Errorf("os.MkdirAll: %w", err)
+	}
+
+	err = os.WriteFile(s.path+".tmp", data, 0644)
+	if err != nil {
+		return fmt.Errorf("os.WriteFile: %w", err)
+	}
+
+	err = os.Rename(s.path+".tmp", s.path)
+	if err != nil {
+		return fmt.Errorf("os.Rename: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,277 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func newLogMock(t *testing.T) *lm.Interface {
+	logMock := lm.NewInterface(t)
+
+	logMock.On("Warn", mock.AnythingOfType("string")).Maybe()
+	logMock.On("Error", mock.Anything).Maybe()
+
+	return logMock
+}
+
+func TestNew(t *testing.T) {
+	cases := []struct {
+		name  string
+		tasks []Task
+		isErr bool
+		errIs error
+	}{
+		{
+			name:  "Error cron expression",
+			tasks: []Task{{Schedule: entity.Schedule{Name: "a", Cron: "* * *"}}},
+			isErr: true,
+		},
+		{
+			name: "Error duplicate name",
+			tasks: []Task{
+				{Schedule: entity.Schedule{Name: "a", Cron: "0 2 * * *"}},
+				{Schedule: entity.Schedule{Name: "a", Cron: "0 3 * * *"}},
+			},
+			isErr: true,
+			errIs: ErrDuplicateName,
+		},
+		{
+			name: "Success",
+			tasks: []Task{
+				{Schedule: entity.Schedule{Name: "a", Cron: "0 2 * * *"}},
+				{Schedule: entity.Schedule{Name: "b", Cron: "@daily"}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := New(ucm.NewCtrl(t), newLogMock(t), filepath.Join(t.TempDir(), "schedules.json"), tc.tasks)
+
+			if tc.isErr {
+				require.Error(t, err)
+
+				if tc.errIs != nil {
+					require.ErrorIs(t, err, tc.errIs)
+				}
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			schedules, err := s.Schedules(context.Background())
+			require.NoError(t, err)
+			require.Len(t, schedules, len(tc.tasks))
+
+			for _, schedule := range schedules {
+				require.True(t, schedule.Next.After(time.Now()))
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	cluster := entity.Cluster{ID: "1234-5678"}
+	infobase := entity.Infobase{ID: "8765-4321"}
+	retention := &entity.RetentionPolicy{KeepLast: 3}
+
+	cases := []struct {
+		name       string
+		safe       bool
+		enqueueErr error
+		jobErr     error
+		status     entity.JobStatus
+		lastError  string
+	}{
+		{
+			name:   "Success backup",
+			status: entity.JobSucceeded,
+		},
+		{
+			name:   "Success safe backup",
+			safe:   true,
+			status: entity.JobSucceeded,
+		},
+		{
+			name:       "Error enqueue",
+			enqueueErr: errors.New("job queue is full"),
+			status:     entity.JobFailed,
+			lastError:  "job queue is full",
+		},
+		{
+			name:      "Error job lost",
+			jobErr:    errors.New("job not found"),
+			status:    entity.JobFailed,
+			lastError: "job not found",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrlMock := ucm.NewCtrl(t)
+
+			job := entity.Job{ID: "1", Status: entity.JobQueued}
+
+			if tc.safe {
+				ctrlMock.On("SafeBackup", mock.Anything, "1capp01:1545", cluster, entity.Credentials{Name: "admin"}, infobase, entity.Credentials{Name: "ib"},
+					entity.SafeBackupOptions{Retention: retention}).
+					Return(job, tc.enqueueErr)
+			} else {
+				ctrlMock.On("Backup", mock.Anything, "1capp01:1545", cluster, entity.Credentials{Name: "admin"}, infobase, entity.Credentials{Name: "ib"},
+					entity.BackupOptions{Retention: retention}).
+					Return(job, tc.enqueueErr)
+			}
+
+			if tc.enqueueErr == nil {
+				ctrlMock.On("Job", mock.Anything, "1").
+					Return(entity.Job{ID: "1", Status: entity.JobRunning}, nil).
+					Once()
+
+				ctrlMock.On("Job", mock.Anything, "1").
+					Return(entity.Job{ID: "1", Status: entity.JobSucceeded}, tc.jobErr).
+					Once()
+			}
+
+			path := filepath.Join(t.TempDir(), "schedules.json")
+
+			task := Task{
+				Schedule: entity.Schedule{
+					Name:       "buh",
+					Cron:       "0 2 * * *",
+					Entrypoint: "1capp01:1545",
+					ClusterID:  cluster.ID,
+					InfobaseID: infobase.ID,
+					Safe:       tc.safe,
+					Retention:  retention,
+				},
+				ClusterCred:  entity.Credentials{Name: "admin"},
+				InfobaseCred: entity.Credentials{Name: "ib"},
+			}
+
+			s, err := New(ctrlMock, newLogMock(t), path, []Task{task})
+			require.NoError(t, err)
+
+			s.pollInterval = time.Millisecond
+
+			s.run(task)
+			s.wg.Wait()
+
+			schedules, err := s.Schedules(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tc.status, schedules[0].LastStatus)
+			require.Equal(t, tc.lastError, schedules[0].LastError)
+			require.False(t, schedules[0].LastRun.IsZero())
+
+			// State is read again by new scheduler
+			s, err = New(ctrlMock, newLogMock(t), path, []Task{task})
+			require.NoError(t, err)
+
+			reloaded, err := s.Schedules(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, schedules[0].ScheduleState.LastStatus, reloaded[0].LastStatus)
+			require.True(t, schedules[0].LastRun.Equal(reloaded[0].LastRun))
+		})
+	}
+}
+
+func TestRunSkip(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "schedules.json")
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"buh":{"last_status":"running","last_job":"1"}}`), 0o600))
+
+	task := Task{Schedule: entity.Schedule{Name: "buh", Cron: "0 2 * * *"}}
+
+	// Job running before restart is lost so it is failed and next run is not skipped
+	ctrlMock := ucm.NewCtrl(t)
+
+	s, err := New(ctrlMock, newLogMock(t), path, []Task{task})
+	require.NoError(t, err)
+
+	schedules, err := s.Schedules(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, entity.JobFailed, schedules[0].LastStatus)
+	require.Equal(t, _errInterrupted, schedules[0].LastError)
+
+	// Running job of schedule makes next run skipped
+	s.setState("buh", entity.ScheduleState{LastStatus: entity.JobRunning, LastJob: "2"})
+
+	s.run(task)
+
+	ctrlMock.AssertNotCalled(t, "Backup", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRunConcurrent(t *testing.T) {
+	t.Parallel()
+
+	cluster := entity.Cluster{ID: "1234-5678"}
+	infobase := entity.Infobase{ID: "8765-4321"}
+
+	daily := Task{Schedule: entity.Schedule{Name: "buh-daily", Cron: "0 2 * * *", Entrypoint: "1capp01:1545", ClusterID: cluster.ID, InfobaseID: infobase.ID}}
+	hourly := Task{Schedule: entity.Schedule{Name: "buh-hourly", Cron: "@hourly", Entrypoint: "1capp01:1545", ClusterID: cluster.ID, InfobaseID: infobase.ID}}
+
+	release := make(chan struct{})
+
+	ctrlMock := ucm.NewCtrl(t)
+
+	// Backup of the first run is not enqueued yet while the other runs check state
+	ctrlMock.On("Backup", mock.Anything, "1capp01:1545", cluster, mock.Anything, infobase, mock.Anything, mock.Anything).
+		Return(entity.Job{ID: "1", Status: entity.JobQueued}, nil).
+		Run(func(args mock.Arguments) { <-release })
+
+	ctrlMock.On("Job", mock.Anything, "1").
+		Return(entity.Job{ID: "1", Status: entity.JobRunning}, nil).
+		Maybe()
+
+	s, err := New(ctrlMock, newLogMock(t), filepath.Join(t.TempDir(), "schedules.json"), []Task{daily, hourly})
+	require.NoError(t, err)
+
+	s.pollInterval = time.Hour
+
+	done := make(chan struct{})
+
+	for _, task := range []Task{daily, daily, hourly} {
+		go func(task Task) {
+			s.run(task)
+			done <- struct{}{}
+		}(task)
+	}
+
+	// Two runs are skipped at once, the third one waits for backup
+	for i := 0; i < 2; i++ {
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			close(release)
+			require.FailNow(t, "run is not skipped")
+		}
+	}
+
+	close(release)
+	<-done
+
+	s.Stop()
+
+	ctrlMock.AssertNumberOfCalls(t, "Backup", 1)
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	"github.com/antonmisa/1cctl/internal/usecase/cache"
+	"github.com/antonmisa/1cctl/internal/usecase/common"
+)
+
+// Servers - getting working servers list for cluster.
+func (c *CtrlUseCase) Servers(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, args map[string]any) ([]entity.Server, error) {
+	span := trace.SpanFromContext(ctx)
+
+	if v, ok := args[common.UseCache]; ok && v.(bool) {
+		span.AddEvent("GetServers from cache")
+
+		servers, err := c.cache.GetServers(ctx, entrypoint, cluster)
+
+		if err != nil && !errors.Is(err, cache.ErrNotFound) {
+			return nil, fmt.Errorf("CtrlUseCase - Servers - c.cache.GetServers: %w", err)
+		} else if !reflect.DeepEqual(servers, []entity.Server{}) {
+			return servers, nil
+		}
+	}
+
+	span.AddEvent("GetServers from 1C")
+
+	servers, err := c.pipe.GetServers(ctx, entrypoint, cluster, clusterCred)
+	if err != nil {
+		return nil, fmt.Errorf("CtrlUseCase - Servers - c.pipe.GetServers: %w", err)
+	}
+
+	span.AddEvent("PutServers to cache")
+
+	err = c.cache.PutServers(ctx, entrypoint, cluster, servers)
+	if err != nil {
+		return nil, fmt.Errorf("CtrlUseCase - Servers - c.cache.PutServers: %w", err)
+	}
+
+	return servers, nil
+}
+
+// Managers - getting cluster managers list.
+func (c *CtrlUseCase) Managers(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials, args map[string]any) ([]entity.Manager, error) {
+	span := trace.SpanFromContext(ctx)
+
+	if v, ok := args[common.UseCache]; ok && v.(bool) {
+		span.AddEvent("GetManagers from cache")
+
+		managers, err := c.cache.GetManagers(ctx, entrypoint, cluster)
+
+		if err != nil && !errors.Is(err, cache.ErrNotFound) {
+			return nil, fmt.Errorf("CtrlUseCase - Managers - c.cache.GetManagers: %w", err)
+		} else if !reflect.DeepEqual(managers, []entity.Manager{}) {
+			return managers, nil
+		}
+	}
+
+	span.AddEvent("GetManagers from 1C")
+
+	managers, err := c.pipe.GetManagers(ctx, entrypoint, cluster, clusterCred)
+	if err != nil {
+		return nil, fmt.Errorf("CtrlUseCase - Managers - c.pipe.GetManagers: %w", err)
+	}
+
+	span.AddEvent("PutManagers to cache")
+
+	err = c.cache.PutManagers(ctx, entrypoint, cluster, managers)
+	if err != nil {
+		return nil, fmt.Errorf("CtrlUseCase - Managers - c.cache.PutManagers: %w", err)
+	}
+
+	return managers, nil
+}
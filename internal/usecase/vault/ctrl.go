@@ -0,0 +1,263 @@
+// Package vault keeps credentials of clusters and infobases in file encrypted by master key.
+package vault
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	uc "github.com/antonmisa/1cctl/internal/usecase"
+)
+
+var (
+	ErrEmptyMasterKey      = errors.New("master key is empty")
+	ErrEmptyEntrypoint     = errors.New("entrypoint is empty")
+	ErrCredentialsNotFound = errors.New("credentials not found")
+	ErrMalformedVault      = errors.New("malformed vault file")
+)
+
+type record struct {
+	Key      entity.VaultKey `json:"key"`
+	Login    string          `json:"login"`
+	Password string          `json:"password"`
+}
+
+// CtrlVault - credentials by entrypoint, cluster and infobase, every change is written to file at once.
+type CtrlVault struct {
+	sync.RWMutex
+
+	path  string
+	aead  cipher.AEAD
+	creds map[entity.VaultKey]entity.Credentials
+}
+
+var _ uc.CtrlVault = (*CtrlVault)(nil)
+
+// New - file is read if it exists, AES-256-GCM key is sha256 of master key.
+func New(path string, masterKey string) (*CtrlVault, error) {
+	if masterKey == "" {
+		return nil, ErrEmptyMasterKey
+	}
+
+	key := sha256.Sum256([]byte(masterKey))
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, fmt.Errorf("ctrlvault - new - aes.NewCipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("ctrlvault - new - cipher.NewGCM: %w", err)
+	}
+
+	v := &CtrlVault{
+		path:  path,
+		aead:  aead,
+		creds: make(map[entity.VaultKey]entity.Credentials),
+	}
+
+	if err := v.load(); err != nil {
+		return nil, fmt.Errorf("ctrlvault - new - v.load: %w", err)
+	}
+
+	return v, nil
+}
+
+// ClusterCredentials - credentials of cluster or, when there are none, of entrypoint.
+func (v *CtrlVault) ClusterCredentials(ctx context.Context, entrypoint, cluster string) (entity.Credentials, bool) {
+	v.RLock()
+	defer v.RUnlock()
+
+	if cred, ok := v.creds[normalize(entity.VaultKey{Entrypoint: entrypoint, Cluster: cluster})]; ok && cluster != "" {
+		return cred, true
+	}
+
+	cred, ok := v.creds[normalize(entity.VaultKey{Entrypoint: entrypoint})]
+
+	return cred, ok
+}
+
+// InfobaseCredentials - credentials of infobase (id) of cluster.
+func (v *CtrlVault) InfobaseCredentials(ctx context.Context, entrypoint, cluster, infobase string) (entity.Credentials, bool) {
+	if infobase == "" {
+		return entity.Credentials{}, false
+	}
+
+	v.RLock()
+	defer v.RUnlock()
+
+	cred, ok := v.creds[normalize(entity.VaultKey{Entrypoint: entrypoint, Cluster: cluster, Infobase: infobase})]
+
+	return cred, ok
+}
+
+// Keys - keys of stored credentials, secrets are never shown.
+func (v *CtrlVault) Keys(ctx context.Context) ([]entity.VaultKey, error) {
+	v.RLock()
+	defer v.RUnlock()
+
+	keys := make([]entity.VaultKey, 0, len(v.creds))
+	for k := range v.creds {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Entrypoint != keys[j].Entrypoint {
+			return keys[i].Entrypoint < keys[j].Entrypoint
+		}
+
+		if keys[i].Cluster != keys[j].Cluster {
+			return keys[i].Cluster < keys[j].Cluster
+		}
+
+		return keys[i].Infobase < keys[j].Infobase
+	})
+
+	return keys, nil
+}
+
+// Set - storing new or rotating existing credentials.
+func (v *CtrlVault) Set(ctx context.Context, key entity.VaultKey, cred entity.Credentials) error {
+	if key.Entrypoint == "" {
+		return ErrEmptyEntrypoint
+	}
+
+	v.Lock()
+	defer v.Unlock()
+
+	key = normalize(key)
+	prev, existed := v.creds[key]
+
+	v.creds[key] = cred
+
+	if err := v.save(); err != nil {
+		if existed {
+			v.creds[key] = prev
+		} else {
+			delete(v.creds, key)
+		}
+
+		return fmt.Errorf("ctrlvault - set - v.save: %w", err)
+	}
+
+	return nil
+}
+
+// Remove -.
+func (v *CtrlVault) Remove(ctx context.Context, key entity.VaultKey) error {
+	v.Lock()
+	defer v.Unlock()
+
+	key = normalize(key)
+
+	prev, ok := v.creds[key]
+	if !ok {
+		return fmt.Errorf("ctrlvault - remove: %s: %w", key.Entrypoint, ErrCredentialsNotFound)
+	}
+
+	delete(v.creds, key)
+
+	if err := v.save(); err != nil {
+		v.creds[key] = prev
+
+		return fmt.Errorf("ctrlvault - remove - v.save: %w", err)
+	}
+
+	return nil
+}
+
+// normalize - entrypoints are compared ignoring case as addresses are.
+func normalize(key entity.VaultKey) entity.VaultKey {
+	key.Entrypoint = strings.ToLower(key.Entrypoint)
+
+	return key
+}
+
+// load - absent file is an empty vault.
+func (v *CtrlVault) load() error {
+	data, err := os.ReadFile(v.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("os.ReadFile: %w", err)
+	}
+
+	size := v.aead.NonceSize()
+	if len(data) < size {
+		return ErrMalformedVault
+	}
+
+	plain, err := v.aead.Open(nil, data[:size], data[size:], nil)
+	if err != nil {
+		return fmt.Errorf("v.aead.Open: %w", err)
+	}
+
+	var records []record
+	if err := json.Unmarshal(plain, &records); err != nil {
+		return fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	for _, r := range records {
+		v.creds[normalize(r.Key)] = entity.Credentials{Name: r.Login, Pwd: r.Password}
+	}
+
+	return nil
+}
+
+// save - file is replaced atomically, so it's never left half written.
+func (v *CtrlVault) save() error {
+	records := make([]record, 0, len(v.creds))
+	for k, c := range v.creds {
+		records = append(records, record{Key: k, Login: c.Name, Password: c.Pwd})
+	}
+
+	plain, err := json.Marshal(records)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	nonce := make([]byte, v.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return fmt.Errorf("rand.Reader: %w", err)
+	}
+
+	data := v.aead.Seal(nonce, nonce, plain, nil)
+
+	tmp, err := os.CreateTemp(filepath.Dir(v.path), filepath.Base(v.path)+".*")
+	if err != nil {
+		return fmt.Errorf("os.CreateTemp: %w", err)
+	}
+
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+
+		return fmt.Errorf("tmp.Write: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("tmp.Close: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), v.path); err != nil {
+		return fmt.Errorf("os.Rename: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+)
+
+func TestVault(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "vault.bin")
+
+	v, err := New(path, "master")
+	require.NoError(t, err)
+
+	_, ok := v.ClusterCredentials(ctx, "1capp01:1545", "1234-5678")
+	require.False(t, ok)
+
+	require.NoError(t, v.Set(ctx, entity.VaultKey{Entrypoint: "1CAPP01:1545"}, entity.Credentials{Name: "admin", Pwd: "secret"}))
+	require.NoError(t, v.Set(ctx, entity.VaultKey{Entrypoint: "1capp01:1545", Cluster: "1234-5678"}, entity.Credentials{Name: "cluster-admin"}))
+	require.NoError(t, v.Set(ctx, entity.VaultKey{Entrypoint: "1capp01:1545", Cluster: "1234-5678", Infobase: "ib-1"}, entity.Credentials{Name: "Administrator"}))
+
+	require.ErrorIs(t, v.Set(ctx, entity.VaultKey{Cluster: "1234-5678"}, entity.Credentials{Name: "admin"}), ErrEmptyEntrypoint)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(data, []byte("secret")))
+
+	// credentials are read back from file
+	v, err = New(path, "master")
+	require.NoError(t, err)
+
+	cred, ok := v.ClusterCredentials(ctx, "1capp01:1545", "1234-5678")
+	require.True(t, ok)
+	require.Equal(t, entity.Credentials{Name: "cluster-admin"}, cred)
+
+	cred, ok = v.ClusterCredentials(ctx, "1capp01:1545", "8765-4321")
+	require.True(t, ok)
+	require.Equal(t, entity.Credentials{Name: "admin", Pwd: "secret"}, cred)
+
+	cred, ok = v.InfobaseCredentials(ctx, "1capp01:1545", "1234-5678", "ib-1")
+	require.True(t, ok)
+	require.Equal(t, entity.Credentials{Name: "Administrator"}, cred)
+
+	_, ok = v.InfobaseCredentials(ctx, "1capp01:1545", "1234-5678", "ib-2")
+	require.False(t, ok)
+
+	keys, err := v.Keys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []entity.VaultKey{
+		{Entrypoint: "1capp01:1545"},
+		{Entrypoint: "1capp01:1545", Cluster: "1234-5678"},
+		{Entrypoint: "1capp01:1545", Cluster: "1234-5678", Infobase: "ib-1"},
+	}, keys)
+
+	// rotation
+	require.NoError(t, v.Set(ctx, entity.VaultKey{Entrypoint: "1capp01:1545"}, entity.Credentials{Name: "admin", Pwd: "rotated"}))
+
+	cred, _ = v.ClusterCredentials(ctx, "1capp01:1545", "")
+	require.Equal(t, entity.Credentials{Name: "admin", Pwd: "rotated"}, cred)
+
+	require.NoError(t, v.Remove(ctx, entity.VaultKey{Entrypoint: "1capp01:1545", Cluster: "1234-5678"}))
+	require.ErrorIs(t, v.Remove(ctx, entity.VaultKey{Entrypoint: "1capp01:1545", Cluster: "1234-5678"}), ErrCredentialsNotFound)
+
+	cred, _ = v.ClusterCredentials(ctx, "1capp01:1545", "1234-5678")
+	require.Equal(t, entity.Credentials{Name: "admin", Pwd: "rotated"}, cred)
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "vault.bin")
+
+	_, err := New(path, "")
+	require.ErrorIs(t, err, ErrEmptyMasterKey)
+
+	v, err := New(path, "master")
+	require.NoError(t, err)
+	require.NoError(t, v.Set(context.Background(), entity.VaultKey{Entrypoint: "1capp01:1545"}, entity.Credentials{Name: "admin"}))
+
+	_, err = New(path, "other")
+	require.Error(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte("short"), 0600))
+
+	_, err = New(path, "master")
+	require.ErrorIs(t, err, ErrMalformedVault)
+}
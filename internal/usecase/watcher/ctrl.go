@@ -0,0 +1,346 @@
+// Package watcher streams changes of sessions of clusters to subscribers.
+package watcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	uc "github.com/antonmisa/1cctl/internal/usecase"
+	"github.com/antonmisa/1cctl/pkg/logger"
+)
+
+const (
+	_defaultPollInterval time.Duration = 5 * time.Second
+	_defaultPollTimeout  time.Duration = 20 * time.Second
+	_defaultSendTimeout  time.Duration = 5 * time.Second
+
+	_subscriberBuffer int = 64
+)
+
+var (
+	ErrStopped = errors.New("watcher is stopped")
+)
+
+// key - one poller is shared by all subscribers of cluster with the same credentials.
+type key struct {
+	entrypoint string
+	cluster    string
+	cred       entity.Credentials
+}
+
+type poller struct {
+	subs     map[*subscriber]struct{}
+	sessions []entity.Session
+	cancel   context.CancelFunc
+}
+
+// subscriber - events of polls are queued without waiting and forwarded to channel by own goroutine,
+// so slow reader could not hold poller or lock of watcher.
+type subscriber struct {
+	sync.Mutex
+	ch    chan entity.SessionEvent
+	queue []entity.SessionEvent
+	wake  chan struct{}
+	done  chan struct{}
+}
+
+func newSubscriber() *subscriber {
+	return &subscriber{
+		ch:   make(chan entity.SessionEvent, _subscriberBuffer),
+		wake: make(chan struct{}, 1),
+		done: make(chan struct{}),
+	}
+}
+
+// push - queueing events for forwarding.
+func (s *subscriber) push(events []entity.SessionEvent) {
+	if len(events) == 0 {
+		return
+	}
+
+	s.Lock()
+	s.queue = append(s.queue, events...)
+	s.Unlock()
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// take - all queued events.
+func (s *subscriber) take() []entity.SessionEvent {
+	s.Lock()
+	defer s.Unlock()
+
+	events := s.queue
+	s.queue = nil
+
+	return events
+}
+
+// CtrlWatcher - polling sessions of watched clusters through pipe and sending differences of successive lists
+// to subscribers, poller of cluster is started by first subscriber and stopped when the last one leaves.
+type CtrlWatcher struct {
+	sync.Mutex
+	pipe        uc.CtrlPipe
+	l           logger.Interface
+	interval    time.Duration
+	timeout     time.Duration
+	sendTimeout time.Duration
+	pollers     map[key]*poller
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ uc.CtrlWatcher = (*CtrlWatcher)(nil)
+
+// New - zero interval and timeout mean defaults.
+func New(pipe uc.CtrlPipe, l logger.Interface, interval time.Duration, timeout time.Duration) *CtrlWatcher {
+	if interval <= 0 {
+		interval = _defaultPollInterval
+	}
+
+	if timeout <= 0 {
+		timeout = _defaultPollTimeout
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &CtrlWatcher{
+		pipe:        pipe,
+		l:           l,
+		interval:    interval,
+		timeout:     timeout,
+		sendTimeout: _defaultSendTimeout,
+		pollers:     make(map[key]*poller),
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+}
+
+// Watch - subscribing to changes of sessions of cluster until ctx is done, channel is closed on unsubscribe.
+// New subscriber gets all known sessions as added ones first. Subscriber which does not read events
+// in send timeout is dropped, the others and poller never wait for it.
+func (w *CtrlWatcher) Watch(ctx context.Context, entrypoint string, cluster entity.Cluster, clusterCred entity.Credentials) (<-chan entity.SessionEvent, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.ctx.Err() != nil {
+		return nil, fmt.Errorf("ctrlwatcher - watch: %w", ErrStopped)
+	}
+
+	k := key{entrypoint, cluster.ID, clusterCred}
+
+	p, ok := w.pollers[k]
+	if !ok {
+		pctx, cancel := context.WithCancel(w.ctx)
+
+		p = &poller{
+			subs:   make(map[*subscriber]struct{}),
+			cancel: cancel,
+		}
+
+		w.pollers[k] = p
+
+		w.wg.Add(1)
+
+		go w.run(pctx, k, p)
+	}
+
+	sub := newSubscriber()
+
+	snapshot := make([]entity.SessionEvent, 0, len(p.sessions))
+	for _, s := range p.sessions {
+		snapshot = append(snapshot, entity.SessionEvent{Type: entity.SessionAdded, Session: s})
+	}
+
+	sub.push(snapshot)
+
+	p.subs[sub] = struct{}{}
+
+	w.wg.Add(2)
+
+	go w.forward(k, p, sub)
+
+	go func() {
+		defer w.wg.Done()
+
+		select {
+		case <-ctx.Done():
+		case <-w.ctx.Done():
+		}
+
+		w.unsubscribe(k, p, sub)
+	}()
+
+	return sub.ch, nil
+}
+
+// Stop - stopping all pollers and closing channels of subscribers.
+func (w *CtrlWatcher) Stop() {
+	w.cancel()
+	w.wg.Wait()
+}
+
+// run - polling sessions of cluster at once and then on interval.
+func (w *CtrlWatcher) run(ctx context.Context, k key, p *poller) {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.poll(ctx, k, p)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *CtrlWatcher) poll(ctx context.Context, k key, p *poller) {
+	pctx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+
+	sessions, err := w.pipe.GetSessions(pctx, k.entrypoint, entity.Cluster{ID: k.cluster}, entity.Infobase{}, k.cred)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.l.Error(fmt.Errorf("ctrlwatcher - poll - w.pipe.GetSessions: %s: %w", k.cluster, err))
+		}
+
+		return
+	}
+
+	w.Lock()
+	defer w.Unlock()
+
+	events := diff(p.sessions, sessions)
+
+	p.sessions = sessions
+
+	for sub := range p.subs {
+		sub.push(events)
+	}
+}
+
+// forward - sending queued events to channel of subscriber, subscriber which does not read them
+// in send timeout is dropped. Channel is closed here only as it is the only sender.
+func (w *CtrlWatcher) forward(k key, p *poller, sub *subscriber) {
+	defer w.wg.Done()
+	defer close(sub.ch)
+
+	for {
+		select {
+		case <-sub.done:
+			return
+		case <-sub.wake:
+		}
+
+		if !w.send(sub, sub.take()) {
+			select {
+			case <-sub.done:
+			default:
+				w.l.Warn(fmt.Sprintf("ctrlwatcher - forward: subscriber of %s is too slow, dropped", k.cluster))
+
+				w.unsubscribe(k, p, sub)
+			}
+
+			return
+		}
+	}
+}
+
+// send - false if subscriber does not read events in timeout or is stopped.
+func (w *CtrlWatcher) send(sub *subscriber, events []entity.SessionEvent) bool {
+	timer := time.NewTimer(w.sendTimeout)
+	defer timer.Stop()
+
+	for _, ev := range events {
+		select {
+		case sub.ch <- ev:
+		case <-timer.C:
+			return false
+		case <-sub.done:
+			return false
+		}
+	}
+
+	return true
+}
+
+// unsubscribe - removing subscriber if it was not dropped yet.
+func (w *CtrlWatcher) unsubscribe(k key, p *poller, sub *subscriber) {
+	w.Lock()
+	defer w.Unlock()
+
+	if _, ok := p.subs[sub]; ok {
+		w.drop(k, p, sub)
+	}
+}
+
+// drop - stopping forwarding of subscriber and poller after the last one, lock must be held.
+func (w *CtrlWatcher) drop(k key, p *poller, sub *subscriber) {
+	delete(p.subs, sub)
+	close(sub.done)
+
+	if len(p.subs) == 0 {
+		p.cancel()
+
+		if w.pollers[k] == p {
+			delete(w.pollers, k)
+		}
+	}
+}
+
+// diff - events turning sessions of previous list into current one.
+func diff(previous []entity.Session, current []entity.Session) []entity.SessionEvent {
+	prev := make(map[string]entity.Session, len(previous))
+	for _, s := range previous {
+		prev[s.ID] = s
+	}
+
+	cur := make(map[string]struct{}, len(current))
+
+	var events []entity.SessionEvent
+
+	for _, s := range current {
+		cur[s.ID] = struct{}{}
+
+		old, ok := prev[s.ID]
+
+		switch {
+		case !ok:
+			events = append(events, entity.SessionEvent{Type: entity.SessionAdded, Session: s})
+		case changed(old, s):
+			events = append(events, entity.SessionEvent{Type: entity.SessionChanged, Session: s})
+		}
+	}
+
+	for _, s := range previous {
+		if _, ok := cur[s.ID]; !ok {
+			events = append(events, entity.SessionEvent{Type: entity.SessionRemoved, Session: s})
+		}
+	}
+
+	return events
+}
+
+// changed - session changed significantly, counters of calls and resources change on every poll and are not compared.
+func changed(a entity.Session, b entity.Session) bool {
+	return a.ConnectionID != b.ConnectionID ||
+		a.ProcessID != b.ProcessID ||
+		a.Hibernate != b.Hibernate ||
+		a.BlockedDB != b.BlockedDB ||
+		a.BlockedLS != b.BlockedLS ||
+		a.Svc != b.Svc ||
+		a.DBProcInfo != b.DBProcInfo
+}
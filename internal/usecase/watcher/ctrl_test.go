@@ -0,0 +1,267 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/antonmisa/1cctl/internal/entity"
+	ucm "github.com/antonmisa/1cctl/internal/usecase/mocks"
+	lm "github.com/antonmisa/1cctl/pkg/logger/mocks"
+)
+
+func newLogMock(t *testing.T) *lm.Interface {
+	logMock := lm.NewInterface(t)
+
+	logMock.On("Warn", mock.AnythingOfType("string")).Maybe()
+	logMock.On("Error", mock.Anything).Maybe()
+
+	return logMock
+}
+
+func receive(t *testing.T, ch <-chan entity.SessionEvent, n int) []entity.SessionEvent {
+	t.Helper()
+
+	events := make([]entity.SessionEvent, 0, n)
+
+	for len(events) < n {
+		select {
+		case ev, ok := <-ch:
+			require.True(t, ok, "channel is closed")
+
+			events = append(events, ev)
+		case <-time.After(time.Second):
+			require.FailNow(t, "no event")
+		}
+	}
+
+	return events
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	previous := []entity.Session{
+		{ID: "a", Hibernate: "no", Calls: 1},
+		{ID: "b"},
+		{ID: "c", Calls: 1},
+	}
+
+	current := []entity.Session{
+		{ID: "a", Hibernate: "yes", Calls: 2},
+		{ID: "c", Calls: 5},
+		{ID: "d"},
+	}
+
+	require.Equal(t, []entity.SessionEvent{
+		{Type: entity.SessionChanged, Session: current[0]},
+		{Type: entity.SessionAdded, Session: current[2]},
+		{Type: entity.SessionRemoved, Session: previous[1]},
+	}, diff(previous, current))
+}
+
+func TestWatch(t *testing.T) {
+	t.Parallel()
+
+	cluster := entity.Cluster{ID: "1234-5678"}
+
+	pipeMock := ucm.NewCtrlPipe(t)
+
+	pipeMock.On("GetSessions", mock.Anything, "1capp01:1545", cluster, entity.Infobase{}, entity.Credentials{}).
+		Return([]entity.Session{{ID: "a"}, {ID: "b"}}, nil).Once()
+
+	pipeMock.On("GetSessions", mock.Anything, "1capp01:1545", cluster, entity.Infobase{}, entity.Credentials{}).
+		Return([]entity.Session{{ID: "a", BlockedDB: 7}}, nil)
+
+	w := New(pipeMock, newLogMock(t), 20*time.Millisecond, 0)
+	defer w.Stop()
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	ctx2, cancel2 := context.WithCancel(context.Background())
+
+	ch1, err := w.Watch(ctx1, "1capp01:1545", cluster, entity.Credentials{})
+	require.NoError(t, err)
+
+	require.Equal(t, []entity.SessionEvent{
+		{Type: entity.SessionAdded, Session: entity.Session{ID: "a"}},
+		{Type: entity.SessionAdded, Session: entity.Session{ID: "b"}},
+	}, receive(t, ch1, 2))
+
+	require.Equal(t, []entity.SessionEvent{
+		{Type: entity.SessionChanged, Session: entity.Session{ID: "a", BlockedDB: 7}},
+		{Type: entity.SessionRemoved, Session: entity.Session{ID: "b"}},
+	}, receive(t, ch1, 2))
+
+	ch2, err := w.Watch(ctx2, "1capp01:1545", cluster, entity.Credentials{})
+	require.NoError(t, err)
+
+	require.Equal(t, []entity.SessionEvent{
+		{Type: entity.SessionAdded, Session: entity.Session{ID: "a", BlockedDB: 7}},
+	}, receive(t, ch2, 1))
+
+	w.Lock()
+	require.Len(t, w.pollers, 1)
+	w.Unlock()
+
+	cancel1()
+	cancel2()
+
+	for _, ch := range []<-chan entity.SessionEvent{ch1, ch2} {
+		require.Eventually(t, func() bool {
+			_, ok := <-ch
+
+			return !ok
+		}, time.Second, 10*time.Millisecond)
+	}
+
+	require.Eventually(t, func() bool {
+		w.Lock()
+		defer w.Unlock()
+
+		return len(w.pollers) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestWatchManySessions(t *testing.T) {
+	t.Parallel()
+
+	cluster := entity.Cluster{ID: "1234-5678"}
+
+	sessions := make([]entity.Session, 3*_subscriberBuffer)
+	for i := range sessions {
+		sessions[i] = entity.Session{ID: fmt.Sprintf("s%d", i)}
+	}
+
+	pipeMock := ucm.NewCtrlPipe(t)
+
+	pipeMock.On("GetSessions", mock.Anything, "1capp01:1545", cluster, entity.Infobase{}, entity.Credentials{}).
+		Return(sessions, nil)
+
+	w := New(pipeMock, newLogMock(t), time.Hour, 0)
+	defer w.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := w.Watch(ctx, "1capp01:1545", cluster, entity.Credentials{})
+	require.NoError(t, err)
+
+	events := receive(t, ch, len(sessions))
+
+	for i, ev := range events {
+		require.Equal(t, entity.SessionEvent{Type: entity.SessionAdded, Session: sessions[i]}, ev)
+	}
+}
+
+func TestWatchSlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	cluster := entity.Cluster{ID: "1234-5678"}
+
+	sessions := make([]entity.Session, 2*_subscriberBuffer)
+	for i := range sessions {
+		sessions[i] = entity.Session{ID: fmt.Sprintf("s%d", i)}
+	}
+
+	pipeMock := ucm.NewCtrlPipe(t)
+
+	pipeMock.On("GetSessions", mock.Anything, "1capp01:1545", cluster, entity.Infobase{}, entity.Credentials{}).
+		Return(sessions, nil)
+
+	w := New(pipeMock, newLogMock(t), time.Hour, 0)
+	w.sendTimeout = 10 * time.Millisecond
+
+	defer w.Stop()
+
+	ch, err := w.Watch(context.Background(), "1capp01:1545", cluster, entity.Credentials{})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		w.Lock()
+		defer w.Unlock()
+
+		return len(w.pollers) == 0
+	}, time.Second, 10*time.Millisecond)
+
+	n := 0
+	for range ch {
+		n++
+	}
+
+	require.Equal(t, _subscriberBuffer, n)
+}
+
+func TestWatchStalledSubscriber(t *testing.T) {
+	t.Parallel()
+
+	cluster := entity.Cluster{ID: "1234-5678"}
+
+	var polls atomic.Int64
+
+	pipeMock := ucm.NewCtrlPipe(t)
+
+	// Every poll changes all sessions, so stalled subscriber overflows its buffer at once
+	pipeMock.On("GetSessions", mock.Anything, "1capp01:1545", cluster, entity.Infobase{}, entity.Credentials{}).
+		Return(func(ctx context.Context, entrypoint string, cluster entity.Cluster, infobase entity.Infobase, cred entity.Credentials) ([]entity.Session, error) {
+			n := int(polls.Add(1))
+
+			sessions := make([]entity.Session, 2*_subscriberBuffer)
+			for i := range sessions {
+				sessions[i] = entity.Session{ID: fmt.Sprintf("s%d", i), BlockedDB: n}
+			}
+
+			return sessions, nil
+		})
+
+	w := New(pipeMock, newLogMock(t), 10*time.Millisecond, 0)
+	defer w.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stalled, err := w.Watch(ctx, "1capp01:1545", cluster, entity.Credentials{})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return len(stalled) == _subscriberBuffer }, time.Second, time.Millisecond)
+
+	var ch <-chan entity.SessionEvent
+
+	watched := make(chan error, 1)
+
+	go func() {
+		var err error
+
+		ch, err = w.Watch(ctx, "1capp01:1545", cluster, entity.Credentials{})
+		watched <- err
+	}()
+
+	select {
+	case err = <-watched:
+		require.NoError(t, err)
+	case <-time.After(100 * time.Millisecond):
+		require.FailNow(t, "watch is blocked by stalled subscriber")
+	}
+
+	// Snapshot and changes of a few polls come long before send timeout of stalled subscriber
+	start := time.Now()
+
+	events := receive(t, ch, 3*2*_subscriberBuffer)
+
+	require.Less(t, time.Since(start), w.sendTimeout/2)
+	require.Equal(t, entity.SessionChanged, events[len(events)-1].Type)
+}
+
+func TestWatchStopped(t *testing.T) {
+	t.Parallel()
+
+	w := New(ucm.NewCtrlPipe(t), newLogMock(t), 0, 0)
+	w.Stop()
+
+	_, err := w.Watch(context.Background(), "1capp01:1545", entity.Cluster{ID: "1234-5678"}, entity.Credentials{})
+	require.ErrorIs(t, err, ErrStopped)
+}
@@ -14,6 +14,7 @@ type Cacher interface {
 	Set(key string, value any, ttl time.Duration)
 	Get(key string) (any, bool)
 	Delete(key string) bool
+	DeleteFunc(match func(key string) bool) int
 	DeleteExpired()
 	Flush()
 }
@@ -111,6 +112,23 @@ func (c *cache) Delete(key string) bool {
 	return found
 }
 
+// Delete all keys matched by func, returns count of deleted -.
+func (c *cache) DeleteFunc(match func(key string) bool) int {
+	c.Lock()
+	defer c.Unlock()
+
+	num := 0
+
+	for k := range c.items {
+		if match(k) {
+			c.delete(k)
+			num++
+		}
+	}
+
+	return num
+}
+
 func (c *cache) delete(key string) {
 	delete(c.items, key)
 }
@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -56,6 +57,59 @@ func Test_Cache_Delete(t *testing.T) {
 	}
 }
 
+func Test_Cache_DeleteFunc(t *testing.T) {
+	type F func() *Cache
+
+	tests := []struct {
+		name   string
+		cf     F
+		prefix string
+		want   int
+		left   int
+	}{
+		{
+			name: "OK",
+			cf: func() *Cache {
+				c, _ := New(60 * time.Second)
+				c.Set("a:1", 1, 60*time.Second)
+				c.Set("a:2", 1, 60*time.Second)
+				c.Set("b:1", 1, 60*time.Second)
+
+				return c
+			},
+			prefix: "a:",
+			want:   2,
+			left:   1,
+		},
+		{
+			name: "Nothing matched",
+			cf: func() *Cache {
+				c, _ := New(60 * time.Second)
+				c.Set("b:1", 1, 60*time.Second)
+				c.Set("b:2", 1, 60*time.Second)
+
+				return c
+			},
+			prefix: "a:",
+			want:   0,
+			left:   2,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := tt.cf()
+			got := c.DeleteFunc(func(key string) bool { return strings.HasPrefix(key, tt.prefix) })
+
+			require.Equal(t, got, tt.want)
+			require.Equal(t, len(c.items), tt.left)
+		})
+	}
+}
+
 func Test_Cache_DeleteExpired(t *testing.T) {
 	type F func() *Cache
 